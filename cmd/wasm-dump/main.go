@@ -222,6 +222,9 @@ func printDis(w io.Writer, fname string, m *wasm.Module) {
 			buf := new(bytes.Buffer)
 			str := new(bytes.Buffer)
 			fmt.Fprintf(buf, "%02x", code.Op.Code)
+			if code.Op.IsPrefixed() {
+				fmt.Fprintf(buf, " %02x", code.Op.Sub)
+			}
 			fmt.Fprintf(str, "%v", code.Op.Name)
 			for _, im := range code.Immediates {
 				imbuf := new(bytes.Buffer)
@@ -322,17 +325,31 @@ func printDetails(w io.Writer, fname string, m *wasm.Module) {
 	if sec := m.Elements; sec != nil {
 		fmt.Fprintf(w, "%v:\n", sec.ID)
 		for i, e := range sec.Entries {
-			fmt.Fprintf(w, " - segment[%d] table=%d\n", i, e.Index)
-			fmt.Fprintf(w, " - init: %#v\n", e.Offset)
+			switch {
+			case e.Passive:
+				fmt.Fprintf(w, " - segment[%d] passive\n", i)
+			case e.Declarative:
+				fmt.Fprintf(w, " - segment[%d] declarative\n", i)
+			default:
+				fmt.Fprintf(w, " - segment[%d] table=%d\n", i, e.Index)
+				fmt.Fprintf(w, " - init: %#v\n", e.Offset)
+			}
 			for ii, elem := range e.Elems {
 				fmt.Fprintf(w, "  - elem[%d] = func[%d]\n", ii, elem)
 			}
+			for ii, expr := range e.Exprs {
+				fmt.Fprintf(w, "  - elem[%d] = %v init: %#v\n", ii, e.ElemType().ValueType(), expr)
+			}
 		}
 	}
 	if sec := m.Data; sec != nil {
 		fmt.Fprintf(w, "%v:\n", sec.ID)
 		for i, e := range sec.Entries {
-			fmt.Fprintf(w, " - segment[%d] size=%d - init %#v\n", i, len(e.Data), e.Offset)
+			if e.Passive {
+				fmt.Fprintf(w, " - segment[%d] size=%d - passive\n", i, len(e.Data))
+			} else {
+				fmt.Fprintf(w, " - segment[%d] size=%d - init %#v\n", i, len(e.Data), e.Offset)
+			}
 			fmt.Fprintf(w, "%s", hexDump(e.Data, 0))
 		}
 	}
@@ -43,7 +43,11 @@ func run(w io.Writer, fname string, verify bool) {
 	}
 	defer f.Close()
 
-	m, err := wasm.ReadModule(f, importer)
+	resolver, err := exec.NewResolver(importer, spectest(w))
+	if err != nil {
+		log.Fatal(err)
+	}
+	m, err := wasm.ReadModule(f, resolver.Resolve)
 	if err != nil {
 		log.Fatalf("could not read module: %v", err)
 	}
@@ -73,16 +77,14 @@ func run(w io.Writer, fname string, verify bool) {
 		}
 
 		i := int64(e.Index)
-		fidx := m.Function.Types[int(i)]
-		ftype := m.Types.Entries[int(fidx)]
+		ftype := m.GetFunction(int(i)).Sig
 		switch len(ftype.ReturnTypes) {
 		case 1:
 			fmt.Fprintf(w, "%s() %s => ", name, ftype.ReturnTypes[0])
 		case 0:
 			fmt.Fprintf(w, "%s() => ", name)
 		default:
-			log.Printf("running exported functions with more than one return value is not supported")
-			continue
+			fmt.Fprintf(w, "%s() %v => ", name, ftype.ReturnTypes)
 		}
 		if len(ftype.ParamTypes) > 0 {
 			log.Printf("running exported functions with input parameters is not supported")
@@ -94,14 +96,61 @@ func run(w io.Writer, fname string, verify bool) {
 			log.Printf("err=%v", err)
 			continue
 		}
-		if len(ftype.ReturnTypes) == 0 {
+		switch len(ftype.ReturnTypes) {
+		case 0:
+			fmt.Fprintf(w, "\n")
+		case 1:
+			fmt.Fprintf(w, "%[1]v (%[1]T)\n", o)
+		default:
+			for i, v := range o.([]interface{}) {
+				if i > 0 {
+					fmt.Fprintf(w, ", ")
+				}
+				fmt.Fprintf(w, "%[1]v (%[1]T)", v)
+			}
 			fmt.Fprintf(w, "\n")
-			continue
 		}
-		fmt.Fprintf(w, "%[1]v (%[1]T)\n", o)
 	}
 }
 
+// spectest returns the host module imported by the modules of the
+// WebAssembly test suite, whose print functions write their arguments to w.
+func spectest(w io.Writer) *exec.HostModule {
+	return exec.NewHostModule("spectest").
+		Func("print", exec.HostFunc(func(proc *exec.Process) error {
+			return nil
+		})).
+		Func("print_i32", exec.HostFuncI32(func(proc *exec.Process, v uint32) error {
+			_, err := fmt.Fprintf(w, "%d : i32\n", int32(v))
+			return err
+		})).
+		Func("print_i64", exec.HostFuncI64(func(proc *exec.Process, v uint64) error {
+			_, err := fmt.Fprintf(w, "%d : i64\n", int64(v))
+			return err
+		})).
+		Func("print_f32", exec.HostFuncF32(func(proc *exec.Process, v float32) error {
+			_, err := fmt.Fprintf(w, "%v : f32\n", v)
+			return err
+		})).
+		Func("print_f64", exec.HostFuncF64(func(proc *exec.Process, v float64) error {
+			_, err := fmt.Fprintf(w, "%v : f64\n", v)
+			return err
+		})).
+		Func("print_i32_f32", exec.HostFuncI32F32(func(proc *exec.Process, a uint32, b float32) error {
+			_, err := fmt.Fprintf(w, "%d : i32\n%v : f32\n", int32(a), b)
+			return err
+		})).
+		Func("print_f64_f64", exec.HostFuncF64F64(func(proc *exec.Process, a, b float64) error {
+			_, err := fmt.Fprintf(w, "%v : f64\n%v : f64\n", a, b)
+			return err
+		})).
+		Global("global_i32", wasm.ValueTypeI32, 666).
+		Global("global_i64", wasm.ValueTypeI64, 666).
+		Memory("memory", wasm.ResizableLimits{Flags: 1, Initial: 1, Maximum: 2}).
+		Table("table", wasm.ResizableLimits{Flags: 1, Initial: 10, Maximum: 20})
+}
+
+// importer loads the module name from the file name.wasm.
 func importer(name string) (*wasm.Module, error) {
 	f, err := os.Open(name + ".wasm")
 	if err != nil {
@@ -25,6 +25,10 @@ func TestRun(t *testing.T) {
 			verify: true,
 			want:   "testdata/basic.wasm.txt",
 		},
+		{
+			name: "testdata/spectest.wasm",
+			want: "testdata/spectest.wasm.txt",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			out := new(bytes.Buffer)
@@ -6,6 +6,8 @@ package disasm
 
 import (
 	"bytes"
+	"encoding/binary"
+	"math"
 
 	"github.com/ontio/wagon/wasm"
 	"github.com/ontio/wagon/wasm/leb128"
@@ -17,9 +19,14 @@ func Assemble(instr []Instr) ([]byte, error) {
 	body := new(bytes.Buffer)
 	for _, ins := range instr {
 		body.WriteByte(ins.Op.Code)
+		if ins.Op.IsPrefixed() {
+			leb128.WriteVarUint32(body, ins.Op.Sub)
+		}
 		switch op := ins.Op.Code; op {
 		case ops.Block, ops.Loop, ops.If:
-			body.WriteByte(byte(ins.Immediates[0].(wasm.BlockType)))
+			if err := wasm.WriteBlockType(body, ins.Immediates[0]); err != nil {
+				return nil, err
+			}
 		case ops.Br, ops.BrIf:
 			leb128.WriteVarUint32(body, ins.Immediates[0].(uint32))
 		case ops.BrTable:
@@ -29,33 +36,47 @@ func Assemble(instr []Instr) ([]byte, error) {
 				leb128.WriteVarUint32(body, ins.Immediates[i+1].(uint32))
 			}
 			leb128.WriteVarUint32(body, ins.Immediates[1+cnt].(uint32))
-		case ops.Call, ops.CallIndirect:
+		case ops.Call, ops.CallIndirect, ops.ReturnCall, ops.ReturnCallIndirect:
 			leb128.WriteVarUint32(body, ins.Immediates[0].(uint32))
-			if op == ops.CallIndirect {
+			if op == ops.CallIndirect || op == ops.ReturnCallIndirect {
 				leb128.WriteVarUint32(body, ins.Immediates[1].(uint32))
 			}
-		case ops.GetLocal, ops.SetLocal, ops.TeeLocal, ops.GetGlobal, ops.SetGlobal:
+		case ops.GetLocal, ops.SetLocal, ops.TeeLocal, ops.GetGlobal, ops.SetGlobal, ops.TableGet, ops.TableSet, ops.RefFunc:
+			leb128.WriteVarUint32(body, ins.Immediates[0].(uint32))
+		case ops.SelectTyped:
 			leb128.WriteVarUint32(body, ins.Immediates[0].(uint32))
+			for _, t := range ins.Immediates[1:] {
+				body.WriteByte(byte(t.(wasm.ValueType)))
+			}
+		case ops.RefNull:
+			body.WriteByte(byte(ins.Immediates[0].(wasm.ValueType)))
 		case ops.I32Const:
 			leb128.WriteVarint64(body, int64(ins.Immediates[0].(int32)))
 		case ops.I64Const:
 			leb128.WriteVarint64(body, ins.Immediates[0].(int64))
-		//case ops.F32Const:
-		//	f := ins.Immediates[0].(float32)
-		//	var b [4]byte
-		//	binary.LittleEndian.PutUint32(b[:], math.Float32bits(f))
-		//	body.Write(b[:])
-		//case ops.F64Const:
-		//	f := ins.Immediates[0].(float64)
-		//	var b [8]byte
-		//	binary.LittleEndian.PutUint64(b[:], math.Float64bits(f))
-		//	body.Write(b[:])
-		//case ops.I32Load, ops.I64Load, ops.F32Load, ops.F64Load, ops.I32Load8s, ops.I32Load8u, ops.I32Load16s, ops.I32Load16u, ops.I64Load8s, ops.I64Load8u, ops.I64Load16s, ops.I64Load16u, ops.I64Load32s, ops.I64Load32u, ops.I32Store, ops.I64Store, ops.F32Store, ops.F64Store, ops.I32Store8, ops.I32Store16, ops.I64Store8, ops.I64Store16, ops.I64Store32:
-		case ops.I32Load, ops.I64Load, ops.I32Load8s, ops.I32Load8u, ops.I32Load16s, ops.I32Load16u, ops.I64Load8s, ops.I64Load8u, ops.I64Load16s, ops.I64Load16u, ops.I64Load32s, ops.I64Load32u, ops.I32Store, ops.I64Store, ops.I32Store8, ops.I32Store16, ops.I64Store8, ops.I64Store16, ops.I64Store32:
+		case ops.F32Const:
+			f := ins.Immediates[0].(float32)
+			var b [4]byte
+			binary.LittleEndian.PutUint32(b[:], math.Float32bits(f))
+			body.Write(b[:])
+		case ops.F64Const:
+			f := ins.Immediates[0].(float64)
+			var b [8]byte
+			binary.LittleEndian.PutUint64(b[:], math.Float64bits(f))
+			body.Write(b[:])
+		case ops.I32Load, ops.I64Load, ops.F32Load, ops.F64Load, ops.I32Load8s, ops.I32Load8u, ops.I32Load16s, ops.I32Load16u, ops.I64Load8s, ops.I64Load8u, ops.I64Load16s, ops.I64Load16u, ops.I64Load32s, ops.I64Load32u, ops.I32Store, ops.I64Store, ops.F32Store, ops.F64Store, ops.I32Store8, ops.I32Store16, ops.I64Store8, ops.I64Store16, ops.I64Store32:
 			leb128.WriteVarUint32(body, ins.Immediates[0].(uint32))
 			leb128.WriteVarUint32(body, ins.Immediates[1].(uint32))
 		case ops.CurrentMemory, ops.GrowMemory:
 			leb128.WriteVarUint32(body, uint32(ins.Immediates[0].(uint8)))
+		case ops.MiscPrefix:
+			// Indices are followed by reserved memory indices,
+			// which are zero and thus encoded as a single byte.
+			for _, imm := range ins.Immediates {
+				leb128.WriteVarUint32(body, imm.(uint32))
+			}
+		case ops.SIMDPrefix:
+			writeSIMDImmediates(body, ins.Immediates)
 		}
 	}
 	return body.Bytes(), nil
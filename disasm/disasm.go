@@ -7,8 +7,10 @@ package disasm
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"io"
+	"math"
 
 	"github.com/ontio/wagon/internal/stack"
 	"github.com/ontio/wagon/wasm"
@@ -24,7 +26,8 @@ type Instr struct {
 	// Immediates are arguments to an operator in the bytecode stream itself.
 	// Valid value types are:
 	// - (u)(int/float)(32/64)
-	// - wasm.BlockType
+	// - wasm.BlockType or wasm.BlockTypeIndex
+	// - uint8 lane indices and [16]byte constants of SIMD operators
 	Immediates  []interface{}
 	NewStack    *StackInfo // non-nil if the instruction creates or unwinds a stack.
 	Block       *BlockInfo // non-nil if the instruction starts or ends a new block.
@@ -37,19 +40,30 @@ type Instr struct {
 	// If the operator is br_table (ops.BrTable), this is a list of StackInfo
 	// fields for each of the blocks/branches referenced by the operator.
 	Branches []StackInfo
+	// Offset is the byte offset of the operator in the function body code.
+	Offset int
+	// Wide is true for drop and select operators whose operands are v128
+	// values, which take two stack slots.
+	Wide bool
 }
 
 // StackInfo stores details about a new stack created or unwound by an instruction.
+// Like all stack depths computed by NewDisassembly, the numbers of values it
+// holds are numbers of slots: v128 values take two slots, the values of
+// other types one.
 type StackInfo struct {
 	StackTopDiff int64 // The difference between the stack depths at the end of the block
-	PreserveTop  bool  // Whether the value on the top of the stack should be preserved while unwinding
+	PreserveTop  bool  // Whether the values on the top of the stack should be preserved while unwinding
+	Preserve     int64 // The number of values on the top of the stack to preserve while unwinding
 	IsReturn     bool  // Whether the unwind is equivalent to a return
 }
 
 // BlockInfo stores details about a block created or ended by an instruction.
 type BlockInfo struct {
 	Start     bool           // If true, this instruction starts a block. Else this instruction ends it.
-	Signature wasm.BlockType // The block signature
+	Signature wasm.BlockType // The block signature, BlockTypeEmpty if it is a type index
+	Params    int            // The number of slots the block takes from the stack
+	Results   int            // The number of slots the block leaves on the stack
 
 	// Indices to the accompanying control operator.
 	// For 'if', this is the index to the 'else' operator.
@@ -65,7 +79,7 @@ type BlockInfo struct {
 // Disassembly is the result of disassembling a WebAssembly function.
 type Disassembly struct {
 	Code     []Instr
-	MaxDepth int // The maximum stack depth that can be reached while executing this function
+	MaxDepth int // The maximum stack depth, in slots, that can be reached while executing this function
 }
 
 func (d *Disassembly) checkMaxDepth(depth int) {
@@ -84,6 +98,32 @@ func isInstrReachable(indexStack [][]int) bool {
 
 var ErrStackUnderflow = errors.New("disasm: stack underflow")
 
+// ErrInvalidBranchDepth is returned by NewDisassembly when a branch refers
+// to a block that does not enclose it.
+var ErrInvalidBranchDepth = errors.New("disasm: invalid branch depth")
+
+// ErrInvalidCount is returned by Disassemble when the number of immediates
+// of an operator exceeds the number of bytes left in the code.
+var ErrInvalidCount = errors.New("disasm: immediate count exceeds the code size")
+
+// instrSlots returns the number of slots taken by the operands of drop and
+// select.
+func instrSlots(instr Instr) int {
+	if instr.Wide {
+		return 2
+	}
+	return 1
+}
+
+// labelArity returns the number of values carried by a branch to the block
+// started by instr: the parameters of a loop, the results of other blocks.
+func labelArity(instr Instr) int64 {
+	if instr.Op.Code == ops.Loop {
+		return int64(instr.Block.Params)
+	}
+	return int64(instr.Block.Results)
+}
+
 // NewDisassembly disassembles the given function. It also takes the function's
 // parent module as an argument for locating any other functions referenced by
 // fn.
@@ -106,6 +146,7 @@ func NewDisassembly(fn wasm.Function, module *wasm.Module) (*Disassembly, error)
 	blockIndices := &stack.Stack{} // a stack of indices to operators which start new blocks
 	curIndex := 0
 	var lastOpReturn bool
+	operands := &operandTypes{module: module, locals: LocalTypes(fn)}
 
 	for _, instr := range instrs {
 		logger.Printf("stack top is %d", stackDepths.Top())
@@ -128,15 +169,35 @@ func NewDisassembly(fn wasm.Function, module *wasm.Module) (*Disassembly, error)
 		}
 
 		logger.Printf("op: %s, unreachable: %v", opStr.Name, instr.Unreachable)
+		// The number of slots taken by the variable accessed by a
+		// local or global operator.
+		var varSlots uint64
+		if !instr.Unreachable {
+			switch op {
+			case ops.Drop:
+				instr.Wide = operands.at(0) == wasm.ValueTypeV128
+			case ops.Select:
+				instr.Wide = operands.at(1) == wasm.ValueTypeV128
+			case ops.SelectTyped:
+				instr.Wide = instr.Immediates[1].(wasm.ValueType) == wasm.ValueTypeV128
+			case ops.GetLocal, ops.SetLocal, ops.TeeLocal:
+				varSlots = uint64(Slots(operands.local(instr.Immediates[0].(uint32))))
+			case ops.GetGlobal, ops.SetGlobal:
+				varSlots = uint64(Slots(operands.global(instr.Immediates[0].(uint32))))
+			}
+			if err := operands.step(instr); err != nil {
+				return nil, err
+			}
+		}
 		if !opStr.Polymorphic && !instr.Unreachable {
 			top := int(stackDepths.Top())
-			top -= len(opStr.Args)
+			top -= Slots(opStr.Args...)
 			stackDepths.SetTop(uint64(top))
 			if top < -1 {
 				return nil, ErrStackUnderflow
 			}
 			if opStr.Returns != wasm.ValueType(wasm.BlockTypeEmpty) {
-				top++
+				top += Slots(opStr.Returns)
 				stackDepths.SetTop(uint64(top))
 			}
 			disas.checkMaxDepth(top)
@@ -147,15 +208,55 @@ func NewDisassembly(fn wasm.Function, module *wasm.Module) (*Disassembly, error)
 			pushPolymorphicOp(blockPolymorphicOps, curIndex)
 		case ops.Drop:
 			if !instr.Unreachable {
-				stackDepths.SetTop(stackDepths.Top() - 1)
+				stackDepths.SetTop(stackDepths.Top() - uint64(instrSlots(instr)))
+			}
+		case ops.Select, ops.SelectTyped:
+			// The condition and the second operand are popped.
+			if !instr.Unreachable {
+				stackDepths.SetTop(stackDepths.Top() - 1 - uint64(instrSlots(instr)))
 			}
-		case ops.Select:
+		case ops.TableSet:
 			if !instr.Unreachable {
 				stackDepths.SetTop(stackDepths.Top() - 2)
 			}
+		case ops.RefNull:
+			if !instr.Unreachable {
+				top := stackDepths.Top() + 1
+				stackDepths.SetTop(top)
+				disas.checkMaxDepth(int(top))
+			}
+		case ops.MiscPrefix:
+			if !instr.Unreachable {
+				switch opStr.Sub {
+				case ops.TableGrow:
+					stackDepths.SetTop(stackDepths.Top() - 1)
+				case ops.TableFill:
+					stackDepths.SetTop(stackDepths.Top() - 3)
+				}
+			}
 		case ops.Return:
 			if !instr.Unreachable {
-				stackDepths.SetTop(stackDepths.Top() - uint64(len(fn.Sig.ReturnTypes)))
+				stackDepths.SetTop(stackDepths.Top() - uint64(Slots(fn.Sig.ReturnTypes...)))
+			}
+			pushPolymorphicOp(blockPolymorphicOps, curIndex)
+			lastOpReturn = true
+		case ops.ReturnCall, ops.ReturnCallIndirect:
+			// A tail call pops the arguments of the callee, whose results
+			// are those of the current function.
+			if !instr.Unreachable {
+				top := int(stackDepths.Top())
+				index := instr.Immediates[0].(uint32)
+				var sig *wasm.FunctionSig
+				if op == ops.ReturnCallIndirect {
+					if module.Types == nil {
+						return nil, errors.New("missing types section")
+					}
+					sig = &module.Types.Entries[index]
+					top--
+				} else {
+					sig = module.GetFunction(int(index)).Sig
+				}
+				stackDepths.SetTop(uint64(top - Slots(sig.ParamTypes...)))
 			}
 			pushPolymorphicOp(blockPolymorphicOps, curIndex)
 			lastOpReturn = true
@@ -163,10 +264,12 @@ func NewDisassembly(fn wasm.Function, module *wasm.Module) (*Disassembly, error)
 			// The max depth reached while execing the current block
 			curDepth := stackDepths.Top()
 			blockStartIndex := blockIndices.Pop()
-			blockSig := disas.Code[blockStartIndex].Block.Signature
+			startBlock := disas.Code[blockStartIndex].Block
 			instr.Block = &BlockInfo{
 				Start:     false,
-				Signature: blockSig,
+				Signature: startBlock.Signature,
+				Params:    startBlock.Params,
+				Results:   startBlock.Results,
 			}
 			if op == ops.End {
 				instr.Block.BlockStartIndex = int(blockStartIndex)
@@ -177,16 +280,16 @@ func NewDisassembly(fn wasm.Function, module *wasm.Module) (*Disassembly, error)
 			}
 
 			// The max depth reached while execing the last block
-			// If the signature of the current block is not empty,
-			// this will be incremented.
+			// If the block returns values, this will be incremented
+			// by their number.
 			// Same with ops.Br/BrIf, we subtract 2 instead of 1
 			// to get the depth of the *parent* block of the branch
 			// we want to take.
 			prevDepthIndex := stackDepths.Len() - 2
 			prevDepth := stackDepths.Get(prevDepthIndex)
 
-			if op != ops.Else && blockSig != wasm.BlockTypeEmpty && !instr.Unreachable {
-				stackDepths.Set(prevDepthIndex, prevDepth+1)
+			if op != ops.Else && startBlock.Results != 0 && !instr.Unreachable {
+				stackDepths.Set(prevDepthIndex, prevDepth+uint64(startBlock.Results))
 				disas.checkMaxDepth(int(stackDepths.Get(prevDepthIndex)))
 			}
 
@@ -197,7 +300,8 @@ func NewDisassembly(fn wasm.Function, module *wasm.Module) (*Disassembly, error)
 				}
 				instr.NewStack = &StackInfo{
 					StackTopDiff: int64(elemsDiscard),
-					PreserveTop:  blockSig != wasm.BlockTypeEmpty,
+					PreserveTop:  startBlock.Results != 0,
+					Preserve:     int64(startBlock.Results),
 				}
 				logger.Printf("discard %d elements, preserve top: %v", elemsDiscard, instr.NewStack.PreserveTop)
 			} else {
@@ -212,7 +316,9 @@ func NewDisassembly(fn wasm.Function, module *wasm.Module) (*Disassembly, error)
 
 			stackDepths.Pop()
 			if op == ops.Else {
-				stackDepths.Push(stackDepths.Top())
+				// The parameters of the block are available again
+				// to the else branch.
+				stackDepths.Push(stackDepths.Top() + uint64(startBlock.Params))
 				blockIndices.Push(uint64(curIndex))
 				if !instr.Unreachable {
 					blockPolymorphicOps = append(blockPolymorphicOps, []int{})
@@ -220,9 +326,23 @@ func NewDisassembly(fn wasm.Function, module *wasm.Module) (*Disassembly, error)
 			}
 
 		case ops.Block, ops.Loop, ops.If:
-			sig := instr.Immediates[0].(wasm.BlockType)
+			sig, err := module.BlockSig(instr.Immediates[0])
+			if err != nil {
+				return nil, err
+			}
 			logger.Printf("if, depth is %d", stackDepths.Top())
-			stackDepths.Push(stackDepths.Top())
+			if !instr.Unreachable {
+				// The parameters of the block are moved from the
+				// stack of the parent block to the new one.
+				base := int(stackDepths.Top()) - Slots(sig.ParamTypes...)
+				if base < 0 {
+					return nil, ErrStackUnderflow
+				}
+				stackDepths.SetTop(uint64(base))
+				stackDepths.Push(uint64(base + Slots(sig.ParamTypes...)))
+			} else {
+				stackDepths.Push(stackDepths.Top())
+			}
 			// If this new block is unreachable, its
 			// entire instruction sequence is unreachable
 			// as well. To make sure that isInstrReachable
@@ -235,12 +355,20 @@ func NewDisassembly(fn wasm.Function, module *wasm.Module) (*Disassembly, error)
 			}
 			instr.Block = &BlockInfo{
 				Start:     true,
-				Signature: sig,
+				Signature: wasm.BlockTypeEmpty,
+				Params:    Slots(sig.ParamTypes...),
+				Results:   Slots(sig.ReturnTypes...),
+			}
+			if bt, ok := instr.Immediates[0].(wasm.BlockType); ok {
+				instr.Block.Signature = bt
 			}
 
 			blockIndices.Push(uint64(curIndex))
 		case ops.Br, ops.BrIf:
 			depth := instr.Immediates[0].(uint32)
+			if int64(depth) > int64(blockIndices.Len()) {
+				return nil, ErrInvalidBranchDepth
+			}
 			if int(depth) == blockIndices.Len() {
 				instr.IsReturn = true
 			} else {
@@ -259,9 +387,11 @@ func NewDisassembly(fn wasm.Function, module *wasm.Module) (*Disassembly, error)
 				// No need to subtract 2 here, we are getting the block
 				// we need to branch to.
 				index := blockIndices.Get(blockIndices.Len() - 1 - int(depth))
+				arity := labelArity(disas.Code[index])
 				instr.NewStack = &StackInfo{
 					StackTopDiff: int64(elemsDiscard),
-					PreserveTop:  disas.Code[index].Block.Signature != wasm.BlockTypeEmpty,
+					PreserveTop:  arity != 0,
+					Preserve:     arity,
 				}
 			}
 			if op == ops.Br {
@@ -275,6 +405,9 @@ func NewDisassembly(fn wasm.Function, module *wasm.Module) (*Disassembly, error)
 			targetCount := instr.Immediates[0].(uint32)
 			for i := uint32(0); i < targetCount; i++ {
 				entry := instr.Immediates[i+1].(uint32)
+				if int64(entry) > int64(blockIndices.Len()) {
+					return nil, ErrInvalidBranchDepth
+				}
 
 				var info StackInfo
 				if int(entry) == blockIndices.Len() {
@@ -290,11 +423,15 @@ func NewDisassembly(fn wasm.Function, module *wasm.Module) (*Disassembly, error)
 					}
 					index := blockIndices.Get(blockIndices.Len() - 1 - int(entry))
 					info.StackTopDiff = int64(elemsDiscard)
-					info.PreserveTop = disas.Code[index].Block.Signature != wasm.BlockTypeEmpty
+					info.Preserve = labelArity(disas.Code[index])
+					info.PreserveTop = info.Preserve != 0
 				}
 				instr.Branches = append(instr.Branches, info)
 			}
 			defaultTarget := instr.Immediates[targetCount+1].(uint32)
+			if int64(defaultTarget) > int64(blockIndices.Len()) {
+				return nil, ErrInvalidBranchDepth
+			}
 
 			var info StackInfo
 			if int(defaultTarget) == blockIndices.Len() {
@@ -310,7 +447,8 @@ func NewDisassembly(fn wasm.Function, module *wasm.Module) (*Disassembly, error)
 				}
 				index := blockIndices.Get(blockIndices.Len() - 1 - int(defaultTarget))
 				info.StackTopDiff = int64(elemsDiscard)
-				info.PreserveTop = disas.Code[index].Block.Signature != wasm.BlockTypeEmpty
+				info.Preserve = labelArity(disas.Code[index])
+				info.PreserveTop = info.Preserve != 0
 			}
 			instr.Branches = append(instr.Branches, info)
 			pushPolymorphicOp(blockPolymorphicOps, curIndex)
@@ -328,8 +466,8 @@ func NewDisassembly(fn wasm.Function, module *wasm.Module) (*Disassembly, error)
 				} else {
 					sig = module.GetFunction(int(index)).Sig
 				}
-				top -= len(sig.ParamTypes)
-				top += len(sig.ReturnTypes)
+				top -= Slots(sig.ParamTypes...)
+				top += Slots(sig.ReturnTypes...)
 				stackDepths.SetTop(uint64(top))
 				disas.checkMaxDepth(top)
 			}
@@ -338,11 +476,11 @@ func NewDisassembly(fn wasm.Function, module *wasm.Module) (*Disassembly, error)
 				top := stackDepths.Top()
 				switch op {
 				case ops.GetLocal, ops.GetGlobal:
-					top++
+					top += varSlots
 					stackDepths.SetTop(top)
 					disas.checkMaxDepth(int(top))
 				case ops.SetLocal, ops.SetGlobal:
-					top--
+					top -= varSlots
 					stackDepths.SetTop(top)
 				case ops.TeeLocal:
 					// stack remains unchanged for tee_local
@@ -350,7 +488,7 @@ func NewDisassembly(fn wasm.Function, module *wasm.Module) (*Disassembly, error)
 			}
 		}
 
-		if op != ops.Return {
+		if op != ops.Return && op != ops.ReturnCall && op != ops.ReturnCallIndirect {
 			lastOpReturn = false
 		}
 
@@ -368,11 +506,66 @@ func NewDisassembly(fn wasm.Function, module *wasm.Module) (*Disassembly, error)
 	return disas, nil
 }
 
+// readOp reads the operator starting with the byte code. The sub-opcode
+// of prefixed operators is read from r.
+func readOp(code byte, r *bytes.Reader) (ops.Op, error) {
+	if !ops.IsPrefix(code) {
+		return ops.New(code)
+	}
+	sub, err := leb128.ReadVarUint32(r)
+	if err != nil {
+		return ops.Op{}, err
+	}
+	return ops.NewPrefixed(code, sub)
+}
+
+// readMiscImmediates reads the immediates of an operator prefixed with
+// MiscPrefix. All of them are returned as uint32 values.
+func readMiscImmediates(op ops.Op, r *bytes.Reader) ([]interface{}, error) {
+	var imms []interface{}
+
+	// Memory indices are reserved bytes, which must be zero.
+	var indices, memories int
+	switch op.Sub {
+	case ops.MemoryInit:
+		indices, memories = 1, 1
+	case ops.DataDrop, ops.ElemDrop:
+		indices = 1
+	case ops.MemoryCopy:
+		memories = 2
+	case ops.MemoryFill:
+		memories = 1
+	case ops.TableInit, ops.TableCopy:
+		indices = 2
+	case ops.TableGrow, ops.TableSize, ops.TableFill:
+		indices = 1
+	}
+	for i := 0; i < indices; i++ {
+		index, err := leb128.ReadVarUint32(r)
+		if err != nil {
+			return nil, err
+		}
+		imms = append(imms, index)
+	}
+	for i := 0; i < memories; i++ {
+		idx, err := wasm.ReadByte(r)
+		if err != nil {
+			return nil, err
+		}
+		if idx != 0x00 {
+			return nil, errors.New("disasm: memory index must be 0")
+		}
+		imms = append(imms, uint32(idx))
+	}
+	return imms, nil
+}
+
 // Disassemble disassembles a given function body into a set of instructions. It won't check operations for validity.
 func Disassemble(code []byte) ([]Instr, error) {
 	reader := bytes.NewReader(code)
 	var out []Instr
 	for {
+		offset := len(code) - reader.Len()
 		op, err := reader.ReadByte()
 		if err == io.EOF {
 			break
@@ -380,21 +573,22 @@ func Disassemble(code []byte) ([]Instr, error) {
 			return nil, err
 		}
 
-		opStr, err := ops.New(op)
+		opStr, err := readOp(op, reader)
 		if err != nil {
 			return nil, err
 		}
 		instr := Instr{
-			Op: opStr,
+			Op:     opStr,
+			Offset: offset,
 		}
 
 		switch op {
 		case ops.Block, ops.Loop, ops.If:
-			sig, err := wasm.ReadByte(reader)
+			sig, err := wasm.ReadBlockType(reader)
 			if err != nil {
 				return nil, err
 			}
-			instr.Immediates = append(instr.Immediates, wasm.BlockType(sig))
+			instr.Immediates = append(instr.Immediates, sig)
 		case ops.Br, ops.BrIf:
 			depth, err := leb128.ReadVarUint32(reader)
 			if err != nil {
@@ -406,6 +600,10 @@ func Disassemble(code []byte) ([]Instr, error) {
 			if err != nil {
 				return nil, err
 			}
+			// Every target takes at least a byte.
+			if int64(targetCount) > int64(reader.Len()) {
+				return nil, ErrInvalidCount
+			}
 			instr.Immediates = append(instr.Immediates, targetCount)
 			for i := uint32(0); i < targetCount; i++ {
 				entry, err := leb128.ReadVarUint32(reader)
@@ -420,28 +618,48 @@ func Disassemble(code []byte) ([]Instr, error) {
 				return nil, err
 			}
 			instr.Immediates = append(instr.Immediates, defaultTarget)
-		case ops.Call, ops.CallIndirect:
+		case ops.Call, ops.CallIndirect, ops.ReturnCall, ops.ReturnCallIndirect:
 			index, err := leb128.ReadVarUint32(reader)
 			if err != nil {
 				return nil, err
 			}
 			instr.Immediates = append(instr.Immediates, index)
-			if op == ops.CallIndirect {
-				idx, err := wasm.ReadByte(reader)
+			if op == ops.CallIndirect || op == ops.ReturnCallIndirect {
+				// The table index is a reserved byte in the MVP.
+				table, err := leb128.ReadVarUint32(reader)
 				if err != nil {
 					return nil, err
 				}
-				if idx != 0x00 {
-					return nil, errors.New("disasm: table index in call_indirect must be 0")
-				}
-				instr.Immediates = append(instr.Immediates, uint32(idx))
+				instr.Immediates = append(instr.Immediates, table)
 			}
-		case ops.GetLocal, ops.SetLocal, ops.TeeLocal, ops.GetGlobal, ops.SetGlobal:
+		case ops.GetLocal, ops.SetLocal, ops.TeeLocal, ops.GetGlobal, ops.SetGlobal, ops.TableGet, ops.TableSet, ops.RefFunc:
 			index, err := leb128.ReadVarUint32(reader)
 			if err != nil {
 				return nil, err
 			}
 			instr.Immediates = append(instr.Immediates, index)
+		case ops.SelectTyped:
+			count, err := leb128.ReadVarUint32(reader)
+			if err != nil {
+				return nil, err
+			}
+			if int64(count) > int64(reader.Len()) {
+				return nil, ErrInvalidCount
+			}
+			instr.Immediates = append(instr.Immediates, count)
+			for i := uint32(0); i < count; i++ {
+				var t wasm.ValueType
+				if err := t.UnmarshalWASM(reader); err != nil {
+					return nil, err
+				}
+				instr.Immediates = append(instr.Immediates, t)
+			}
+		case ops.RefNull:
+			var t wasm.ElemType
+			if err := t.UnmarshalWASM(reader); err != nil {
+				return nil, err
+			}
+			instr.Immediates = append(instr.Immediates, t.ValueType())
 		case ops.I32Const:
 			i, err := leb128.ReadVarint32(reader)
 			if err != nil {
@@ -454,22 +672,21 @@ func Disassemble(code []byte) ([]Instr, error) {
 				return nil, err
 			}
 			instr.Immediates = append(instr.Immediates, i)
-		//case ops.F32Const:
-		//	var b [4]byte
-		//	if _, err := io.ReadFull(reader, b[:]); err != nil {
-		//		return nil, err
-		//	}
-		//	i := binary.LittleEndian.Uint32(b[:])
-		//	instr.Immediates = append(instr.Immediates, math.Float32frombits(i))
-		//case ops.F64Const:
-		//	var b [8]byte
-		//	if _, err := io.ReadFull(reader, b[:]); err != nil {
-		//		return nil, err
-		//	}
-		//	i := binary.LittleEndian.Uint64(b[:])
-		//	instr.Immediates = append(instr.Immediates, math.Float64frombits(i))
-		//case ops.I32Load, ops.I64Load, ops.F32Load, ops.F64Load, ops.I32Load8s, ops.I32Load8u, ops.I32Load16s, ops.I32Load16u, ops.I64Load8s, ops.I64Load8u, ops.I64Load16s, ops.I64Load16u, ops.I64Load32s, ops.I64Load32u, ops.I32Store, ops.I64Store, ops.F32Store, ops.F64Store, ops.I32Store8, ops.I32Store16, ops.I64Store8, ops.I64Store16, ops.I64Store32:
-		case ops.I32Load, ops.I64Load, ops.I32Load8s, ops.I32Load8u, ops.I32Load16s, ops.I32Load16u, ops.I64Load8s, ops.I64Load8u, ops.I64Load16s, ops.I64Load16u, ops.I64Load32s, ops.I64Load32u, ops.I32Store, ops.I64Store, ops.I32Store8, ops.I32Store16, ops.I64Store8, ops.I64Store16, ops.I64Store32:
+		case ops.F32Const:
+			var b [4]byte
+			if _, err := io.ReadFull(reader, b[:]); err != nil {
+				return nil, err
+			}
+			i := binary.LittleEndian.Uint32(b[:])
+			instr.Immediates = append(instr.Immediates, math.Float32frombits(i))
+		case ops.F64Const:
+			var b [8]byte
+			if _, err := io.ReadFull(reader, b[:]); err != nil {
+				return nil, err
+			}
+			i := binary.LittleEndian.Uint64(b[:])
+			instr.Immediates = append(instr.Immediates, math.Float64frombits(i))
+		case ops.I32Load, ops.I64Load, ops.F32Load, ops.F64Load, ops.I32Load8s, ops.I32Load8u, ops.I32Load16s, ops.I32Load16u, ops.I64Load8s, ops.I64Load8u, ops.I64Load16s, ops.I64Load16u, ops.I64Load32s, ops.I64Load32u, ops.I32Store, ops.I64Store, ops.F32Store, ops.F64Store, ops.I32Store8, ops.I32Store16, ops.I64Store8, ops.I64Store16, ops.I64Store32:
 			// read memory_immediate
 			align, err := leb128.ReadVarUint32(reader)
 			if err != nil {
@@ -491,6 +708,18 @@ func Disassemble(code []byte) ([]Instr, error) {
 				return nil, errors.New("disasm: memory index must be 0")
 			}
 			instr.Immediates = append(instr.Immediates, uint8(idx))
+		case ops.MiscPrefix:
+			imms, err := readMiscImmediates(opStr, reader)
+			if err != nil {
+				return nil, err
+			}
+			instr.Immediates = imms
+		case ops.SIMDPrefix:
+			imms, err := readSIMDImmediates(opStr, reader)
+			if err != nil {
+				return nil, err
+			}
+			instr.Immediates = imms
 		}
 		out = append(out, instr)
 	}
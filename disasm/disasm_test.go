@@ -8,10 +8,12 @@ import (
 	"bytes"
 	"io/ioutil"
 	"path/filepath"
+	"reflect"
 	"testing"
 
 	"github.com/ontio/wagon/disasm"
 	"github.com/ontio/wagon/wasm"
+	ops "github.com/ontio/wagon/wasm/operators"
 )
 
 func TestDisassemble(t *testing.T) {
@@ -43,3 +45,104 @@ func TestDisassemble(t *testing.T) {
 		}
 	}
 }
+
+func TestDisassemblePrefixed(t *testing.T) {
+	// memory.copy, whose sub-opcode is encoded in two bytes, and
+	// memory.init 1.
+	code := []byte{0xfc, 0x8a, 0x00, 0x00, 0x00, 0xfc, 0x08, 0x01, 0x00}
+	instrs, err := disasm.Disassemble(code)
+	if err != nil {
+		t.Fatalf("disassemble failed: %v", err)
+	}
+	if len(instrs) != 2 {
+		t.Fatalf("got %d instructions, want 2", len(instrs))
+	}
+	for i, want := range []struct {
+		name   string
+		sub    uint32
+		imms   []uint32
+		offset int
+	}{
+		{"memory.copy", ops.MemoryCopy, []uint32{0, 0}, 0},
+		{"memory.init", ops.MemoryInit, []uint32{1, 0}, 5},
+	} {
+		instr := instrs[i]
+		if instr.Op.Name != want.name || instr.Op.Code != ops.MiscPrefix || instr.Op.Sub != want.sub || instr.Offset != want.offset {
+			t.Errorf("instruction %d: got %s (%#x %#x) at %d, want %s at %d", i, instr.Op.Name, instr.Op.Code, instr.Op.Sub, instr.Offset, want.name, want.offset)
+		}
+		if len(instr.Immediates) != len(want.imms) {
+			t.Fatalf("instruction %d: got immediates %v, want %v", i, instr.Immediates, want.imms)
+		}
+		for j, imm := range want.imms {
+			if instr.Immediates[j] != imm {
+				t.Errorf("instruction %d: got immediates %v, want %v", i, instr.Immediates, want.imms)
+			}
+		}
+	}
+
+	got, err := disasm.Assemble(instrs)
+	if err != nil {
+		t.Fatalf("assemble failed: %v", err)
+	}
+	if want := []byte{0xfc, 0x0a, 0x00, 0x00, 0xfc, 0x08, 0x01, 0x00}; !bytes.Equal(got, want) {
+		t.Errorf("assembled %x, want %x", got, want)
+	}
+
+	for _, code := range [][]byte{
+		{0xfc},
+		{0xfc, 0x7f},
+		{0xfe, 0x08, 0x01, 0x00},
+		{0xfc, 0x0a, 0x01, 0x00},
+	} {
+		if _, err := disasm.Disassemble(code); err == nil {
+			t.Errorf("%x: disassembled invalid code", code)
+		}
+	}
+}
+
+func TestDisassembleSIMD(t *testing.T) {
+	// v128.load8_lane offset=8 1, and i8x16.shuffle.
+	code := []byte{0xfd, 0x54, 0x00, 0x08, 0x01,
+		0xfd, 0x0d, 0, 17, 2, 19, 4, 21, 6, 23, 8, 25, 10, 27, 12, 29, 14, 31}
+	instrs, err := disasm.Disassemble(code)
+	if err != nil {
+		t.Fatalf("disassemble failed: %v", err)
+	}
+	if len(instrs) != 2 {
+		t.Fatalf("got %d instructions, want 2", len(instrs))
+	}
+	if got, want := instrs[0].Immediates, []interface{}{uint32(0), uint32(8), uint8(1)}; !reflect.DeepEqual(got, want) {
+		t.Errorf("v128.load8_lane: got immediates %v, want %v", got, want)
+	}
+	var lanes [16]byte
+	copy(lanes[:], code[7:])
+	if got, want := instrs[1].Immediates, []interface{}{lanes}; !reflect.DeepEqual(got, want) {
+		t.Errorf("i8x16.shuffle: got immediates %v, want %v", got, want)
+	}
+
+	got, err := disasm.Assemble(instrs)
+	if err != nil {
+		t.Fatalf("assemble failed: %v", err)
+	}
+	if !bytes.Equal(got, code) {
+		t.Errorf("assembled %x, want %x", got, code)
+	}
+}
+
+func TestDisassembleMalformed(t *testing.T) {
+	// br_table claiming a billion targets.
+	if _, err := disasm.Disassemble([]byte{0x41, 0x00, 0x0e, 0x80, 0x94, 0xeb, 0xdc, 0x03, 0x00}); err != disasm.ErrInvalidCount {
+		t.Errorf("br_table: got error %v, want %v", err, disasm.ErrInvalidCount)
+	}
+
+	sig := &wasm.FunctionSig{Form: 0x60}
+	for _, code := range [][]byte{
+		{0x0c, 0x01},                         // br 1
+		{0x41, 0x00, 0x0e, 0x01, 0x00, 0x02}, // br_table 0 2
+	} {
+		fn := wasm.Function{Sig: sig, Body: &wasm.FunctionBody{Code: code}}
+		if _, err := disasm.NewDisassembly(fn, &wasm.Module{}); err != disasm.ErrInvalidBranchDepth {
+			t.Errorf("%x: got error %v, want %v", code, err, disasm.ErrInvalidBranchDepth)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package disasm
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/ontio/wagon/wasm"
+	"github.com/ontio/wagon/wasm/leb128"
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// readSIMDImmediates reads the immediates of an operator prefixed with
+// SIMDPrefix. The alignment and offset of a memory_immediate are returned as
+// uint32 values and lane indices as uint8 values. The constant of v128.const
+// and the lane indices of i8x16.shuffle are returned as a [16]byte.
+func readSIMDImmediates(op ops.Op, r *bytes.Reader) ([]interface{}, error) {
+	var imms []interface{}
+	if simdHasMemoryImmediate(op.Sub) {
+		align, err := leb128.ReadVarUint32(r)
+		if err != nil {
+			return nil, err
+		}
+		offset, err := leb128.ReadVarUint32(r)
+		if err != nil {
+			return nil, err
+		}
+		imms = append(imms, align, offset)
+	}
+	switch {
+	case op.Sub == ops.V128Const || op.Sub == ops.I8x16Shuffle:
+		var b [16]byte
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return nil, err
+		}
+		imms = append(imms, b)
+	case simdHasLane(op.Sub):
+		lane, err := wasm.ReadByte(r)
+		if err != nil {
+			return nil, err
+		}
+		imms = append(imms, lane)
+	}
+	return imms, nil
+}
+
+// writeSIMDImmediates writes the immediates returned by readSIMDImmediates.
+func writeSIMDImmediates(w *bytes.Buffer, imms []interface{}) {
+	for _, imm := range imms {
+		switch imm := imm.(type) {
+		case uint32:
+			leb128.WriteVarUint32(w, imm)
+		case uint8:
+			w.WriteByte(imm)
+		case [16]byte:
+			w.Write(imm[:])
+		}
+	}
+}
+
+// simdHasMemoryImmediate reports whether the SIMD operator sub accesses the
+// linear memory.
+func simdHasMemoryImmediate(sub uint32) bool {
+	return sub <= ops.V128Store || (sub >= ops.V128Load8Lane && sub <= ops.V128Load64Zero)
+}
+
+// simdHasLane reports whether the SIMD operator sub has a lane index
+// immediate.
+func simdHasLane(sub uint32) bool {
+	return (sub >= ops.I8x16ExtractLaneS && sub <= ops.F64x2ReplaceLane) ||
+		(sub >= ops.V128Load8Lane && sub <= ops.V128Store64Lane)
+}
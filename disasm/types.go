@@ -0,0 +1,195 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package disasm
+
+import (
+	"github.com/ontio/wagon/wasm"
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// Slots returns the number of stack slots taken by values of the given
+// types. A slot holds 64 bits, so v128 values take two slots and values of
+// the other types one.
+func Slots(types ...wasm.ValueType) int {
+	n := len(types)
+	for _, t := range types {
+		if t == wasm.ValueTypeV128 {
+			n++
+		}
+	}
+	return n
+}
+
+// LocalTypes returns the types of the locals of fn, starting with its
+// parameters.
+func LocalTypes(fn wasm.Function) []wasm.ValueType {
+	locals := append([]wasm.ValueType(nil), fn.Sig.ParamTypes...)
+	if fn.Body != nil {
+		for _, entry := range fn.Body.Locals {
+			for i := uint32(0); i < entry.Count; i++ {
+				locals = append(locals, entry.Type)
+			}
+		}
+	}
+	return locals
+}
+
+// operandTypes tracks the types of the operands of the reachable
+// instructions of a function, so that the number of slots taken by the
+// operands of drop and select is known.
+type operandTypes struct {
+	stack  []wasm.ValueType
+	blocks []typedBlock
+
+	module *wasm.Module
+	locals []wasm.ValueType
+}
+
+// typedBlock is a block started by block, loop or if.
+type typedBlock struct {
+	height int // number of operands below the parameters of the block
+	sig    *wasm.FunctionSig
+}
+
+func (s *operandTypes) push(types ...wasm.ValueType) {
+	s.stack = append(s.stack, types...)
+}
+
+// pop removes n operands and returns the deepest one. Validated code never
+// underflows the stack, so the operands of invalid code are assumed to be
+// i32 values.
+func (s *operandTypes) pop(n int) wasm.ValueType {
+	t := wasm.ValueTypeI32
+	if n > len(s.stack) {
+		n = len(s.stack)
+	}
+	if n > 0 {
+		t = s.stack[len(s.stack)-n]
+	}
+	s.stack = s.stack[:len(s.stack)-n]
+	return t
+}
+
+// at returns the type of the operand at the given depth below the top of
+// the stack.
+func (s *operandTypes) at(depth int) wasm.ValueType {
+	if depth >= len(s.stack) {
+		return wasm.ValueTypeI32
+	}
+	return s.stack[len(s.stack)-1-depth]
+}
+
+func (s *operandTypes) local(index uint32) wasm.ValueType {
+	if int(index) >= len(s.locals) {
+		return wasm.ValueTypeI32
+	}
+	return s.locals[index]
+}
+
+func (s *operandTypes) global(index uint32) wasm.ValueType {
+	if g := s.module.GetGlobal(int(index)); g != nil {
+		return g.Type.Type
+	}
+	return wasm.ValueTypeI32
+}
+
+func (s *operandTypes) sig(index uint32, indirect bool) *wasm.FunctionSig {
+	if indirect {
+		if s.module.Types == nil || int(index) >= len(s.module.Types.Entries) {
+			return &wasm.FunctionSig{}
+		}
+		return &s.module.Types.Entries[index]
+	}
+	if fn := s.module.GetFunction(int(index)); fn != nil {
+		return fn.Sig
+	}
+	return &wasm.FunctionSig{}
+}
+
+func (s *operandTypes) table(index uint32) wasm.ValueType {
+	if table := s.module.GetTable(int(index)); table != nil {
+		return table.ElementType.ValueType()
+	}
+	return wasm.ValueTypeFuncRef
+}
+
+// step updates the operand types after the reachable instruction instr.
+// Instructions following an unconditional branch are unreachable, so that
+// the operand stack of a block only needs to be restored by its end.
+func (s *operandTypes) step(instr Instr) error {
+	op := instr.Op
+	if !op.Polymorphic {
+		s.pop(len(op.Args))
+		if op.Returns != wasm.ValueType(wasm.BlockTypeEmpty) {
+			s.push(op.Returns)
+		}
+	}
+	switch op.Code {
+	case ops.Block, ops.Loop, ops.If:
+		sig, err := s.module.BlockSig(instr.Immediates[0])
+		if err != nil {
+			return err
+		}
+		height := len(s.stack) - len(sig.ParamTypes)
+		if height < 0 {
+			height = 0
+		}
+		s.blocks = append(s.blocks, typedBlock{height: height, sig: sig})
+	case ops.Else, ops.End:
+		if len(s.blocks) == 0 {
+			// The end of the function.
+			return nil
+		}
+		b := s.blocks[len(s.blocks)-1]
+		if b.height <= len(s.stack) {
+			s.stack = s.stack[:b.height]
+		}
+		if op.Code == ops.Else {
+			s.push(b.sig.ParamTypes...)
+		} else {
+			s.push(b.sig.ReturnTypes...)
+			s.blocks = s.blocks[:len(s.blocks)-1]
+		}
+	case ops.Drop:
+		s.pop(1)
+	case ops.Select, ops.SelectTyped:
+		s.pop(1)
+		t := s.pop(2)
+		s.push(t)
+	case ops.Call, ops.CallIndirect, ops.ReturnCall, ops.ReturnCallIndirect:
+		indirect := op.Code == ops.CallIndirect || op.Code == ops.ReturnCallIndirect
+		if indirect {
+			s.pop(1)
+		}
+		sig := s.sig(instr.Immediates[0].(uint32), indirect)
+		s.pop(len(sig.ParamTypes))
+		s.push(sig.ReturnTypes...)
+	case ops.GetLocal:
+		s.push(s.local(instr.Immediates[0].(uint32)))
+	case ops.GetGlobal:
+		s.push(s.global(instr.Immediates[0].(uint32)))
+	case ops.SetLocal, ops.SetGlobal:
+		s.pop(1)
+	case ops.TableGet:
+		s.pop(1)
+		s.push(s.table(instr.Immediates[0].(uint32)))
+	case ops.TableSet:
+		s.pop(2)
+	case ops.RefNull:
+		s.push(instr.Immediates[0].(wasm.ValueType))
+	case ops.RefIsNull:
+		s.pop(1)
+		s.push(wasm.ValueTypeI32)
+	case ops.MiscPrefix:
+		switch op.Sub {
+		case ops.TableGrow:
+			s.pop(2)
+			s.push(wasm.ValueTypeI32)
+		case ops.TableFill:
+			s.pop(3)
+		}
+	}
+	return nil
+}
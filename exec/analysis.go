@@ -0,0 +1,467 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"sort"
+
+	"github.com/ontio/wagon/disasm"
+	"github.com/ontio/wagon/wasm"
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// FunctionCost is the static analysis of a function by AnalyzeModule. The
+// sizes are numbers of slots of the arena holding the locals and the
+// operand stacks of the frames of a VM, each slot holding 8 bytes.
+type FunctionCost struct {
+	// Host is true for the host functions, and for the imported functions
+	// whose body is unknown. They are not analyzed, and are assumed to
+	// neither use the arena nor charge gas besides the cost of the calls.
+	Host bool
+
+	MaxStackDepth int // maximum depth of the operand stack
+	Locals        int // parameters and local variables
+	FrameSize     int // slots of the arena taken by a call of the function
+
+	// Calls lists the functions which may be called by the function, in
+	// increasing order. A call_indirect may call every function of its
+	// type.
+	Calls    []uint32
+	Indirect bool // whether the function calls functions through a table
+
+	Loops bool // whether the function body has a loop
+	// Dynamic is true if the function uses operators whose cost depends
+	// on their operands, such as memory.grow and memory.copy.
+	Dynamic bool
+	// Recursive is true if the function is part of a cycle of the call
+	// graph. MayRecurse is true if it calls, directly or not, a recursive
+	// function, or is recursive itself.
+	Recursive  bool
+	MayRecurse bool
+
+	// Gas is an upper bound on the gas charged by the instructions of
+	// the function body, on the paths running every loop body at most
+	// once. The gas charged by the called functions is not included.
+	Gas uint64
+	// MaxGas is Gas, plus at every call the MaxGas of the called function
+	// or, for indirect calls, the largest MaxGas of the functions of the
+	// called type. The calls of recursive functions add nothing.
+	MaxGas uint64
+	// Bounded is true if MaxGas bounds the gas charged by every call of
+	// the function, which neither the function nor those it may call
+	// have loops, recursion or dynamic costs.
+	Bounded bool
+
+	// MaxFrame is the largest FrameSize of the function and those it may
+	// call. MaxStack is the largest number of slots taken by a call of the
+	// function and the calls nested in it, if it may not recurse.
+	MaxFrame int
+	MaxStack uint64
+}
+
+// StackBound returns an upper bound on the number of slots of the arena
+// taken by a call of the function and the calls nested in it, when at most
+// frameLimit calls may be nested, as set by VM.FrameLimit.
+func (c FunctionCost) StackBound(frameLimit int) uint64 {
+	bound := uint64(c.MaxFrame) * uint64(frameLimit)
+	if !c.MayRecurse && c.MaxStack < bound {
+		return c.MaxStack
+	}
+	return bound
+}
+
+// callEdge is a call of the function to, which is a tail call if tail is
+// true.
+type callEdge struct {
+	to   uint32
+	tail bool
+}
+
+// analyzer holds the state of AnalyzeModule.
+type analyzer struct {
+	module   *wasm.Module
+	schedule *GasSchedule
+	costs    []FunctionCost
+	code     [][]disasm.Instr
+	edges    [][]callEdge
+
+	// Strongly connected components of the call graph, found with
+	// Tarjan's algorithm.
+	index   int
+	indices []int
+	lowlink []int
+	onStack []bool
+	stack   []int
+}
+
+// AnalyzeModule computes the static costs of the functions of module, read
+// by wasm.ReadModule, under schedule. If schedule is nil,
+// DefaultGasSchedule is used. The returned slice is indexed by the function
+// index space of the module.
+//
+// The operand stack depth and locals of the functions are those reserved
+// by CompiledModule, so that the frame size of a call is exact, while the
+// gas is bounded over the paths through the structured control flow of
+// the function bodies.
+func AnalyzeModule(module *wasm.Module, schedule *GasSchedule) ([]FunctionCost, error) {
+	if schedule == nil {
+		schedule = DefaultGasSchedule()
+	}
+	a := &analyzer{
+		module:   module,
+		schedule: schedule,
+		costs:    make([]FunctionCost, len(module.FunctionIndexSpace)),
+		code:     make([][]disasm.Instr, len(module.FunctionIndexSpace)),
+		edges:    make([][]callEdge, len(module.FunctionIndexSpace)),
+	}
+	for i, fn := range module.FunctionIndexSpace {
+		if err := a.disassemble(i, fn); err != nil {
+			return nil, err
+		}
+	}
+
+	a.indices = make([]int, len(a.costs))
+	a.lowlink = make([]int, len(a.costs))
+	a.onStack = make([]bool, len(a.costs))
+	for i := range a.indices {
+		a.indices[i] = -1
+	}
+	for i := range a.costs {
+		if a.indices[i] < 0 {
+			a.connect(i)
+		}
+	}
+	return a.costs, nil
+}
+
+// disassemble records the frame and the calls of the function fn, located
+// at index in the function index space.
+func (a *analyzer) disassemble(index int, fn wasm.Function) error {
+	cost := &a.costs[index]
+	if fn.IsHost() || fn.Body == nil {
+		cost.Host = true
+		cost.Bounded = true
+		return nil
+	}
+	disassembly, err := disasm.NewDisassembly(fn, a.module)
+	if err != nil {
+		return err
+	}
+	a.code[index] = disassembly.Code
+	cost.MaxStackDepth = disassembly.MaxDepth
+	cost.Locals = disasm.Slots(disasm.LocalTypes(fn)...)
+	cost.FrameSize = cost.Locals + cost.MaxStackDepth + 1
+
+	calls := make(map[uint32]bool)
+	addCall := func(to uint32, tail bool) {
+		if !calls[to] {
+			cost.Calls = append(cost.Calls, to)
+		}
+		calls[to] = true
+		a.edges[index] = append(a.edges[index], callEdge{to: to, tail: tail})
+	}
+	for _, instr := range disassembly.Code {
+		if instr.Unreachable {
+			continue
+		}
+		op := instr.Op.Code
+		switch {
+		case op == ops.Loop:
+			cost.Loops = true
+		case op == ops.GrowMemory || op == ops.MiscPrefix && dynamicMiscOp(instr.Op.Sub):
+			cost.Dynamic = true
+		case op == ops.Call || op == ops.ReturnCall:
+			addCall(instr.Immediates[0].(uint32), op == ops.ReturnCall)
+		case op == ops.CallIndirect || op == ops.ReturnCallIndirect:
+			cost.Indirect = true
+			for _, to := range a.indirectCallees(instr.Immediates[0].(uint32)) {
+				addCall(to, op == ops.ReturnCallIndirect)
+			}
+		}
+	}
+	sort.Slice(cost.Calls, func(i, j int) bool { return cost.Calls[i] < cost.Calls[j] })
+	return nil
+}
+
+// dynamicMiscOp reports whether the operator with the sub-opcode sub
+// following MiscPrefix charges gas for the elements it writes.
+func dynamicMiscOp(sub uint32) bool {
+	switch sub {
+	case ops.MemoryInit, ops.MemoryCopy, ops.MemoryFill,
+		ops.TableInit, ops.TableCopy, ops.TableGrow, ops.TableFill:
+		return true
+	}
+	return false
+}
+
+// indirectCallees returns the functions which may be called through a
+// table by a call_indirect of the given type.
+func (a *analyzer) indirectCallees(typeIndex uint32) []uint32 {
+	if a.module.Types == nil || int(typeIndex) >= len(a.module.Types.Entries) {
+		return nil
+	}
+	want := &a.module.Types.Entries[typeIndex]
+	var callees []uint32
+	for i, fn := range a.module.FunctionIndexSpace {
+		if fn.Sig != nil && sameSig(want, fn.Sig) {
+			callees = append(callees, uint32(i))
+		}
+	}
+	return callees
+}
+
+func sameSig(a, b *wasm.FunctionSig) bool {
+	if len(a.ParamTypes) != len(b.ParamTypes) || len(a.ReturnTypes) != len(b.ReturnTypes) {
+		return false
+	}
+	for i := range a.ParamTypes {
+		if a.ParamTypes[i] != b.ParamTypes[i] {
+			return false
+		}
+	}
+	for i := range a.ReturnTypes {
+		if a.ReturnTypes[i] != b.ReturnTypes[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// connect visits the function v of the call graph with Tarjan's algorithm,
+// which completes the strongly connected components of the callees of a
+// function before its own, so that the costs of the callees are known
+// when the component is analyzed.
+func (a *analyzer) connect(v int) {
+	a.indices[v] = a.index
+	a.lowlink[v] = a.index
+	a.index++
+	a.stack = append(a.stack, v)
+	a.onStack[v] = true
+
+	for _, e := range a.edges[v] {
+		w := int(e.to)
+		if a.indices[w] < 0 {
+			a.connect(w)
+			if a.lowlink[w] < a.lowlink[v] {
+				a.lowlink[v] = a.lowlink[w]
+			}
+		} else if a.onStack[w] && a.indices[w] < a.lowlink[v] {
+			a.lowlink[v] = a.indices[w]
+		}
+	}
+
+	if a.lowlink[v] != a.indices[v] {
+		return
+	}
+	var component []int
+	for {
+		w := a.stack[len(a.stack)-1]
+		a.stack = a.stack[:len(a.stack)-1]
+		a.onStack[w] = false
+		component = append(component, w)
+		if w == v {
+			break
+		}
+	}
+	a.analyzeComponent(component)
+}
+
+// analyzeComponent computes the costs of the functions of a strongly
+// connected component of the call graph, whose callees outside of the
+// component are analyzed.
+func (a *analyzer) analyzeComponent(component []int) {
+	inComponent := make(map[int]bool, len(component))
+	for _, v := range component {
+		inComponent[v] = true
+	}
+	recursive := len(component) > 1
+	if !recursive {
+		for _, e := range a.edges[component[0]] {
+			if int(e.to) == component[0] {
+				recursive = true
+			}
+		}
+	}
+
+	for _, v := range component {
+		cost := &a.costs[v]
+		cost.Recursive = recursive
+		cost.MayRecurse = recursive
+		cost.MaxFrame = cost.FrameSize
+		if cost.Host {
+			continue
+		}
+		cost.Bounded = !recursive && !cost.Loops && !cost.Dynamic
+		var stack uint64
+		for _, e := range a.edges[v] {
+			if inComponent[int(e.to)] {
+				continue
+			}
+			callee := a.costs[e.to]
+			cost.MayRecurse = cost.MayRecurse || callee.MayRecurse
+			cost.Bounded = cost.Bounded && callee.Bounded
+			if callee.MaxFrame > cost.MaxFrame {
+				cost.MaxFrame = callee.MaxFrame
+			}
+			// A tail call replaces the frame of the caller.
+			chain := callee.MaxStack
+			if !e.tail {
+				chain += uint64(cost.FrameSize)
+			}
+			if chain > stack {
+				stack = chain
+			}
+		}
+		if stack < uint64(cost.FrameSize) {
+			stack = uint64(cost.FrameSize)
+		}
+		if !cost.MayRecurse {
+			cost.MaxStack = stack
+		}
+		cost.Gas = a.gas(v, nil)
+		cost.MaxGas = a.gas(v, inComponent)
+	}
+
+	if recursive {
+		// Every function of the component may call every other.
+		maxFrame := 0
+		for _, v := range component {
+			if a.costs[v].MaxFrame > maxFrame {
+				maxFrame = a.costs[v].MaxFrame
+			}
+		}
+		for _, v := range component {
+			a.costs[v].MaxFrame = maxFrame
+		}
+	}
+}
+
+// gasBlock is a block of a function body walked by analyzer.gas.
+type gasBlock struct {
+	loop    bool
+	isIf    bool
+	hasElse bool
+	entry   uint64 // gas charged when entering the block
+	exit    uint64 // largest gas charged when leaving the block
+	reached bool   // whether the end of the block may be reached
+}
+
+// gas returns the largest gas charged by the function fn on the paths
+// running every loop body at most once. If callees is not nil, the MaxGas
+// of the functions it calls is added at every call, except for those in
+// callees.
+func (a *analyzer) gas(fn int, callees map[int]bool) uint64 {
+	var (
+		cur    uint64
+		live   = true
+		max    uint64
+		blocks = []*gasBlock{{}}
+	)
+	leave := func(target *gasBlock) {
+		if !target.reached || cur > target.exit {
+			target.exit = cur
+		}
+		target.reached = true
+	}
+	branch := func(depth uint32) {
+		target := blocks[len(blocks)-1-int(depth)]
+		if !target.loop {
+			leave(target)
+		} else if cur > max {
+			// Branching back to the loop starts another iteration.
+			max = cur
+		}
+	}
+
+	for _, instr := range a.code[fn] {
+		if instr.Unreachable {
+			continue
+		}
+		if instr.Op.Code == ops.End {
+			top := blocks[len(blocks)-1]
+			blocks = blocks[:len(blocks)-1]
+			if live {
+				leave(top)
+			}
+			if top.isIf && !top.hasElse {
+				cur = top.entry
+				leave(top)
+			}
+			cur, live = top.exit+a.schedule.cost(instr), top.reached
+			continue
+		}
+
+		cur += a.schedule.cost(instr)
+		if callees != nil {
+			cur += a.calleeGas(instr, callees)
+		}
+		switch instr.Op.Code {
+		case ops.Block, ops.Loop, ops.If:
+			blocks = append(blocks, &gasBlock{
+				loop:  instr.Op.Code == ops.Loop,
+				isIf:  instr.Op.Code == ops.If,
+				entry: cur,
+			})
+		case ops.Else:
+			top := blocks[len(blocks)-1]
+			if live {
+				leave(top)
+			}
+			top.hasElse = true
+			cur, live = top.entry, true
+		case ops.Br:
+			branch(instr.Immediates[0].(uint32))
+			live = false
+		case ops.BrIf:
+			branch(instr.Immediates[0].(uint32))
+		case ops.BrTable:
+			for _, depth := range instr.Immediates[1:] {
+				branch(depth.(uint32))
+			}
+			live = false
+		case ops.Return, ops.Unreachable, ops.ReturnCall, ops.ReturnCallIndirect:
+			branch(uint32(len(blocks) - 1))
+			live = false
+		}
+	}
+
+	// The disassembly has no end for the function body. The compiler
+	// appends a nop to it instead, which the returns branch to.
+	if live {
+		leave(blocks[0])
+	}
+	cur = blocks[0].exit + a.schedule.cost(nopInstr)
+	if cur > max {
+		max = cur
+	}
+	return max
+}
+
+// calleeGas returns the largest MaxGas of the functions which may be
+// called by instr, except those in skip.
+func (a *analyzer) calleeGas(instr disasm.Instr, skip map[int]bool) uint64 {
+	var callees []uint32
+	switch instr.Op.Code {
+	case ops.Call, ops.ReturnCall:
+		callees = []uint32{instr.Immediates[0].(uint32)}
+	case ops.CallIndirect, ops.ReturnCallIndirect:
+		callees = a.indirectCallees(instr.Immediates[0].(uint32))
+	}
+	var gas uint64
+	for _, to := range callees {
+		if !skip[int(to)] && a.costs[to].MaxGas > gas {
+			gas = a.costs[to].MaxGas
+		}
+	}
+	return gas
+}
+
+var nopInstr = func() disasm.Instr {
+	op, err := ops.New(ops.Nop)
+	if err != nil {
+		panic(err)
+	}
+	return disasm.Instr{Op: op}
+}()
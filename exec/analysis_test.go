@@ -0,0 +1,151 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/ontio/wagon/wasm"
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// moduleAnalysis has a memory and a table, and defines the functions:
+//
+//	0 leaf()        i32.const 1, drop
+//	1 branch()      calls leaf if 0 is true, or runs a nop
+//	2 loop(i32) i32 loops while its argument is true, and returns it
+//	3 rec()         calls itself
+//	4 caller()      calls rec and branch
+//	5 tail()        tail calls branch
+//	6 grow(i32) i32 grows the memory by its argument
+//	7 ind(i32) i32  calls through the table a function of its own type
+var moduleAnalysis = moduleBytes(
+	section(0x01, 0x02, 0x60, 0x00, 0x00, 0x60, 0x01, 0x7f, 0x01, 0x7f),
+	section(0x03, 0x08, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01, 0x01),
+	section(0x04, 0x01, 0x70, 0x00, 0x01),
+	section(0x05, 0x01, 0x00, 0x01),
+	section(0x0a, bytes.Join([][]byte{
+		{0x08},
+		funcBody(0x41, 0x01, 0x1a),
+		funcBody(0x41, 0x00, 0x04, 0x40, 0x10, 0x00, 0x05, 0x01, 0x0b),
+		funcBody(0x03, 0x40, 0x20, 0x00, 0x0d, 0x00, 0x0b, 0x20, 0x00),
+		funcBody(0x10, 0x03),
+		funcBody(0x10, 0x03, 0x10, 0x01),
+		funcBody(0x12, 0x01),
+		funcBody(0x20, 0x00, 0x40, 0x00),
+		funcBody(0x20, 0x00, 0x41, 0x00, 0x11, 0x01, 0x00),
+	}, nil)...),
+)
+
+func TestAnalyzeModule(t *testing.T) {
+	m, err := wasm.ReadModule(bytes.NewReader(moduleAnalysis), nil)
+	if err != nil {
+		t.Fatalf("could not read module: %v", err)
+	}
+	costs, err := AnalyzeModule(m, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every instruction costs one unit, including the end of the
+	// blocks and the nop appended to the function bodies by the compiler.
+	want := []FunctionCost{
+		{MaxStackDepth: 1, FrameSize: 2, Gas: 3, MaxGas: 3, Bounded: true, MaxFrame: 2, MaxStack: 2},
+		{MaxStackDepth: 1, FrameSize: 2, Calls: []uint32{0}, Gas: 6, MaxGas: 9, Bounded: true, MaxFrame: 2, MaxStack: 4},
+		{MaxStackDepth: 1, Locals: 1, FrameSize: 3, Loops: true, Gas: 6, MaxGas: 6, MaxFrame: 3, MaxStack: 3},
+		{FrameSize: 1, Calls: []uint32{3}, Recursive: true, MayRecurse: true, Gas: 2, MaxGas: 2, MaxFrame: 1},
+		{FrameSize: 1, Calls: []uint32{1, 3}, MayRecurse: true, Gas: 3, MaxGas: 14, MaxFrame: 2},
+		{FrameSize: 1, Calls: []uint32{1}, Gas: 2, MaxGas: 11, Bounded: true, MaxFrame: 2, MaxStack: 4},
+		{MaxStackDepth: 1, Locals: 1, FrameSize: 3, Dynamic: true, Gas: 3, MaxGas: 3, MaxFrame: 3, MaxStack: 3},
+		{
+			MaxStackDepth: 2, Locals: 1, FrameSize: 4, Calls: []uint32{2, 6, 7}, Indirect: true,
+			Recursive: true, MayRecurse: true, Gas: 4, MaxGas: 10, MaxFrame: 4,
+		},
+	}
+	if len(costs) != len(want) {
+		t.Fatalf("got %d functions, want %d", len(costs), len(want))
+	}
+	for i := range want {
+		if !reflect.DeepEqual(costs[i], want[i]) {
+			t.Errorf("function %d:\ngot  %+v\nwant %+v", i, costs[i], want[i])
+		}
+	}
+
+	for _, tc := range []struct {
+		fn         int
+		frameLimit int
+		want       uint64
+	}{
+		{0, 100, 2},
+		{1, 100, 4},
+		{1, 1, 2},
+		{3, 100, 100},
+		{4, 100, 200},
+		{5, 100, 4},
+		{7, 10, 40},
+	} {
+		if got := costs[tc.fn].StackBound(tc.frameLimit); got != tc.want {
+			t.Errorf("function %d: StackBound(%d) = %d, want %d", tc.fn, tc.frameLimit, got, tc.want)
+		}
+	}
+
+	v1, err := NewGasSchedule(GasScheduleV1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	costs, err = AnalyzeModule(m, v1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := costs[1]; got.Gas != 16 || got.MaxGas != 19 {
+		t.Errorf("function 1 under GasScheduleV1: got Gas %d and MaxGas %d, want 16 and 19", got.Gas, got.MaxGas)
+	}
+}
+
+// TestAnalyzeModuleGas checks that the gas charged by the bounded functions
+// does not exceed their MaxGas.
+func TestAnalyzeModuleGas(t *testing.T) {
+	m, err := wasm.ReadModule(bytes.NewReader(moduleAnalysis), nil)
+	if err != nil {
+		t.Fatalf("could not read module: %v", err)
+	}
+	for _, version := range []uint32{GasScheduleV0, GasScheduleV1} {
+		schedule, err := NewGasSchedule(version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		schedule.MemoryPageCost = 0
+		costs, err := AnalyzeModule(m, schedule)
+		if err != nil {
+			t.Fatal(err)
+		}
+		compiled, err := CompileModuleWithFeatures(m, schedule, ^ops.Features(0))
+		if err != nil {
+			t.Fatalf("could not compile module: %v", err)
+		}
+		vm, err := NewVMWithCompiled(compiled, math.MaxUint64)
+		if err != nil {
+			t.Fatalf("could not instantiate vm: %v", err)
+		}
+		for i, cost := range costs {
+			if !cost.Bounded {
+				continue
+			}
+			gasLimit := uint64(math.MaxUint64)
+			execStep := uint64(math.MaxUint64)
+			vm.ExecMetrics = &Gas{GasPrice: 1, GasLimit: &gasLimit, GasFactor: 1, ExecStep: &execStep}
+			vm.CallStackDepth = 10
+			if _, err := vm.ExecCode(int64(i)); err != nil {
+				t.Fatalf("function %d: %v", i, err)
+			}
+			if got := math.MaxUint64 - execStep; got > cost.MaxGas {
+				t.Errorf("schedule %d, function %d: charged %d, more than MaxGas %d", version, i, got, cost.MaxGas)
+			}
+		}
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"errors"
+
+	"github.com/ontio/wagon/wasm"
+)
+
+// ErrOutOfBoundsTableAccess is the error value used while trapping the VM
+// when it detects an out of bounds access to a table or an element segment.
+var ErrOutOfBoundsTableAccess = errors.New("exec: out of bounds table access")
+
+// dataSegments returns the contents of the data segments of module, as seen
+// by memory.init. Active segments are dropped once the module is
+// instantiated, so only passive segments are non-nil.
+func dataSegments(module *wasm.Module) [][]byte {
+	if module.Data == nil {
+		return nil
+	}
+	segs := make([][]byte, len(module.Data.Entries))
+	for i, entry := range module.Data.Entries {
+		if entry.Passive {
+			segs[i] = entry.Data
+		}
+	}
+	return segs
+}
+
+// elemSegments returns the contents of the element segments of module, as
+// seen by table.init. Only passive segments are non-nil.
+func elemSegments(module *wasm.Module) ([][]wasm.TableEntry, error) {
+	if module.Elements == nil {
+		return nil, nil
+	}
+	segs := make([][]wasm.TableEntry, len(module.Elements.Entries))
+	for i, entry := range module.Elements.Entries {
+		if !entry.Passive {
+			continue
+		}
+		entries, err := entry.Entries(module)
+		if err != nil {
+			return nil, err
+		}
+		segs[i] = entries
+	}
+	return segs, nil
+}
+
+// writableTables returns the tables of the VM, after copying them the first
+// time they are modified, since they are shared with the module.
+func (vm *VM) writableTables() [][]wasm.TableEntry {
+	if !vm.ownTables {
+		tables := make([][]wasm.TableEntry, len(vm.tables))
+		for i, t := range vm.tables {
+			tables[i] = append([]wasm.TableEntry(nil), t...)
+		}
+		vm.tables = tables
+		vm.ownTables = true
+	}
+	return vm.tables
+}
+
+// inRange reports whether the n items starting at off fit in a sequence of
+// length size.
+func inRange(off, n uint32, size int) bool {
+	return uint64(off)+uint64(n) <= uint64(size)
+}
+
+// bulkOperands returns the destination, the source or value and the length
+// operands of a bulk operator, without popping them.
+func (vm *VM) bulkOperands() (dst, src, n uint32) {
+	args := vm.ctx.stack[len(vm.ctx.stack)-3:]
+	return uint32(args[0]), uint32(args[1]), uint32(args[2])
+}
+
+// chargeBulk charges cost for a bulk operator and pops its operands. If the
+// execution is suspended before the gas is paid, it returns false and leaves
+// the operands on the stack, so that the operator can be executed again when
+// the execution is resumed.
+func (vm *VM) chargeBulk(cost uint64) bool {
+	if cost != 0 && !vm.charge(cost) {
+		return false
+	}
+	vm.ctx.stack = vm.ctx.stack[:len(vm.ctx.stack)-3]
+	return true
+}
+
+// bulkBytesCost returns the gas charged for writing n bytes of linear memory
+// with a bulk operator.
+func (vm *VM) bulkBytesCost(n uint32) uint64 {
+	if vm.gas == nil {
+		return 0
+	}
+	return unitsCost(uint64(n), vm.gas.BulkByteCost)
+}
+
+// tableElemsCost returns the gas charged for writing n table elements.
+func (vm *VM) tableElemsCost(n uint32) uint64 {
+	if vm.gas == nil {
+		return 0
+	}
+	return unitsCost(uint64(n), vm.gas.TableElemCost)
+}
+
+func (vm *VM) dataDrop() {
+	vm.data[vm.fetchUint32()] = nil
+}
+
+func (vm *VM) elemDrop() {
+	vm.elems[vm.fetchUint32()] = nil
+}
+
+func (vm *VM) memoryInit() {
+	seg := vm.data[vm.fetchUint32()]
+	_ = vm.fetchUint32() // reserved memory index
+	dst, src, n := vm.bulkOperands()
+	if !inRange(src, n, len(seg)) || !inRange(dst, n, len(vm.memory)) {
+		panic(ErrOutOfBoundsMemoryAccess)
+	}
+	if !vm.chargeBulk(vm.bulkBytesCost(n)) {
+		return
+	}
+	copy(vm.memory[dst:], seg[src:src+n])
+}
+
+func (vm *VM) memoryCopy() {
+	_ = vm.fetchUint32() // reserved memory indices
+	_ = vm.fetchUint32()
+	dst, src, n := vm.bulkOperands()
+	if !inRange(src, n, len(vm.memory)) || !inRange(dst, n, len(vm.memory)) {
+		panic(ErrOutOfBoundsMemoryAccess)
+	}
+	if !vm.chargeBulk(vm.bulkBytesCost(n)) {
+		return
+	}
+	copy(vm.memory[dst:dst+n], vm.memory[src:src+n])
+}
+
+func (vm *VM) memoryFill() {
+	_ = vm.fetchUint32() // reserved memory index
+	dst, val, n := vm.bulkOperands()
+	if !inRange(dst, n, len(vm.memory)) {
+		panic(ErrOutOfBoundsMemoryAccess)
+	}
+	if !vm.chargeBulk(vm.bulkBytesCost(n)) {
+		return
+	}
+	mem := vm.memory[dst : dst+n]
+	for i := range mem {
+		mem[i] = byte(val)
+	}
+}
+
+func (vm *VM) tableInit() {
+	seg := vm.elems[vm.fetchUint32()]
+	table := vm.fetchUint32()
+	dst, src, n := vm.bulkOperands()
+	if !inRange(src, n, len(seg)) || !inRange(dst, n, len(vm.tables[table])) {
+		panic(ErrOutOfBoundsTableAccess)
+	}
+	if !vm.chargeBulk(vm.tableElemsCost(n)) {
+		return
+	}
+	copy(vm.writableTables()[table][dst:dst+n], seg[src:src+n])
+}
+
+func (vm *VM) tableCopy() {
+	dstTable := vm.fetchUint32()
+	srcTable := vm.fetchUint32()
+	dst, src, n := vm.bulkOperands()
+	if !inRange(src, n, len(vm.tables[srcTable])) || !inRange(dst, n, len(vm.tables[dstTable])) {
+		panic(ErrOutOfBoundsTableAccess)
+	}
+	if !vm.chargeBulk(vm.tableElemsCost(n)) {
+		return
+	}
+	tables := vm.writableTables()
+	copy(tables[dstTable][dst:dst+n], tables[srcTable][src:src+n])
+}
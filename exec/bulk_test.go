@@ -0,0 +1,206 @@
+// Copyright 2020 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"bytes"
+	"testing"
+
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// funcBody encodes the body of a function without locals.
+func funcBody(code ...byte) []byte {
+	return append([]byte{byte(len(code) + 2), 0x00}, append(code, 0x0b)...)
+}
+
+// getArgs pushes the three parameters of a function.
+var getArgs = []byte{0x20, 0x00, 0x20, 0x01, 0x20, 0x02}
+
+// moduleBulk has a one page memory, a passive data segment holding "hello",
+// a table of 4 elements and a passive element segment holding functions 9
+// and 10, which return 10 and 20. Functions 0 to 8 are:
+//
+//	init(d, s, n)  memory.init 0
+//	drop()         data.drop 0
+//	copy(d, s, n)  memory.copy
+//	fill(d, v, n)  memory.fill
+//	load(a) i32    i32.load8_u
+//	tinit(d, s, n) table.init 0
+//	edrop()        elem.drop 0
+//	tcopy(d, s, n) table.copy
+//	call(i) i32    call_indirect () i32
+var moduleBulk = moduleBytes(
+	section(0x01, 0x04,
+		0x60, 0x03, 0x7f, 0x7f, 0x7f, 0x00,
+		0x60, 0x00, 0x00,
+		0x60, 0x01, 0x7f, 0x01, 0x7f,
+		0x60, 0x00, 0x01, 0x7f),
+	section(0x03, 0x0b, 0x00, 0x01, 0x00, 0x00, 0x02, 0x00, 0x01, 0x00, 0x02, 0x03, 0x03),
+	section(0x04, 0x01, 0x70, 0x00, 0x04),
+	section(0x05, 0x01, 0x00, 0x01),
+	section(0x09, 0x01, 0x01, 0x00, 0x02, 0x09, 0x0a),
+	section(0x0c, 0x01),
+	section(0x0a, bytes.Join([][]byte{
+		{0x0b},
+		funcBody(append(getArgs, 0xfc, 0x08, 0x00, 0x00)...),
+		funcBody(0xfc, 0x09, 0x00),
+		funcBody(append(getArgs, 0xfc, 0x0a, 0x00, 0x00)...),
+		funcBody(append(getArgs, 0xfc, 0x0b, 0x00)...),
+		funcBody(0x20, 0x00, 0x2d, 0x00, 0x00),
+		funcBody(append(getArgs, 0xfc, 0x0c, 0x00, 0x00)...),
+		funcBody(0xfc, 0x0d, 0x00),
+		funcBody(append(getArgs, 0xfc, 0x0e, 0x00, 0x00)...),
+		funcBody(0x20, 0x00, 0x11, 0x03, 0x00),
+		funcBody(0x41, 0x0a),
+		funcBody(0x41, 0x14),
+	}, nil)...),
+	section(0x0b, 0x01, 0x01, 0x05, 'h', 'e', 'l', 'l', 'o'),
+)
+
+const (
+	bulkInit = iota
+	bulkDrop
+	bulkCopy
+	bulkFill
+	bulkLoad
+	bulkTableInit
+	bulkElemDrop
+	bulkTableCopy
+	bulkCall
+)
+
+func TestBulkMemoryDisabled(t *testing.T) {
+	want := ops.DisabledPrefixedOpcodeError{Prefix: ops.MiscPrefix, Sub: ops.MemoryInit}
+	if _, err := CompileModule(readTestModule(t, moduleBulk, nil), nil); err != want {
+		t.Fatalf("got error %v, want %v", err, want)
+	}
+}
+
+func TestBulkMemory(t *testing.T) {
+	compiled := compileTestModuleWithFeatures(t, moduleBulk, nil, ops.FeatureBulkMemory)
+	vm := newTestVM(t, compiled)
+
+	mustExec(t, vm, bulkInit, 10, 1, 4)
+	mustExec(t, vm, bulkCopy, 12, 10, 4)
+	mustExec(t, vm, bulkFill, 20, 'x', 3)
+	if got, want := string(vm.Memory()[10:23]), "elello\x00\x00\x00\x00xxx"; got != want {
+		t.Errorf("got memory %q, want %q", got, want)
+	}
+	if got := mustExec(t, vm, bulkLoad, 21); got != uint32('x') {
+		t.Errorf("load(21) = %v, want %v", got, uint32('x'))
+	}
+
+	_, err := vm.ExecCode(bulkInit, 0, 3, 3)
+	checkTrap(t, err, TrapMemoryOutOfBounds)
+	_, err = vm.ExecCode(bulkCopy, wasmPageSize-1, 0, 2)
+	checkTrap(t, err, TrapMemoryOutOfBounds)
+	_, err = vm.ExecCode(bulkFill, wasmPageSize+1, 0, 0)
+	checkTrap(t, err, TrapMemoryOutOfBounds)
+
+	// A dropped segment behaves like an empty one.
+	mustExec(t, vm, bulkDrop)
+	mustExec(t, vm, bulkInit, 0, 0, 0)
+	_, err = vm.ExecCode(bulkInit, 0, 0, 1)
+	checkTrap(t, err, TrapMemoryOutOfBounds)
+
+	_, err = vm.ExecCode(bulkCall, 1)
+	checkTrap(t, err, TrapUninitializedElement)
+	mustExec(t, vm, bulkTableInit, 1, 0, 2)
+	mustExec(t, vm, bulkTableCopy, 3, 1, 1)
+	for i, want := range []uint32{10, 20, 10} {
+		if got := mustExec(t, vm, bulkCall, uint64(i+1)); got != want {
+			t.Errorf("call(%d) = %v, want %v", i+1, got, want)
+		}
+	}
+	_, err = vm.ExecCode(bulkTableCopy, 3, 2, 2)
+	checkTrap(t, err, TrapTableOutOfBounds)
+	mustExec(t, vm, bulkElemDrop)
+	_, err = vm.ExecCode(bulkTableInit, 0, 0, 1)
+	checkTrap(t, err, TrapTableOutOfBounds)
+
+	// The table of the module is not modified.
+	other := newTestVM(t, compiled)
+	_, err = other.ExecCode(bulkCall, 1)
+	checkTrap(t, err, TrapUninitializedElement)
+	mustExec(t, other, bulkTableInit, 0, 0, 1)
+}
+
+func TestBulkMemoryGas(t *testing.T) {
+	for _, version := range []uint32{GasScheduleV0, GasScheduleV2} {
+		schedule, err := NewGasSchedule(version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if schedule.BulkByteCost == 0 || schedule.TableElemCost == 0 {
+			t.Errorf("schedule %d does not charge for bulk operators", version)
+		}
+		vm := newTestVM(t, compileTestModuleWithFeatures(t, moduleBulk, schedule, ops.FeatureBulkMemory))
+
+		used := func(fn int64, args ...uint64) uint64 {
+			before := *vm.ExecMetrics.ExecStep
+			mustExec(t, vm, fn, args...)
+			return before - *vm.ExecMetrics.ExecStep
+		}
+		base := used(bulkFill, 0, 0, 0)
+		if got, want := used(bulkFill, 0, 0, wasmPageSize), base+wasmPageSize*schedule.BulkByteCost; got != want {
+			t.Errorf("schedule %d: memory.fill of 64KiB used %d gas, want %d", version, got, want)
+		}
+		if got, want := used(bulkCopy, 0, 100, 500), base+500*schedule.BulkByteCost; got != want {
+			t.Errorf("schedule %d: memory.copy of 500 bytes used %d gas, want %d", version, got, want)
+		}
+		if got, want := used(bulkTableInit, 0, 0, 2), base+2*schedule.TableElemCost; got != want {
+			t.Errorf("schedule %d: table.init of 2 elements used %d gas, want %d", version, got, want)
+		}
+	}
+}
+
+func TestBulkMemoryResume(t *testing.T) {
+	vm := newTestVM(t, compileTestModuleWithFeatures(t, moduleBulk, nil, ops.FeatureBulkMemory))
+
+	// Running out of steps suspends the VM before memory.fill writes to the
+	// memory.
+	*vm.ExecMetrics.ExecStep = 100
+	_, err := vm.ExecCode(bulkFill, 0, 'y', 1000)
+	checkTrap(t, err, TrapOutOfGas)
+	if !vm.Suspended() {
+		t.Fatal("execution is not suspended")
+	}
+	if vm.Memory()[0] != 0 {
+		t.Error("memory.fill wrote to the memory without paying for it")
+	}
+
+	// The resumed execution pays for the bytes once, and for the five
+	// instructions of fill.
+	*vm.ExecMetrics.ExecStep = 2000
+	if _, err := vm.Resume(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := 2000-*vm.ExecMetrics.ExecStep, uint64(1000+5); got != want {
+		t.Errorf("resumed memory.fill used %d gas, want %d", got, want)
+	}
+	if got, want := string(vm.Memory()[999:1001]), "y\x00"; got != want {
+		t.Errorf("memory holds %q after the fill, want %q", got, want)
+	}
+}
+
+func TestBulkMemorySnapshot(t *testing.T) {
+	compiled := compileTestModuleWithFeatures(t, moduleBulk, nil, ops.FeatureBulkMemory)
+	vm := newTestVM(t, compiled)
+	mustExec(t, vm, bulkTableInit, 0, 0, 2)
+	mustExec(t, vm, bulkDrop)
+	s := vm.Snapshot()
+
+	restored := newTestVM(t, compiled)
+	if err := restored.Restore(s); err != nil {
+		t.Fatalf("could not restore snapshot: %v", err)
+	}
+	if got := mustExec(t, restored, bulkCall, 1); got != uint32(20) {
+		t.Errorf("call(1) = %v, want 20", got)
+	}
+	_, err := restored.ExecCode(bulkInit, 0, 0, 1)
+	checkTrap(t, err, TrapMemoryOutOfBounds)
+	mustExec(t, restored, bulkTableInit, 0, 0, 1)
+}
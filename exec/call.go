@@ -20,31 +20,34 @@ var (
 	ErrUndefinedElementIndex = errors.New("exec: undefined element index")
 	// check call stack depth
 	ErrCallStackDepthExceed = errors.New("exec: call stack depth exceeded")
+	// ErrArenaSlotsExceed is the error value used while trapping the VM when
+	// the frames of the nested calls take more than VM.MaxArenaSlots slots.
+	ErrArenaSlotsExceed = errors.New("exec: arena slots exceeded")
 )
 
 func (vm *VM) call() {
-	vm.checkCallStackDepth()
-	defer func() {
-		vm.CallStackDepth++
-	}()
 	index := vm.fetchUint32()
 
 	vm.funcs[index].call(vm, int64(index))
 }
 
 func (vm *VM) callIndirect() {
-	vm.checkCallStackDepth()
-	defer func() {
-		vm.CallStackDepth++
-	}()
+	elemIndex := vm.indirectCallee()
+	vm.funcs[elemIndex].call(vm, int64(elemIndex))
+}
+
+// indirectCallee reads the immediates of call_indirect or
+// return_call_indirect, pops the table index and returns the index of the
+// function to call, after checking its signature.
+func (vm *VM) indirectCallee() uint32 {
 	index := vm.fetchUint32()
 	fnExpect := vm.module.Types.Entries[index]
-	_ = vm.fetchUint32() // reserved (https://github.com/WebAssembly/design/blob/27ac254c854994103c24834a994be16f74f54186/BinaryEncoding.md#call-operators-described-here)
+	table := vm.tables[vm.fetchUint32()]
 	tableIndex := vm.popUint32()
-	if int(tableIndex) >= len(vm.module.TableIndexSpace[0]) {
+	if int(tableIndex) >= len(table) {
 		panic(ErrUndefinedElementIndex)
 	}
-	tableEntry := vm.module.TableIndexSpace[0][tableIndex]
+	tableEntry := table[tableIndex]
 	if !tableEntry.Initialized {
 		panic(wasm.UninitializedTableEntryError(tableIndex))
 	}
@@ -69,6 +72,32 @@ func (vm *VM) callIndirect() {
 			panic(ErrSignatureMismatch)
 		}
 	}
+	return elemIndex
+}
 
-	vm.funcs[elemIndex].call(vm, int64(elemIndex))
+func (vm *VM) returnCall() {
+	vm.tailCall(int64(vm.fetchUint32()))
+}
+
+func (vm *VM) returnCallIndirect() {
+	vm.tailCall(int64(vm.indirectCallee()))
+}
+
+// tailCall calls the function at index in place of the current function.
+// A compiled function reuses the frame of the current function, so that tail
+// calls do not count against the call stack depth. A host function is called
+// like with call, and the return following the tail call in the compiled
+// code returns its results.
+func (vm *VM) tailCall(index int64) {
+	compiled, ok := vm.funcs[index].(compiledFunction)
+	if !ok {
+		vm.funcs[index].call(vm, index)
+		return
+	}
+
+	// The arguments may overlap the locals of the callee, which are
+	// zeroed by newContext, so they are saved first.
+	vm.tailArgs = append(vm.tailArgs[:0], vm.ctx.stack[len(vm.ctx.stack)-compiled.args:]...)
+	vm.ctx = vm.newContext(compiled, index, vm.ctx.base)
+	copy(vm.ctx.locals, vm.tailArgs)
 }
@@ -7,6 +7,7 @@ package exec
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math"
 	"reflect"
@@ -55,7 +56,7 @@ func TestHostCall(t *testing.T) {
 		// code should disassemble to:
 		// call 1 (which is host)
 		// end
-		Code: []byte{0x02, 0x00, 0x10, 0x01, 0x0b},
+		Code: []byte{0x02, 0x40, 0x10, 0x01, 0x0b},
 	}
 
 	// There was no call to `ReadModule` so this part emulates
@@ -215,7 +216,8 @@ func TestGoFunctionCallChecksForFirstArgument(t *testing.T) {
 		if r := recover(); r == nil {
 			t.Errorf("This code should have panicked.")
 		} else {
-			if r != "exec: the first argument of a host function was int32, expected ptr" {
+			if trap, ok := r.(*Trap); !ok || trap.Code != TrapInternal ||
+				trap.Err.Error() != "exec: the first argument of a host function was int32, expected ptr" {
 				t.Errorf("This should have panicked because of the wrong type being used as a first argument, and it panicked because of %v", r)
 			}
 		}
@@ -284,7 +286,7 @@ func TestInfiniteRecursion(t *testing.T) {
 	}
 	// this code follows ontology/smartcontract/wasmvm/wasm_service.go:Invoke(), with
 	// some error checking removed for brevity.
-	compiled, err := CompileModule(m)
+	compiled, err := CompileModule(m, nil)
 	if err != nil {
 		panic(err)
 	}
@@ -300,5 +302,5 @@ func TestInfiniteRecursion(t *testing.T) {
 	entry, _ := compiled.RawModule.Export.Entries["invoke"]
 	index := int64(entry.Index)
 	_, err = vm.ExecCode(index, 0)
-	assert.Equal(t, err, ErrCallStackDepthExceed)
+	assert.True(t, errors.Is(err, ErrCallStackDepthExceed))
 }
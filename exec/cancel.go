@@ -0,0 +1,62 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	gocontext "context"
+)
+
+// ExecCodeContext is like ExecCode, but stops the execution once ctx is
+// done. ctx is checked at the gas counters inserted by the compiler and at
+// the back edges of loops, so ctx may be canceled from another goroutine,
+// e.g. by context.WithTimeout, to bound the wall-clock time of an execution.
+//
+// If ctx is done before the execution finishes, a *Trap with code
+// TrapCanceled wrapping ctx.Err() is returned and the VM is left suspended,
+// so the execution can be continued with Resume or ResumeContext. If ctx
+// is already done, nothing is executed and the trap has no backtrace.
+func (vm *VM) ExecCodeContext(ctx gocontext.Context, fnIndex int64, args ...uint64) (interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, canceledTrap(err)
+	}
+	vm.done, vm.doneErr = ctx.Done(), ctx.Err
+	defer vm.clearDone()
+	return vm.ExecCode(fnIndex, args...)
+}
+
+// ResumeContext is like Resume, but stops the execution once ctx is done,
+// as described for ExecCodeContext.
+func (vm *VM) ResumeContext(ctx gocontext.Context, results ...uint64) (interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, canceledTrap(err)
+	}
+	vm.done, vm.doneErr = ctx.Done(), ctx.Err
+	defer vm.clearDone()
+	return vm.Resume(results...)
+}
+
+// canceledTrap returns the trap for a context which is done before the
+// execution starts.
+func canceledTrap(err error) *Trap {
+	return &Trap{Code: TrapCanceled, Func: -1, Offset: -1, Err: err}
+}
+
+func (vm *VM) clearDone() {
+	vm.done, vm.doneErr = nil, nil
+}
+
+// interrupt returns nil if the context of the execution is not done.
+// Otherwise it suspends the VM and returns the cancellation trap.
+func (vm *VM) interrupt() error {
+	select {
+	case <-vm.done:
+	default:
+		return nil
+	}
+	trap := vm.newTrap(vm.doneErr())
+	trap.Code = TrapCanceled
+	vm.suspended = true
+	return trap
+}
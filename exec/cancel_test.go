@@ -0,0 +1,100 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	gocontext "context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// moduleLoop defines loop(), which never returns.
+var moduleLoop = moduleBytes(
+	section(0x01, 0x01, 0x60, 0x00, 0x00),
+	section(0x03, 0x01, 0x00),
+	section(0x0a, 0x01, 0x07, 0x00, 0x03, 0x40, 0x0c, 0x00, 0x0b, 0x0b),
+)
+
+func checkCanceled(t *testing.T, vm *VM, err, cause error) {
+	t.Helper()
+	var trap *Trap
+	if !errors.As(err, &trap) || trap.Code != TrapCanceled {
+		t.Fatalf("got error %v, want a cancellation trap", err)
+	}
+	if !errors.Is(err, cause) {
+		t.Errorf("trap %v is not caused by %v", err, cause)
+	}
+	if trap.Func != 0 || trap.Backtrace[0].Name != "" {
+		t.Errorf("trap location is function %d %q", trap.Func, trap.Backtrace[0].Name)
+	}
+	if !vm.Suspended() {
+		t.Error("canceled execution is not suspended")
+	}
+}
+
+func TestExecCodeContextTimeout(t *testing.T) {
+	vm := newTestVM(t, compileTestModule(t, moduleLoop, nil))
+	ctx, cancel := gocontext.WithTimeout(gocontext.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := vm.ExecCodeContext(ctx, 0)
+	checkCanceled(t, vm, err, gocontext.DeadlineExceeded)
+
+	// The context of the execution only applies to the call it was given to.
+	*vm.ExecMetrics.ExecStep = 1000
+	if _, err := vm.Resume(); !errors.Is(err, ErrExecStepExhausted) {
+		t.Errorf("got error %v, want %v", err, ErrExecStepExhausted)
+	}
+}
+
+func TestExecCodeContextCancel(t *testing.T) {
+	vm := newTestVM(t, compileTestModule(t, moduleLoop, nil))
+	ctx, cancel := gocontext.WithCancel(gocontext.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	_, err := vm.ExecCodeContext(ctx, 0)
+	checkCanceled(t, vm, err, gocontext.Canceled)
+
+	var trap *Trap
+	if _, err := vm.ResumeContext(ctx); !errors.As(err, &trap) || trap.Code != TrapCanceled || !errors.Is(err, gocontext.Canceled) {
+		t.Errorf("got error %v, want a cancellation trap", err)
+	}
+	if !vm.Suspended() {
+		t.Error("resuming with a canceled context discarded the execution")
+	}
+}
+
+func TestExecCodeContextCanceledBefore(t *testing.T) {
+	vm := newTestVM(t, compileTestModule(t, moduleSum, nil))
+	ctx, cancel := gocontext.WithCancel(gocontext.Background())
+	cancel()
+	_, err := vm.ExecCodeContext(ctx, 0, 100)
+	var trap *Trap
+	if !errors.As(err, &trap) || trap.Code != TrapCanceled || !errors.Is(err, gocontext.Canceled) {
+		t.Fatalf("got error %v, want a cancellation trap", err)
+	}
+	if trap.Func != -1 || len(trap.Backtrace) != 0 {
+		t.Errorf("trap of a canceled context has location %d %v", trap.Func, trap.Backtrace)
+	}
+	if vm.Suspended() {
+		t.Error("execution was started with a canceled context")
+	}
+	if err.Error() != gocontext.Canceled.Error() {
+		t.Errorf("got error message %q", err.Error())
+	}
+}
+
+func TestExecCodeContextDone(t *testing.T) {
+	vm := newTestVM(t, compileTestModule(t, moduleSum, nil))
+	res, err := vm.ExecCodeContext(gocontext.Background(), 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != uint32(10100) {
+		t.Errorf("sum(100) = %v, want 10100", res)
+	}
+}
@@ -13,9 +13,9 @@ func (vm *VM) i64Const() {
 }
 
 func (vm *VM) f32Const() {
-	vm.pushFloat32(vm.fetchFloat32())
+	vm.pushUint32(vm.fetchUint32())
 }
 
 func (vm *VM) f64Const() {
-	vm.pushFloat64(vm.fetchFloat64())
+	vm.pushUint64(vm.fetchUint64())
 }
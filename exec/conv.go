@@ -5,27 +5,54 @@
 package exec
 
 import (
+	"errors"
 	"math"
 )
 
+var (
+	// ErrInvalidConversionToInteger is the error value used while trapping
+	// the VM when it attempts to truncate a NaN to an integer.
+	ErrInvalidConversionToInteger = errors.New("exec: invalid conversion to integer")
+	// ErrIntegerOverflow is the error value used while trapping the VM when
+	// a truncated floating-point value does not fit in the destination
+	// integer type.
+	ErrIntegerOverflow = errors.New("exec: integer overflow")
+)
+
 func (vm *VM) i32Wrapi64() {
 	vm.pushUint32(uint32(vm.popUint64()))
 }
 
 func (vm *VM) i32TruncSF32() {
-	vm.pushInt32(int32(math.Trunc(float64(vm.popFloat32()))))
+	vm.pushUint32(uint32(vm.truncF32(vm.popUint32(), math.MaxInt32, 1<<31)))
 }
 
 func (vm *VM) i32TruncUF32() {
-	vm.pushUint32(uint32(math.Trunc(float64(vm.popFloat32()))))
+	vm.pushUint32(uint32(vm.truncF32(vm.popUint32(), math.MaxUint32, 0)))
 }
 
 func (vm *VM) i32TruncSF64() {
-	vm.pushInt32(int32(math.Trunc(vm.popFloat64())))
+	vm.pushUint32(uint32(vm.truncF64(vm.popUint64(), math.MaxInt32, 1<<31)))
 }
 
 func (vm *VM) i32TruncUF64() {
-	vm.pushUint32(uint32(math.Trunc(vm.popFloat64())))
+	vm.pushUint32(uint32(vm.truncF64(vm.popUint64(), math.MaxUint32, 0)))
+}
+
+func (vm *VM) i32TruncSatSF32() {
+	vm.pushUint32(uint32(vm.truncSatF32(vm.popUint32(), math.MaxInt32, 1<<31)))
+}
+
+func (vm *VM) i32TruncSatUF32() {
+	vm.pushUint32(uint32(vm.truncSatF32(vm.popUint32(), math.MaxUint32, 0)))
+}
+
+func (vm *VM) i32TruncSatSF64() {
+	vm.pushUint32(uint32(vm.truncSatF64(vm.popUint64(), math.MaxInt32, 1<<31)))
+}
+
+func (vm *VM) i32TruncSatUF64() {
+	vm.pushUint32(uint32(vm.truncSatF64(vm.popUint64(), math.MaxUint32, 0)))
 }
 
 func (vm *VM) i64ExtendSI32() {
@@ -36,58 +63,163 @@ func (vm *VM) i64ExtendUI32() {
 	vm.pushUint64(uint64(vm.popUint32()))
 }
 
+func (vm *VM) i32Extend8S() {
+	vm.pushUint32(uint32(int8(vm.popUint32())))
+}
+
+func (vm *VM) i32Extend16S() {
+	vm.pushUint32(uint32(int16(vm.popUint32())))
+}
+
+func (vm *VM) i64Extend8S() {
+	vm.pushInt64(int64(int8(vm.popInt64())))
+}
+
+func (vm *VM) i64Extend16S() {
+	vm.pushInt64(int64(int16(vm.popInt64())))
+}
+
+func (vm *VM) i64Extend32S() {
+	vm.pushInt64(int64(int32(vm.popInt64())))
+}
+
 func (vm *VM) i64TruncSF32() {
-	vm.pushInt64(int64(math.Trunc(float64(vm.popFloat32()))))
+	vm.pushUint64(vm.truncF32(vm.popUint32(), math.MaxInt64, 1<<63))
 }
 
 func (vm *VM) i64TruncUF32() {
-	vm.pushUint64(uint64(math.Trunc(float64(vm.popFloat32()))))
+	vm.pushUint64(vm.truncF32(vm.popUint32(), math.MaxUint64, 0))
 }
 
 func (vm *VM) i64TruncSF64() {
-	vm.pushInt64(int64(math.Trunc(vm.popFloat64())))
+	vm.pushUint64(vm.truncF64(vm.popUint64(), math.MaxInt64, 1<<63))
 }
 
 func (vm *VM) i64TruncUF64() {
-	vm.pushUint64(uint64(math.Trunc(vm.popFloat64())))
+	vm.pushUint64(vm.truncF64(vm.popUint64(), math.MaxUint64, 0))
+}
+
+func (vm *VM) i64TruncSatSF32() {
+	vm.pushUint64(vm.truncSatF32(vm.popUint32(), math.MaxInt64, 1<<63))
+}
+
+func (vm *VM) i64TruncSatUF32() {
+	vm.pushUint64(vm.truncSatF32(vm.popUint32(), math.MaxUint64, 0))
+}
+
+func (vm *VM) i64TruncSatSF64() {
+	vm.pushUint64(vm.truncSatF64(vm.popUint64(), math.MaxInt64, 1<<63))
+}
+
+func (vm *VM) i64TruncSatUF64() {
+	vm.pushUint64(vm.truncSatF64(vm.popUint64(), math.MaxUint64, 0))
 }
 
 func (vm *VM) f32ConvertSI32() {
-	vm.pushFloat32(float32(vm.popInt32()))
+	vm.pushUint32(vm.fpu().f32FromInt(signMagnitude(int64(vm.popInt32()))))
 }
 
 func (vm *VM) f32ConvertUI32() {
-	vm.pushFloat32(float32(vm.popUint32()))
+	vm.pushUint32(vm.fpu().f32FromInt(false, uint64(vm.popUint32())))
 }
 
 func (vm *VM) f32ConvertSI64() {
-	vm.pushFloat32(float32(vm.popInt64()))
+	vm.pushUint32(vm.fpu().f32FromInt(signMagnitude(vm.popInt64())))
 }
 
 func (vm *VM) f32ConvertUI64() {
-	vm.pushFloat32(float32(vm.popUint64()))
+	vm.pushUint32(vm.fpu().f32FromInt(false, vm.popUint64()))
 }
 
 func (vm *VM) f32DemoteF64() {
-	vm.pushFloat32(float32(vm.popFloat64()))
+	vm.pushUint32(vm.fpu().f32DemoteF64(vm.popUint64()))
 }
 
 func (vm *VM) f64ConvertSI32() {
-	vm.pushFloat64(float64(vm.popInt32()))
+	vm.pushUint64(vm.fpu().f64FromInt(signMagnitude(int64(vm.popInt32()))))
 }
 
 func (vm *VM) f64ConvertUI32() {
-	vm.pushFloat64(float64(vm.popUint32()))
+	vm.pushUint64(vm.fpu().f64FromInt(false, uint64(vm.popUint32())))
 }
 
 func (vm *VM) f64ConvertSI64() {
-	vm.pushFloat64(float64(vm.popInt64()))
+	vm.pushUint64(vm.fpu().f64FromInt(signMagnitude(vm.popInt64())))
 }
 
 func (vm *VM) f64ConvertUI64() {
-	vm.pushFloat64(float64(vm.popUint64()))
+	vm.pushUint64(vm.fpu().f64FromInt(false, vm.popUint64()))
 }
 
 func (vm *VM) f64PromoteF32() {
-	vm.pushFloat64(float64(vm.popFloat32()))
+	vm.pushUint64(vm.fpu().f64PromoteF32(vm.popUint32()))
+}
+
+// truncF32 truncates v towards zero and returns the result in two's
+// complement. The VM traps if the magnitude of the result exceeds maxPos for
+// positive values or maxNeg for negative ones.
+func (vm *VM) truncF32(v uint32, maxPos, maxNeg uint64) uint64 {
+	if v&^f32SignBit > 0x7f800000 {
+		panic(ErrInvalidConversionToInteger)
+	}
+	neg, mag, ok := vm.fpu().f32ToInt(v)
+	return checkTrunc(neg, mag, ok, maxPos, maxNeg)
+}
+
+// truncF64 is the binary64 counterpart of truncF32.
+func (vm *VM) truncF64(v uint64, maxPos, maxNeg uint64) uint64 {
+	if v&^f64SignBit > 0x7ff0000000000000 {
+		panic(ErrInvalidConversionToInteger)
+	}
+	neg, mag, ok := vm.fpu().f64ToInt(v)
+	return checkTrunc(neg, mag, ok, maxPos, maxNeg)
+}
+
+func checkTrunc(neg bool, mag uint64, ok bool, maxPos, maxNeg uint64) uint64 {
+	if !ok || !neg && mag > maxPos || neg && mag > maxNeg {
+		panic(ErrIntegerOverflow)
+	}
+	if neg {
+		return -mag
+	}
+	return mag
+}
+
+// truncSatF32 is like truncF32, but instead of trapping it converts NaN to 0
+// and clamps the magnitude of the result to maxPos or maxNeg.
+func (vm *VM) truncSatF32(v uint32, maxPos, maxNeg uint64) uint64 {
+	if v&^f32SignBit > 0x7f800000 {
+		return 0
+	}
+	neg, mag, ok := vm.fpu().f32ToInt(v)
+	return saturateTrunc(neg, mag, ok, maxPos, maxNeg)
+}
+
+// truncSatF64 is the binary64 counterpart of truncSatF32.
+func (vm *VM) truncSatF64(v uint64, maxPos, maxNeg uint64) uint64 {
+	if v&^f64SignBit > 0x7ff0000000000000 {
+		return 0
+	}
+	neg, mag, ok := vm.fpu().f64ToInt(v)
+	return saturateTrunc(neg, mag, ok, maxPos, maxNeg)
+}
+
+func saturateTrunc(neg bool, mag uint64, ok bool, maxPos, maxNeg uint64) uint64 {
+	if neg {
+		if !ok || mag > maxNeg {
+			mag = maxNeg
+		}
+		return -mag
+	}
+	if !ok || mag > maxPos {
+		mag = maxPos
+	}
+	return mag
+}
+
+func signMagnitude(v int64) (neg bool, mag uint64) {
+	if v < 0 {
+		return true, uint64(-v)
+	}
+	return false, uint64(v)
 }
@@ -0,0 +1,224 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"math"
+
+	"github.com/ontio/wagon/exec/internal/softfloat"
+)
+
+// FloatMode selects how a VM evaluates floating-point operators.
+type FloatMode uint8
+
+const (
+	// FloatSoft evaluates floating-point operators with a pure integer
+	// implementation of IEEE 754 arithmetic. Results, including the bit
+	// pattern of every NaN, are identical on all hosts, which makes it the
+	// only mode suitable for consensus. It is the default.
+	FloatSoft FloatMode = iota
+	// FloatNative evaluates floating-point operators with the host's
+	// floating-point unit. It is faster than FloatSoft and NaN results are
+	// still canonicalized, but bit-identical results across hosts and
+	// architectures are not guaranteed.
+	FloatNative
+)
+
+func (m FloatMode) String() string {
+	switch m {
+	case FloatSoft:
+		return "soft"
+	case FloatNative:
+		return "native"
+	}
+	return "<unknown float mode>"
+}
+
+// fpu performs the floating-point operations whose result depends on
+// rounding. Operands and results are raw IEEE 754 bit patterns.
+type fpu interface {
+	f32Add(a, b uint32) uint32
+	f32Sub(a, b uint32) uint32
+	f32Mul(a, b uint32) uint32
+	f32Div(a, b uint32) uint32
+	f32Min(a, b uint32) uint32
+	f32Max(a, b uint32) uint32
+	f32Sqrt(a uint32) uint32
+	f32Ceil(a uint32) uint32
+	f32Floor(a uint32) uint32
+	f32Trunc(a uint32) uint32
+	f32Nearest(a uint32) uint32
+	f32Eq(a, b uint32) bool
+	f32Lt(a, b uint32) bool
+	f32Le(a, b uint32) bool
+
+	f64Add(a, b uint64) uint64
+	f64Sub(a, b uint64) uint64
+	f64Mul(a, b uint64) uint64
+	f64Div(a, b uint64) uint64
+	f64Min(a, b uint64) uint64
+	f64Max(a, b uint64) uint64
+	f64Sqrt(a uint64) uint64
+	f64Ceil(a uint64) uint64
+	f64Floor(a uint64) uint64
+	f64Trunc(a uint64) uint64
+	f64Nearest(a uint64) uint64
+	f64Eq(a, b uint64) bool
+	f64Lt(a, b uint64) bool
+	f64Le(a, b uint64) bool
+
+	f32FromInt(neg bool, mag uint64) uint32
+	f64FromInt(neg bool, mag uint64) uint64
+	f32ToInt(a uint32) (neg bool, mag uint64, ok bool)
+	f64ToInt(a uint64) (neg bool, mag uint64, ok bool)
+	f32DemoteF64(a uint64) uint32
+	f64PromoteF32(a uint32) uint64
+}
+
+func (vm *VM) fpu() fpu {
+	if vm.FloatMode == FloatNative {
+		return nativeFPU{}
+	}
+	return softFPU{}
+}
+
+type softFPU struct{}
+
+func (softFPU) f32Add(a, b uint32) uint32  { return softfloat.F32Add(a, b) }
+func (softFPU) f32Sub(a, b uint32) uint32  { return softfloat.F32Sub(a, b) }
+func (softFPU) f32Mul(a, b uint32) uint32  { return softfloat.F32Mul(a, b) }
+func (softFPU) f32Div(a, b uint32) uint32  { return softfloat.F32Div(a, b) }
+func (softFPU) f32Min(a, b uint32) uint32  { return softfloat.F32Min(a, b) }
+func (softFPU) f32Max(a, b uint32) uint32  { return softfloat.F32Max(a, b) }
+func (softFPU) f32Sqrt(a uint32) uint32    { return softfloat.F32Sqrt(a) }
+func (softFPU) f32Ceil(a uint32) uint32    { return softfloat.F32Ceil(a) }
+func (softFPU) f32Floor(a uint32) uint32   { return softfloat.F32Floor(a) }
+func (softFPU) f32Trunc(a uint32) uint32   { return softfloat.F32Trunc(a) }
+func (softFPU) f32Nearest(a uint32) uint32 { return softfloat.F32Nearest(a) }
+func (softFPU) f32Eq(a, b uint32) bool     { return softfloat.F32Eq(a, b) }
+func (softFPU) f32Lt(a, b uint32) bool     { return softfloat.F32Lt(a, b) }
+func (softFPU) f32Le(a, b uint32) bool     { return softfloat.F32Le(a, b) }
+func (softFPU) f64Add(a, b uint64) uint64  { return softfloat.F64Add(a, b) }
+func (softFPU) f64Sub(a, b uint64) uint64  { return softfloat.F64Sub(a, b) }
+func (softFPU) f64Mul(a, b uint64) uint64  { return softfloat.F64Mul(a, b) }
+func (softFPU) f64Div(a, b uint64) uint64  { return softfloat.F64Div(a, b) }
+func (softFPU) f64Min(a, b uint64) uint64  { return softfloat.F64Min(a, b) }
+func (softFPU) f64Max(a, b uint64) uint64  { return softfloat.F64Max(a, b) }
+func (softFPU) f64Sqrt(a uint64) uint64    { return softfloat.F64Sqrt(a) }
+func (softFPU) f64Ceil(a uint64) uint64    { return softfloat.F64Ceil(a) }
+func (softFPU) f64Floor(a uint64) uint64   { return softfloat.F64Floor(a) }
+func (softFPU) f64Trunc(a uint64) uint64   { return softfloat.F64Trunc(a) }
+func (softFPU) f64Nearest(a uint64) uint64 { return softfloat.F64Nearest(a) }
+func (softFPU) f64Eq(a, b uint64) bool     { return softfloat.F64Eq(a, b) }
+func (softFPU) f64Lt(a, b uint64) bool     { return softfloat.F64Lt(a, b) }
+func (softFPU) f64Le(a, b uint64) bool     { return softfloat.F64Le(a, b) }
+
+func (softFPU) f32FromInt(neg bool, mag uint64) uint32 { return softfloat.F32FromInt(neg, mag) }
+func (softFPU) f64FromInt(neg bool, mag uint64) uint64 { return softfloat.F64FromInt(neg, mag) }
+func (softFPU) f32ToInt(a uint32) (bool, uint64, bool) { return softfloat.F32ToInt(a) }
+func (softFPU) f64ToInt(a uint64) (bool, uint64, bool) { return softfloat.F64ToInt(a) }
+func (softFPU) f32DemoteF64(a uint64) uint32           { return softfloat.F64DemoteToF32(a) }
+func (softFPU) f64PromoteF32(a uint32) uint64          { return softfloat.F32PromoteToF64(a) }
+
+type nativeFPU struct{}
+
+func f32(a uint32) float32 { return math.Float32frombits(a) }
+func f64(a uint64) float64 { return math.Float64frombits(a) }
+
+func bits32(f float32) uint32 {
+	if f != f {
+		return softfloat.NaN32
+	}
+	return math.Float32bits(f)
+}
+
+func bits64(f float64) uint64 {
+	if f != f {
+		return softfloat.NaN64
+	}
+	return math.Float64bits(f)
+}
+
+// wasmMin and wasmMax differ from math.Min and math.Max in that a NaN operand
+// always produces a NaN, even when the other operand is infinite.
+func wasmMin(a, b float64) float64 {
+	if a != a || b != b {
+		return math.NaN()
+	}
+	return math.Min(a, b)
+}
+
+func wasmMax(a, b float64) float64 {
+	if a != a || b != b {
+		return math.NaN()
+	}
+	return math.Max(a, b)
+}
+
+func (nativeFPU) f32Add(a, b uint32) uint32 { return bits32(f32(a) + f32(b)) }
+func (nativeFPU) f32Sub(a, b uint32) uint32 { return bits32(f32(a) - f32(b)) }
+func (nativeFPU) f32Mul(a, b uint32) uint32 { return bits32(f32(a) * f32(b)) }
+func (nativeFPU) f32Div(a, b uint32) uint32 { return bits32(f32(a) / f32(b)) }
+func (nativeFPU) f32Min(a, b uint32) uint32 {
+	return bits32(float32(wasmMin(float64(f32(a)), float64(f32(b)))))
+}
+func (nativeFPU) f32Max(a, b uint32) uint32 {
+	return bits32(float32(wasmMax(float64(f32(a)), float64(f32(b)))))
+}
+func (nativeFPU) f32Sqrt(a uint32) uint32  { return bits32(float32(math.Sqrt(float64(f32(a))))) }
+func (nativeFPU) f32Ceil(a uint32) uint32  { return bits32(float32(math.Ceil(float64(f32(a))))) }
+func (nativeFPU) f32Floor(a uint32) uint32 { return bits32(float32(math.Floor(float64(f32(a))))) }
+func (nativeFPU) f32Trunc(a uint32) uint32 { return bits32(float32(math.Trunc(float64(f32(a))))) }
+func (nativeFPU) f32Nearest(a uint32) uint32 {
+	return bits32(float32(math.RoundToEven(float64(f32(a)))))
+}
+func (nativeFPU) f32Eq(a, b uint32) bool     { return f32(a) == f32(b) }
+func (nativeFPU) f32Lt(a, b uint32) bool     { return f32(a) < f32(b) }
+func (nativeFPU) f32Le(a, b uint32) bool     { return f32(a) <= f32(b) }
+func (nativeFPU) f64Add(a, b uint64) uint64  { return bits64(f64(a) + f64(b)) }
+func (nativeFPU) f64Sub(a, b uint64) uint64  { return bits64(f64(a) - f64(b)) }
+func (nativeFPU) f64Mul(a, b uint64) uint64  { return bits64(f64(a) * f64(b)) }
+func (nativeFPU) f64Div(a, b uint64) uint64  { return bits64(f64(a) / f64(b)) }
+func (nativeFPU) f64Min(a, b uint64) uint64  { return bits64(wasmMin(f64(a), f64(b))) }
+func (nativeFPU) f64Max(a, b uint64) uint64  { return bits64(wasmMax(f64(a), f64(b))) }
+func (nativeFPU) f64Sqrt(a uint64) uint64    { return bits64(math.Sqrt(f64(a))) }
+func (nativeFPU) f64Ceil(a uint64) uint64    { return bits64(math.Ceil(f64(a))) }
+func (nativeFPU) f64Floor(a uint64) uint64   { return bits64(math.Floor(f64(a))) }
+func (nativeFPU) f64Trunc(a uint64) uint64   { return bits64(math.Trunc(f64(a))) }
+func (nativeFPU) f64Nearest(a uint64) uint64 { return bits64(math.RoundToEven(f64(a))) }
+func (nativeFPU) f64Eq(a, b uint64) bool     { return f64(a) == f64(b) }
+func (nativeFPU) f64Lt(a, b uint64) bool     { return f64(a) < f64(b) }
+func (nativeFPU) f64Le(a, b uint64) bool     { return f64(a) <= f64(b) }
+
+func (nativeFPU) f32FromInt(neg bool, mag uint64) uint32 {
+	if neg {
+		return math.Float32bits(float32(-int64(mag)))
+	}
+	return math.Float32bits(float32(mag))
+}
+
+func (nativeFPU) f64FromInt(neg bool, mag uint64) uint64 {
+	if neg {
+		return math.Float64bits(float64(-int64(mag)))
+	}
+	return math.Float64bits(float64(mag))
+}
+
+func (n nativeFPU) f32ToInt(a uint32) (bool, uint64, bool) {
+	return n.f64ToInt(math.Float64bits(float64(f32(a))))
+}
+
+func (nativeFPU) f64ToInt(a uint64) (bool, uint64, bool) {
+	f := math.Trunc(f64(a))
+	neg := math.Signbit(f)
+	f = math.Abs(f)
+	if f != f || f >= 1<<64 {
+		return neg, 0, false
+	}
+	return neg, uint64(f), true
+}
+
+func (nativeFPU) f32DemoteF64(a uint64) uint32  { return bits32(float32(f64(a))) }
+func (nativeFPU) f64PromoteF32(a uint32) uint64 { return bits64(float64(f32(a))) }
@@ -0,0 +1,222 @@
+// Copyright 2020 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/ontio/wagon/wasm"
+	"github.com/ontio/wagon/wasm/operators"
+)
+
+var floatModes = []FloatMode{FloatSoft, FloatNative}
+
+func f32Bits(f float32) uint64 { return uint64(math.Float32bits(f)) }
+func f64Bits(f float64) uint64 { return math.Float64bits(f) }
+
+const (
+	nan32    = 0x7fc00000
+	negNaN32 = 0xffc00000
+	nan64    = 0x7ff8000000000000
+	negNaN64 = 0xfff8000000000000
+)
+
+func TestFloatOps(t *testing.T) {
+	negZero32, negZero64 := f32Bits(float32(math.Copysign(0, -1))), f64Bits(math.Copysign(0, -1))
+	for _, tc := range []struct {
+		opcode byte
+		args   []uint64
+		want   uint64
+	}{
+		{operators.F32Add, []uint64{f32Bits(1), f32Bits(2)}, f32Bits(3)},
+		{operators.F32Add, []uint64{f32Bits(float32(math.Inf(1))), f32Bits(float32(math.Inf(-1)))}, nan32},
+		{operators.F32Sub, []uint64{f32Bits(1), f32Bits(3)}, f32Bits(-2)},
+		{operators.F32Div, []uint64{f32Bits(1), f32Bits(3)}, 0x3eaaaaab},
+		{operators.F32Div, []uint64{negNaN32, f32Bits(1)}, nan32},
+		{operators.F32Min, []uint64{0, negZero32}, negZero32},
+		{operators.F32Max, []uint64{negZero32, 0}, 0},
+		{operators.F32Min, []uint64{nan32, f32Bits(float32(math.Inf(-1)))}, nan32},
+		{operators.F32Sqrt, []uint64{f32Bits(-1)}, nan32},
+		{operators.F32Sqrt, []uint64{negZero32}, negZero32},
+		{operators.F32Nearest, []uint64{f32Bits(2.5)}, f32Bits(2)},
+		{operators.F32Nearest, []uint64{f32Bits(-0.5)}, negZero32},
+		{operators.F32Ceil, []uint64{f32Bits(-0.5)}, negZero32},
+		{operators.F32Floor, []uint64{f32Bits(-0.5)}, f32Bits(-1)},
+		{operators.F32Trunc, []uint64{f32Bits(-1.5)}, f32Bits(-1)},
+		{operators.F32Abs, []uint64{negNaN32}, nan32},
+		{operators.F32Neg, []uint64{nan32}, negNaN32},
+		{operators.F32Copysign, []uint64{f32Bits(2), f32Bits(-1)}, f32Bits(-2)},
+		{operators.F32Eq, []uint64{0, negZero32}, 1},
+		{operators.F32Ne, []uint64{nan32, nan32}, 1},
+		{operators.F32Lt, []uint64{f32Bits(-1), f32Bits(1)}, 1},
+		{operators.F32Ge, []uint64{nan32, f32Bits(1)}, 0},
+
+		{operators.F64Add, []uint64{f64Bits(0.1), f64Bits(0.2)}, f64Bits(0.30000000000000004)},
+		{operators.F64Mul, []uint64{f64Bits(math.Inf(1)), 0}, nan64},
+		{operators.F64Div, []uint64{f64Bits(1), negZero64}, f64Bits(math.Inf(-1))},
+		{operators.F64Min, []uint64{negZero64, 0}, negZero64},
+		{operators.F64Max, []uint64{negNaN64, f64Bits(1)}, nan64},
+		{operators.F64Sqrt, []uint64{f64Bits(2)}, f64Bits(math.Sqrt2)},
+		{operators.F64Nearest, []uint64{f64Bits(-3.5)}, f64Bits(-4)},
+		{operators.F64Nearest, []uint64{f64Bits(4503599627370497)}, f64Bits(4503599627370497)},
+		{operators.F64Copysign, []uint64{f64Bits(-2), 0}, f64Bits(2)},
+		{operators.F64Le, []uint64{negZero64, 0}, 1},
+		{operators.F64Gt, []uint64{f64Bits(1), nan64}, 0},
+
+		{operators.F32DemoteF64, []uint64{f64Bits(math.MaxFloat64)}, f32Bits(float32(math.Inf(1)))},
+		{operators.F32DemoteF64, []uint64{negNaN64}, nan32},
+		{operators.F64PromoteF32, []uint64{f32Bits(1.5)}, f64Bits(1.5)},
+		{operators.F32ConvertUI64, []uint64{math.MaxUint64}, f32Bits(18446744073709551616)},
+		{operators.F32ConvertSI64, []uint64{0x20000020000001}, f32Bits(9007199791611905)},
+		{operators.F64ConvertSI32, []uint64{0x80000000}, f64Bits(-2147483648)},
+		{operators.F64ConvertUI32, []uint64{0x80000000}, f64Bits(2147483648)},
+		{operators.I32TruncSF32, []uint64{f32Bits(-2147483648)}, 0x80000000},
+		{operators.I32TruncUF64, []uint64{f64Bits(-0.9)}, 0},
+		{operators.I64TruncSF64, []uint64{f64Bits(-1.5)}, math.MaxUint64},
+		{operators.I64TruncUF32, []uint64{f32Bits(18446742974197923840)}, 18446742974197923840},
+		{operators.I32ReinterpretF32, []uint64{negNaN32}, negNaN32},
+		{operators.F64ReinterpretI64, []uint64{0x7ff0000000000001}, 0x7ff0000000000001},
+	} {
+		op, err := operators.New(tc.opcode)
+		if err != nil {
+			t.Fatalf("could not lookup operator 0x%x: %v", tc.opcode, err)
+		}
+		for _, mode := range floatModes {
+			t.Run(fmt.Sprintf("%s/%v%#x", mode, op.Name, tc.args), func(t *testing.T) {
+				vm := &VM{FloatMode: mode}
+				vm.ctx.stack = make([]uint64, 0, 2)
+				vm.newFuncTable()
+				for _, arg := range tc.args {
+					vm.pushUint64(arg)
+				}
+				vm.funcTable[tc.opcode]()
+				got := vm.popUint64()
+				if op.Returns == wasm.ValueTypeI32 || op.Returns == wasm.ValueTypeF32 {
+					got = uint64(uint32(got))
+				}
+				if got != tc.want {
+					t.Fatalf("got=%#x, want=%#x", got, tc.want)
+				}
+			})
+		}
+	}
+}
+
+func TestFloatTruncTraps(t *testing.T) {
+	for _, tc := range []struct {
+		opcode byte
+		arg    uint64
+		want   error
+	}{
+		{operators.I32TruncSF32, nan32, ErrInvalidConversionToInteger},
+		{operators.I32TruncSF32, f32Bits(2147483648), ErrIntegerOverflow},
+		{operators.I32TruncUF32, f32Bits(-1), ErrIntegerOverflow},
+		{operators.I32TruncSF64, f64Bits(-2147483649), ErrIntegerOverflow},
+		{operators.I64TruncSF64, f64Bits(9223372036854775808), ErrIntegerOverflow},
+		{operators.I64TruncUF64, f64Bits(math.Inf(1)), ErrIntegerOverflow},
+		{operators.I64TruncUF64, negNaN64, ErrInvalidConversionToInteger},
+	} {
+		for _, mode := range floatModes {
+			t.Run(fmt.Sprintf("%s/%#x(%#x)", mode, tc.opcode, tc.arg), func(t *testing.T) {
+				vm := &VM{FloatMode: mode}
+				vm.ctx.stack = make([]uint64, 0, 2)
+				vm.newFuncTable()
+				vm.pushUint64(tc.arg)
+				defer func() {
+					if r := recover(); r != tc.want {
+						t.Fatalf("got panic %v, want %v", r, tc.want)
+					}
+				}()
+				vm.funcTable[tc.opcode]()
+			})
+		}
+	}
+}
+
+// moduleFloat exports div(f64, f64) f64, returning the quotient of its
+// arguments, and hg(f32) f32, returning env.half applied to its argument plus
+// an f32 global initialized to 1.5.
+var moduleFloat = moduleBytes(
+	section(0x01, 0x02, 0x60, 0x02, 0x7c, 0x7c, 0x01, 0x7c, 0x60, 0x01, 0x7d, 0x01, 0x7d),
+	section(0x02, 0x01, 0x03, 'e', 'n', 'v', 0x04, 'h', 'a', 'l', 'f', 0x00, 0x01),
+	section(0x03, 0x02, 0x00, 0x01),
+	section(0x06, 0x01, 0x7d, 0x00, 0x43, 0x00, 0x00, 0xc0, 0x3f, 0x0b),
+	section(0x07, 0x02, 0x03, 'd', 'i', 'v', 0x00, 0x01, 0x02, 'h', 'g', 0x00, 0x02),
+	section(0x0a, 0x02,
+		0x07, 0x00, 0x20, 0x00, 0x20, 0x01, 0xa3, 0x0b,
+		0x09, 0x00, 0x20, 0x00, 0x10, 0x00, 0x23, 0x00, 0x92, 0x0b),
+)
+
+func half(proc *Process, x float32) float32 {
+	return x / 2
+}
+
+func halfImporter(name string) (*wasm.Module, error) {
+	m := wasm.NewModule()
+	m.Types = &wasm.SectionTypes{
+		Entries: []wasm.FunctionSig{
+			{
+				Form:        0,
+				ParamTypes:  []wasm.ValueType{wasm.ValueTypeF32},
+				ReturnTypes: []wasm.ValueType{wasm.ValueTypeF32},
+			},
+		},
+	}
+	m.FunctionIndexSpace = []wasm.Function{
+		{
+			Sig:  &m.Types.Entries[0],
+			Host: reflect.ValueOf(half),
+			Body: &wasm.FunctionBody{},
+		},
+	}
+	m.Export = &wasm.SectionExports{
+		Entries: map[string]wasm.ExportEntry{
+			"half": {
+				FieldStr: "half",
+				Kind:     wasm.ExternalFunction,
+				Index:    0,
+			},
+		},
+	}
+	return m, nil
+}
+
+func TestFloatExec(t *testing.T) {
+	compiled, err := CompileModule(readTestModule(t, moduleFloat, halfImporter), nil)
+	if err != nil {
+		t.Fatalf("could not compile module: %v", err)
+	}
+	for _, mode := range floatModes {
+		vm := newTestVM(t, compiled)
+		vm.FloatMode = mode
+
+		res, err := vm.ExecCode(1, f64Bits(1), f64Bits(3))
+		if err != nil {
+			t.Fatalf("%s: %v", mode, err)
+		}
+		if res != 1.0/3 {
+			t.Errorf("%s: div(1, 3) = %v, want %v", mode, res, 1.0/3)
+		}
+
+		res, err = vm.ExecCode(1, 0, 0)
+		if err != nil {
+			t.Fatalf("%s: %v", mode, err)
+		}
+		if f, ok := res.(float64); !ok || math.Float64bits(f) != nan64 {
+			t.Errorf("%s: div(0, 0) = %v, want canonical NaN", mode, res)
+		}
+
+		res, err = vm.ExecCode(2, f32Bits(5))
+		if err != nil {
+			t.Fatalf("%s: %v", mode, err)
+		}
+		if res != float32(4) {
+			t.Errorf("%s: hg(5) = %v (%T), want 4", mode, res, res)
+		}
+	}
+}
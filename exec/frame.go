@@ -0,0 +1,99 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+// DefaultFrameLimit is the maximum number of nested calls of a VM whose
+// FrameLimit is zero.
+const DefaultFrameLimit = 16384
+
+// The locals and the operand stack of every frame live in a single arena
+// shared by all frames of a VM. A frame occupies the range
+//
+//	[base, base+len(locals)+maxDepth+1)
+//
+// of the arena, and the frame of a callee starts right after the range of
+// its caller. The arena is grown by doubling and kept across executions, so
+// calls do not allocate once it is large enough. It never grows past
+// MaxArenaSlots.
+
+// frameLimit returns the maximum number of nested calls.
+func (vm *VM) frameLimit() int {
+	if vm.FrameLimit == 0 {
+		return DefaultFrameLimit
+	}
+	return int(vm.FrameLimit)
+}
+
+// checkCallStackDepth takes a call from the CallStackDepth budget. The
+// caller gives it back when the call returns.
+func (vm *VM) checkCallStackDepth() {
+	if vm.CallStackDepth <= 0 || len(vm.frames) >= vm.frameLimit() {
+		panic(ErrCallStackDepthExceed)
+	}
+	vm.CallStackDepth--
+}
+
+// newContext returns a context executing the function compiled, located at
+// index in the function index space, whose frame starts at base in the arena.
+// All the locals of the returned context are zero.
+func (vm *VM) newContext(compiled compiledFunction, index int64, base int) context {
+	nlocals := compiled.totalLocalVars
+	end := base + nlocals + compiled.maxDepth + 1
+	if vm.MaxArenaSlots != 0 && uint64(end) > vm.MaxArenaSlots {
+		panic(ErrArenaSlotsExceed)
+	}
+	if end > len(vm.arena) {
+		vm.growArena(end)
+	}
+	locals := vm.arena[base : base+nlocals : base+nlocals]
+	for i := range locals {
+		locals[i] = 0
+	}
+	return context{
+		stack:   vm.arena[base+nlocals : base+nlocals : end],
+		locals:  locals,
+		code:    compiled.code,
+		pc:      0,
+		curFunc: index,
+		base:    base,
+	}
+}
+
+// calleeBase returns the offset in the arena at which the frame of a
+// function called from the current frame starts.
+func (vm *VM) calleeBase() int {
+	return vm.ctx.base + len(vm.ctx.locals) + cap(vm.ctx.stack)
+}
+
+// growArena grows the arena to hold at least n values, and moves the
+// frames to the new arena.
+func (vm *VM) growArena(n int) {
+	size := 2 * len(vm.arena)
+	if vm.MaxArenaSlots != 0 && uint64(size) > vm.MaxArenaSlots {
+		size = int(vm.MaxArenaSlots)
+	}
+	if size < n {
+		size = n
+	}
+	arena := make([]uint64, size)
+	copy(arena, vm.arena)
+	vm.arena = arena
+	for i := range vm.frames {
+		vm.frames[i].rebase(arena)
+	}
+	vm.ctx.rebase(arena)
+}
+
+// rebase points the locals and the stack of ctx to the same range of arena.
+func (ctx *context) rebase(arena []uint64) {
+	nlocals, depth, capacity := len(ctx.locals), len(ctx.stack), cap(ctx.stack)
+	if ctx.base+nlocals+capacity > len(arena) {
+		// ctx is not a frame of the arena, e.g. the zero context.
+		return
+	}
+	ctx.locals = arena[ctx.base : ctx.base+nlocals : ctx.base+nlocals]
+	stackBase := ctx.base + nlocals
+	ctx.stack = arena[stackBase : stackBase+depth : stackBase+capacity]
+}
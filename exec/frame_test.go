@@ -0,0 +1,94 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// moduleDepth defines depth(n i32) i32, which calls itself recursively n
+// times and returns n.
+var moduleDepth = moduleBytes(
+	section(0x01, 0x01, 0x60, 0x01, 0x7f, 0x01, 0x7f),
+	section(0x03, 0x01, 0x00),
+	section(0x0a, 0x01, 0x15, 0x00,
+		0x20, 0x00, 0x45, 0x04, 0x7f, 0x41, 0x00, 0x05,
+		0x20, 0x00, 0x41, 0x01, 0x6b, 0x10, 0x00, 0x41, 0x01, 0x6a,
+		0x0b, 0x0b),
+)
+
+func TestFrameLimit(t *testing.T) {
+	for _, tc := range []struct {
+		frameLimit     uint32
+		callStackDepth uint32
+		max            uint64
+	}{
+		{0, math.MaxUint32, DefaultFrameLimit},
+		{50, math.MaxUint32, 50},
+		{50, 20, 20},
+		{10, 20, 10},
+		{0, 0, 0},
+	} {
+		vm := newTestVM(t, compileTestModule(t, moduleDepth, nil))
+		vm.FrameLimit = tc.frameLimit
+		vm.CallStackDepth = tc.callStackDepth
+
+		res, err := vm.ExecCode(0, tc.max)
+		if err != nil {
+			t.Fatalf("%+v: depth(%d): %v", tc, tc.max, err)
+		}
+		if res != uint32(tc.max) {
+			t.Fatalf("%+v: depth(%d) = %v", tc, tc.max, res)
+		}
+		if _, err = vm.ExecCode(0, tc.max+1); !errors.Is(err, ErrCallStackDepthExceed) {
+			t.Fatalf("%+v: depth(%d): got error %v, want %v", tc, tc.max+1, err, ErrCallStackDepthExceed)
+		}
+		// The calls give their depth back, even when they trap.
+		if vm.CallStackDepth != tc.callStackDepth {
+			t.Fatalf("%+v: CallStackDepth = %d after the trap", tc, vm.CallStackDepth)
+		}
+		if res, err = vm.ExecCode(0, 0); err != nil || res != uint32(0) {
+			t.Fatalf("%+v: depth(0) = %v, %v", tc, res, err)
+		}
+	}
+}
+
+func TestCallsDoNotAllocate(t *testing.T) {
+	vm := newTestVM(t, compileTestModule(t, moduleDepth, nil))
+	allocs := func(n uint64) float64 {
+		vm.ExecCode(0, n) // grow the arena
+		return testing.AllocsPerRun(10, func() {
+			vm.ExecCode(0, n)
+		})
+	}
+	if shallow, deep := allocs(300), allocs(3000); deep != shallow {
+		t.Errorf("3000 nested calls made %v allocations, 300 made %v", deep, shallow)
+	}
+}
+
+func TestMaxArenaSlots(t *testing.T) {
+	vm := newTestVM(t, compileTestModule(t, moduleDepth, nil))
+	compiled := vm.funcs[0].(compiledFunction)
+	frame := uint64(compiled.totalLocalVars + compiled.maxDepth + 1)
+	// depth(n) nests n+1 frames.
+	vm.MaxArenaSlots = 11 * frame
+	if res, err := vm.ExecCode(0, 10); err != nil || res != uint32(10) {
+		t.Fatalf("depth(10) = %v, %v", res, err)
+	}
+	if n := uint64(len(vm.arena)); n > vm.MaxArenaSlots {
+		t.Errorf("arena grew to %d slots, past MaxArenaSlots", n)
+	}
+	_, err := vm.ExecCode(0, 11)
+	var trap *Trap
+	if !errors.As(err, &trap) || trap.Code != TrapCallStackExhausted || !errors.Is(err, ErrArenaSlotsExceed) {
+		t.Fatalf("depth(11): got error %v, want %v", err, ErrArenaSlotsExceed)
+	}
+	// The VM is usable after the trap.
+	if res, err := vm.ExecCode(0, 3); err != nil || res != uint32(3) {
+		t.Fatalf("depth(3) = %v, %v", res, err)
+	}
+}
@@ -19,10 +19,14 @@ type function interface {
 type compiledFunction struct {
 	code           []byte
 	branchTables   []*compile.BranchTable
-	maxDepth       int  // maximum stack depth reached while executing the function body
-	totalLocalVars int  // number of local variables used by the function
-	args           int  // number of arguments the function accepts
-	returns        bool // whether the function returns a value
+	instrs         []int64 // offsets of the instructions of code, in increasing order
+	maxDepth       int     // maximum stack depth reached while executing the function body
+	totalLocalVars int     // number of local variables used by the function
+	args           int     // number of arguments the function accepts
+	results        int     // number of values the function returns
+
+	// offsets maps the compiled code to the original function body.
+	offsets []compile.InstrOffset
 }
 
 type goFunction struct {
@@ -31,11 +35,16 @@ type goFunction struct {
 }
 
 func (fn goFunction) call(vm *VM, index int64) {
+	vm.checkCallStackDepth()
+	defer func() {
+		vm.CallStackDepth++
+	}()
+
 	// numIn = # of call inputs + vm, as the function expects
 	// an additional *VM argument
 	numIn := fn.typ.NumIn()
 	args := make([]reflect.Value, numIn)
-	proc := NewProcess(vm)
+	proc := vm.process()
 
 	// Pass proc as an argument. Check that the function indeed
 	// expects a *Process argument.
@@ -50,8 +59,10 @@ func (fn goFunction) call(vm *VM, index int64) {
 		kind := fn.typ.In(i).Kind()
 
 		switch kind {
-		case reflect.Float64, reflect.Float32:
-			val.SetFloat(math.Float64frombits(raw))
+		case reflect.Float32:
+			val.Set(reflect.ValueOf(math.Float32frombits(uint32(raw))).Convert(val.Type()))
+		case reflect.Float64:
+			val.Set(reflect.ValueOf(math.Float64frombits(raw)).Convert(val.Type()))
 		case reflect.Uint32, reflect.Uint64:
 			val.SetUint(raw)
 		case reflect.Int32, reflect.Int64:
@@ -63,12 +74,19 @@ func (fn goFunction) call(vm *VM, index int64) {
 		args[i] = val
 	}
 
-	rtrns := fn.val.Call(args)
+	rtrns := fn.callHost(index, args)
+	if vm.paused {
+		// The results are supplied to (*VM).Resume instead.
+		vm.pendingResults = len(rtrns)
+		return
+	}
 	for i, out := range rtrns {
 		kind := out.Kind()
 		switch kind {
-		case reflect.Float64, reflect.Float32:
-			vm.pushFloat64(out.Float())
+		case reflect.Float32:
+			vm.pushFloat32(out.Convert(reflect.TypeOf(float32(0))).Interface().(float32))
+		case reflect.Float64:
+			vm.pushFloat64(out.Convert(reflect.TypeOf(float64(0))).Interface().(float64))
 		case reflect.Uint32, reflect.Uint64:
 			vm.pushUint64(out.Uint())
 		case reflect.Int32, reflect.Int64:
@@ -79,35 +97,26 @@ func (fn goFunction) call(vm *VM, index int64) {
 	}
 }
 
+// callHost calls the host function, wrapping the value it may panic with in
+// a hostPanic.
+func (fn goFunction) callHost(index int64, args []reflect.Value) []reflect.Value {
+	defer func() {
+		if r := recover(); r != nil {
+			panic(hostPanic{index: index, value: r})
+		}
+	}()
+	return fn.val.Call(args)
+}
+
 func (compiled compiledFunction) call(vm *VM, index int64) {
-	// Make space on the stack for all intermediate values and
-	// a possible return value.
-	newStack := make([]uint64, 0, compiled.maxDepth+1)
-	locals := make([]uint64, compiled.totalLocalVars)
+	vm.checkCallStackDepth()
 
+	ctx := vm.newContext(compiled, index, vm.calleeBase())
 	for i := compiled.args - 1; i >= 0; i-- {
-		locals[i] = vm.popUint64()
+		ctx.locals[i] = vm.popUint64()
 	}
 
-	//save execution context
-	prevCtxt := vm.ctx
-
-	vm.ctx = context{
-		stack:   newStack,
-		locals:  locals,
-		code:    compiled.code,
-		pc:      0,
-		curFunc: index,
-	}
-
-	rtrn, err := vm.execCode(compiled)
-	if err != nil {
-		panic("errors happen while call method:" + err.Error())
-	}
-	//restore execution context
-	vm.ctx = prevCtxt
-
-	if compiled.returns {
-		vm.pushUint64(rtrn)
-	}
+	// save execution context, it is restored when the callee returns
+	vm.frames = append(vm.frames, vm.ctx)
+	vm.ctx = ctx
 }
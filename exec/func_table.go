@@ -5,6 +5,7 @@
 package exec
 
 import (
+	"github.com/ontio/wagon/exec/internal/compile"
 	ops "github.com/ontio/wagon/wasm/operators"
 )
 
@@ -69,84 +70,99 @@ func (vm *VM) newFuncTable() {
 	vm.funcTable[ops.I64GeS] = vm.i64GeS
 	vm.funcTable[ops.I64GeU] = vm.i64GeU
 
-	//vm.funcTable[ops.F32Eq] = vm.f32Eq
-	//vm.funcTable[ops.F32Ne] = vm.f32Ne
-	//vm.funcTable[ops.F32Lt] = vm.f32Lt
-	//vm.funcTable[ops.F32Gt] = vm.f32Gt
-	//vm.funcTable[ops.F32Le] = vm.f32Le
-	//vm.funcTable[ops.F32Ge] = vm.f32Ge
-	//vm.funcTable[ops.F32Abs] = vm.f32Abs
-	//vm.funcTable[ops.F32Neg] = vm.f32Neg
-	//vm.funcTable[ops.F32Ceil] = vm.f32Ceil
-	//vm.funcTable[ops.F32Floor] = vm.f32Floor
-	//vm.funcTable[ops.F32Trunc] = vm.f32Trunc
-	//vm.funcTable[ops.F32Nearest] = vm.f32Nearest
-	//vm.funcTable[ops.F32Sqrt] = vm.f32Sqrt
-	//vm.funcTable[ops.F32Add] = vm.f32Add
-	//vm.funcTable[ops.F32Sub] = vm.f32Sub
-	//vm.funcTable[ops.F32Mul] = vm.f32Mul
-	//vm.funcTable[ops.F32Div] = vm.f32Div
-	//vm.funcTable[ops.F32Min] = vm.f32Min
-	//vm.funcTable[ops.F32Max] = vm.f32Max
-	//vm.funcTable[ops.F32Copysign] = vm.f32Copysign
+	vm.funcTable[ops.F32Eq] = vm.f32Eq
+	vm.funcTable[ops.F32Ne] = vm.f32Ne
+	vm.funcTable[ops.F32Lt] = vm.f32Lt
+	vm.funcTable[ops.F32Gt] = vm.f32Gt
+	vm.funcTable[ops.F32Le] = vm.f32Le
+	vm.funcTable[ops.F32Ge] = vm.f32Ge
+	vm.funcTable[ops.F32Abs] = vm.f32Abs
+	vm.funcTable[ops.F32Neg] = vm.f32Neg
+	vm.funcTable[ops.F32Ceil] = vm.f32Ceil
+	vm.funcTable[ops.F32Floor] = vm.f32Floor
+	vm.funcTable[ops.F32Trunc] = vm.f32Trunc
+	vm.funcTable[ops.F32Nearest] = vm.f32Nearest
+	vm.funcTable[ops.F32Sqrt] = vm.f32Sqrt
+	vm.funcTable[ops.F32Add] = vm.f32Add
+	vm.funcTable[ops.F32Sub] = vm.f32Sub
+	vm.funcTable[ops.F32Mul] = vm.f32Mul
+	vm.funcTable[ops.F32Div] = vm.f32Div
+	vm.funcTable[ops.F32Min] = vm.f32Min
+	vm.funcTable[ops.F32Max] = vm.f32Max
+	vm.funcTable[ops.F32Copysign] = vm.f32Copysign
 	//
-	//vm.funcTable[ops.F64Eq] = vm.f64Eq
-	//vm.funcTable[ops.F64Ne] = vm.f64Ne
-	//vm.funcTable[ops.F64Lt] = vm.f64Lt
-	//vm.funcTable[ops.F64Gt] = vm.f64Gt
-	//vm.funcTable[ops.F64Le] = vm.f64Le
-	//vm.funcTable[ops.F64Ge] = vm.f64Ge
-	//vm.funcTable[ops.F64Abs] = vm.f64Abs
-	//vm.funcTable[ops.F64Neg] = vm.f64Neg
-	//vm.funcTable[ops.F64Ceil] = vm.f64Ceil
-	//vm.funcTable[ops.F64Floor] = vm.f64Floor
-	//vm.funcTable[ops.F64Trunc] = vm.f64Trunc
-	//vm.funcTable[ops.F64Nearest] = vm.f64Nearest
-	//vm.funcTable[ops.F64Sqrt] = vm.f64Sqrt
-	//vm.funcTable[ops.F64Add] = vm.f64Add
-	//vm.funcTable[ops.F64Sub] = vm.f64Sub
-	//vm.funcTable[ops.F64Mul] = vm.f64Mul
-	//vm.funcTable[ops.F64Div] = vm.f64Div
-	//vm.funcTable[ops.F64Min] = vm.f64Min
-	//vm.funcTable[ops.F64Max] = vm.f64Max
-	//vm.funcTable[ops.F64Copysign] = vm.f64Copysign
+	vm.funcTable[ops.F64Eq] = vm.f64Eq
+	vm.funcTable[ops.F64Ne] = vm.f64Ne
+	vm.funcTable[ops.F64Lt] = vm.f64Lt
+	vm.funcTable[ops.F64Gt] = vm.f64Gt
+	vm.funcTable[ops.F64Le] = vm.f64Le
+	vm.funcTable[ops.F64Ge] = vm.f64Ge
+	vm.funcTable[ops.F64Abs] = vm.f64Abs
+	vm.funcTable[ops.F64Neg] = vm.f64Neg
+	vm.funcTable[ops.F64Ceil] = vm.f64Ceil
+	vm.funcTable[ops.F64Floor] = vm.f64Floor
+	vm.funcTable[ops.F64Trunc] = vm.f64Trunc
+	vm.funcTable[ops.F64Nearest] = vm.f64Nearest
+	vm.funcTable[ops.F64Sqrt] = vm.f64Sqrt
+	vm.funcTable[ops.F64Add] = vm.f64Add
+	vm.funcTable[ops.F64Sub] = vm.f64Sub
+	vm.funcTable[ops.F64Mul] = vm.f64Mul
+	vm.funcTable[ops.F64Div] = vm.f64Div
+	vm.funcTable[ops.F64Min] = vm.f64Min
+	vm.funcTable[ops.F64Max] = vm.f64Max
+	vm.funcTable[ops.F64Copysign] = vm.f64Copysign
 
 	vm.funcTable[ops.I32Const] = vm.i32Const
 	vm.funcTable[ops.I64Const] = vm.i64Const
-	//vm.funcTable[ops.F32Const] = vm.f32Const
-	//vm.funcTable[ops.F64Const] = vm.f64Const
+	vm.funcTable[ops.F32Const] = vm.f32Const
+	vm.funcTable[ops.F64Const] = vm.f64Const
 	//
-	//vm.funcTable[ops.I32ReinterpretF32] = vm.i32ReinterpretF32
-	//vm.funcTable[ops.I64ReinterpretF64] = vm.i64ReinterpretF64
-	//vm.funcTable[ops.F32ReinterpretI32] = vm.f32ReinterpretI32
-	//vm.funcTable[ops.F64ReinterpretI64] = vm.f64ReinterpretI64
+	vm.funcTable[ops.I32ReinterpretF32] = vm.i32ReinterpretF32
+	vm.funcTable[ops.I64ReinterpretF64] = vm.i64ReinterpretF64
+	vm.funcTable[ops.F32ReinterpretI32] = vm.f32ReinterpretI32
+	vm.funcTable[ops.F64ReinterpretI64] = vm.f64ReinterpretI64
 
 	vm.funcTable[ops.I32WrapI64] = vm.i32Wrapi64
-	//vm.funcTable[ops.I32TruncSF32] = vm.i32TruncSF32
-	//vm.funcTable[ops.I32TruncUF32] = vm.i32TruncUF32
-	//vm.funcTable[ops.I32TruncSF64] = vm.i32TruncSF64
-	//vm.funcTable[ops.I32TruncUF64] = vm.i32TruncUF64
+	vm.funcTable[ops.I32TruncSF32] = vm.i32TruncSF32
+	vm.funcTable[ops.I32TruncUF32] = vm.i32TruncUF32
+	vm.funcTable[ops.I32TruncSF64] = vm.i32TruncSF64
+	vm.funcTable[ops.I32TruncUF64] = vm.i32TruncUF64
 	vm.funcTable[ops.I64ExtendSI32] = vm.i64ExtendSI32
 	vm.funcTable[ops.I64ExtendUI32] = vm.i64ExtendUI32
-	//vm.funcTable[ops.I64TruncSF32] = vm.i64TruncSF32
-	//vm.funcTable[ops.I64TruncUF32] = vm.i64TruncUF32
-	//vm.funcTable[ops.I64TruncSF64] = vm.i64TruncSF64
-	//vm.funcTable[ops.I64TruncUF64] = vm.i64TruncUF64
-	//vm.funcTable[ops.F32ConvertSI32] = vm.f32ConvertSI32
-	//vm.funcTable[ops.F32ConvertUI32] = vm.f32ConvertUI32
-	//vm.funcTable[ops.F32ConvertSI64] = vm.f32ConvertSI64
-	//vm.funcTable[ops.F32ConvertUI64] = vm.f32ConvertUI64
-	//vm.funcTable[ops.F32DemoteF64] = vm.f32DemoteF64
-	//vm.funcTable[ops.F64ConvertSI32] = vm.f64ConvertSI32
-	//vm.funcTable[ops.F64ConvertUI32] = vm.f64ConvertUI32
-	//vm.funcTable[ops.F64ConvertSI64] = vm.f64ConvertSI64
-	//vm.funcTable[ops.F64ConvertUI64] = vm.f64ConvertUI64
-	//vm.funcTable[ops.F64PromoteF32] = vm.f64PromoteF32
+	vm.funcTable[ops.I64TruncSF32] = vm.i64TruncSF32
+	vm.funcTable[ops.I64TruncUF32] = vm.i64TruncUF32
+	vm.funcTable[ops.I64TruncSF64] = vm.i64TruncSF64
+	vm.funcTable[ops.I64TruncUF64] = vm.i64TruncUF64
+	vm.funcTable[ops.F32ConvertSI32] = vm.f32ConvertSI32
+	vm.funcTable[ops.F32ConvertUI32] = vm.f32ConvertUI32
+	vm.funcTable[ops.F32ConvertSI64] = vm.f32ConvertSI64
+	vm.funcTable[ops.F32ConvertUI64] = vm.f32ConvertUI64
+	vm.funcTable[ops.F32DemoteF64] = vm.f32DemoteF64
+	vm.funcTable[ops.F64ConvertSI32] = vm.f64ConvertSI32
+	vm.funcTable[ops.F64ConvertUI32] = vm.f64ConvertUI32
+	vm.funcTable[ops.F64ConvertSI64] = vm.f64ConvertSI64
+	vm.funcTable[ops.F64ConvertUI64] = vm.f64ConvertUI64
+	vm.funcTable[ops.F64PromoteF32] = vm.f64PromoteF32
+
+	vm.setPrefixedFunc(ops.MiscPrefix, ops.I32TruncSatSF32, vm.i32TruncSatSF32)
+	vm.setPrefixedFunc(ops.MiscPrefix, ops.I32TruncSatUF32, vm.i32TruncSatUF32)
+	vm.setPrefixedFunc(ops.MiscPrefix, ops.I32TruncSatSF64, vm.i32TruncSatSF64)
+	vm.setPrefixedFunc(ops.MiscPrefix, ops.I32TruncSatUF64, vm.i32TruncSatUF64)
+	vm.setPrefixedFunc(ops.MiscPrefix, ops.I64TruncSatSF32, vm.i64TruncSatSF32)
+	vm.setPrefixedFunc(ops.MiscPrefix, ops.I64TruncSatUF32, vm.i64TruncSatUF32)
+	vm.setPrefixedFunc(ops.MiscPrefix, ops.I64TruncSatSF64, vm.i64TruncSatSF64)
+	vm.setPrefixedFunc(ops.MiscPrefix, ops.I64TruncSatUF64, vm.i64TruncSatUF64)
+
+	vm.funcTable[ops.I32Extend8S] = vm.i32Extend8S
+	vm.funcTable[ops.I32Extend16S] = vm.i32Extend16S
+	vm.funcTable[ops.I64Extend8S] = vm.i64Extend8S
+	vm.funcTable[ops.I64Extend16S] = vm.i64Extend16S
+	vm.funcTable[ops.I64Extend32S] = vm.i64Extend32S
 
 	vm.funcTable[ops.I32Load] = vm.i32Load
 	vm.funcTable[ops.I64Load] = vm.i64Load
-	//vm.funcTable[ops.F32Load] = vm.f32Load
-	//vm.funcTable[ops.F64Load] = vm.f64Load
+	vm.funcTable[ops.F32Load] = vm.f32Load
+	vm.funcTable[ops.F64Load] = vm.f64Load
 	vm.funcTable[ops.I32Load8s] = vm.i32Load8s
 	vm.funcTable[ops.I32Load8u] = vm.i32Load8u
 	vm.funcTable[ops.I32Load16s] = vm.i32Load16s
@@ -159,8 +175,8 @@ func (vm *VM) newFuncTable() {
 	vm.funcTable[ops.I64Load32u] = vm.i64Load32u
 	vm.funcTable[ops.I32Store] = vm.i32Store
 	vm.funcTable[ops.I64Store] = vm.i64Store
-	//vm.funcTable[ops.F32Store] = vm.f32Store
-	//vm.funcTable[ops.F64Store] = vm.f64Store
+	vm.funcTable[ops.F32Store] = vm.f32Store
+	vm.funcTable[ops.F64Store] = vm.f64Store
 	vm.funcTable[ops.I32Store8] = vm.i32Store8
 	vm.funcTable[ops.I32Store16] = vm.i32Store16
 	vm.funcTable[ops.I64Store8] = vm.i64Store8
@@ -169,8 +185,27 @@ func (vm *VM) newFuncTable() {
 	vm.funcTable[ops.CurrentMemory] = vm.currentMemory
 	vm.funcTable[ops.GrowMemory] = vm.growMemory
 
+	vm.setPrefixedFunc(ops.MiscPrefix, ops.MemoryInit, vm.memoryInit)
+	vm.setPrefixedFunc(ops.MiscPrefix, ops.DataDrop, vm.dataDrop)
+	vm.setPrefixedFunc(ops.MiscPrefix, ops.MemoryCopy, vm.memoryCopy)
+	vm.setPrefixedFunc(ops.MiscPrefix, ops.MemoryFill, vm.memoryFill)
+	vm.setPrefixedFunc(ops.MiscPrefix, ops.TableInit, vm.tableInit)
+	vm.setPrefixedFunc(ops.MiscPrefix, ops.ElemDrop, vm.elemDrop)
+	vm.setPrefixedFunc(ops.MiscPrefix, ops.TableCopy, vm.tableCopy)
+
+	vm.funcTable[ops.TableGet] = vm.tableGet
+	vm.funcTable[ops.TableSet] = vm.tableSet
+	vm.setPrefixedFunc(ops.MiscPrefix, ops.TableGrow, vm.tableGrow)
+	vm.setPrefixedFunc(ops.MiscPrefix, ops.TableSize, vm.tableSize)
+	vm.setPrefixedFunc(ops.MiscPrefix, ops.TableFill, vm.tableFill)
+	vm.funcTable[ops.RefNull] = vm.refNull
+	vm.funcTable[ops.RefIsNull] = vm.refIsNull
+	vm.funcTable[ops.RefFunc] = vm.refFunc
+
 	vm.funcTable[ops.Drop] = vm.drop
 	vm.funcTable[ops.Select] = vm.selectOp
+	vm.funcTable[ops.SelectTyped] = vm.selectOp
+	vm.funcTable[compile.OpSelectWide] = vm.selectWide
 
 	vm.funcTable[ops.GetLocal] = vm.getLocal
 	vm.funcTable[ops.SetLocal] = vm.setLocal
@@ -183,4 +218,34 @@ func (vm *VM) newFuncTable() {
 
 	vm.funcTable[ops.Call] = vm.call
 	vm.funcTable[ops.CallIndirect] = vm.callIndirect
+	vm.funcTable[ops.ReturnCall] = vm.returnCall
+	vm.funcTable[ops.ReturnCallIndirect] = vm.returnCallIndirect
+
+	vm.newSIMDFuncTable()
+}
+
+// setPrefixedFunc registers fn as the implementation of the operator encoded
+// as prefix followed by the sub-opcode sub.
+func (vm *VM) setPrefixedFunc(prefix byte, sub uint32, fn func()) {
+	if vm.prefixedFuncTable == nil {
+		vm.prefixedFuncTable = make(map[byte][]func())
+	}
+	table := vm.prefixedFuncTable[prefix]
+	for uint32(len(table)) <= sub {
+		table = append(table, nil)
+	}
+	table[sub] = fn
+	vm.prefixedFuncTable[prefix] = table
+	vm.funcTable[prefix] = func() { vm.execPrefixed(prefix) }
+}
+
+// execPrefixed executes the operator encoded as prefix followed by the
+// sub-opcode at the current position in the code.
+func (vm *VM) execPrefixed(prefix byte) {
+	sub := vm.fetchUint32()
+	table := vm.prefixedFuncTable[prefix]
+	if sub >= uint32(len(table)) || table[sub] == nil {
+		panic(ops.InvalidPrefixedOpcodeError{Prefix: prefix, Sub: sub})
+	}
+	table[sub]()
 }
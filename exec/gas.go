@@ -0,0 +1,246 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"fmt"
+
+	"github.com/ontio/wagon/disasm"
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// Versions of the built-in gas schedules. A chain selects the version that is
+// active at a given block height and passes the matching schedule to
+// CompileModule, so that prices can change at a fork height while older
+// blocks keep being replayed with the schedule they were executed with.
+const (
+	// GasScheduleV0 charges one unit per executed instruction, and one unit
+	// per byte or table element written by a bulk memory operator. It is
+	// the pricing used by wagon before gas schedules were introduced.
+	GasScheduleV0 uint32 = iota
+	// GasScheduleV1 prices instructions by their relative execution cost.
+	GasScheduleV1
+	// GasScheduleV2 extends GasScheduleV1 with a price for every page of
+	// linear memory allocated, for the bytes and table elements written by
+	// the bulk memory operators and for the SIMD operators.
+	GasScheduleV2
+
+	// LatestGasScheduleVersion is the newest built-in schedule version.
+	LatestGasScheduleVersion = GasScheduleV2
+)
+
+// UnknownGasScheduleError is returned by NewGasSchedule when no built-in
+// schedule exists for the requested version.
+type UnknownGasScheduleError uint32
+
+func (e UnknownGasScheduleError) Error() string {
+	return fmt.Sprintf("exec: unknown gas schedule version: %d", uint32(e))
+}
+
+// GasSchedule describes how much gas each instruction costs. The cost of an
+// instruction is computed when the module is compiled and charged at run time
+// through the gas counters inserted by the compiler.
+type GasSchedule struct {
+	// Version is the version of the built-in schedule this schedule was
+	// derived from.
+	Version uint32
+
+	// OpCost is the base cost of every opcode. The base cost of a prefixed
+	// operator is the cost of its prefix byte, unless it is listed in
+	// PrefixedOpCost.
+	OpCost [256]uint64
+	// PrefixedOpCost is the base cost of individual prefixed operators.
+	PrefixedOpCost map[ops.PrefixedOpcode]uint64
+	// MemoryByteCost is charged for every byte read or written by a load
+	// or store instruction, in addition to its base cost.
+	MemoryByteCost uint64
+	// CallCost is charged for every call and return_call, in addition to
+	// its base cost.
+	CallCost uint64
+	// CallIndirectCost is charged for every call_indirect and
+	// return_call_indirect, in addition to its base cost.
+	CallIndirectCost uint64
+	// MemoryPageCost is charged for every page of linear memory allocated,
+	// either initially when the VM is instantiated or by grow_memory.
+	MemoryPageCost uint64
+	// BulkByteCost is charged at run time for every byte written by
+	// memory.init, memory.copy and memory.fill.
+	BulkByteCost uint64
+	// TableElemCost is charged at run time for every element written by
+	// table.init, table.copy, table.grow and table.fill.
+	TableElemCost uint64
+}
+
+// NewGasSchedule returns a copy of the built-in schedule with the given
+// version. The returned schedule may be modified freely by the caller.
+func NewGasSchedule(version uint32) (*GasSchedule, error) {
+	if int(version) >= len(gasSchedules) {
+		return nil, UnknownGasScheduleError(version)
+	}
+	schedule := *gasSchedules[version]
+	if costs := schedule.PrefixedOpCost; costs != nil {
+		schedule.PrefixedOpCost = make(map[ops.PrefixedOpcode]uint64, len(costs))
+		for op, cost := range costs {
+			schedule.PrefixedOpCost[op] = cost
+		}
+	}
+	return &schedule, nil
+}
+
+// DefaultGasSchedule returns the schedule used when CompileModule is given a
+// nil schedule, which is GasScheduleV0.
+func DefaultGasSchedule() *GasSchedule {
+	schedule, _ := NewGasSchedule(GasScheduleV0)
+	return schedule
+}
+
+var gasSchedules = []*GasSchedule{
+	GasScheduleV0: gasScheduleV0(),
+	GasScheduleV1: gasScheduleV1(),
+	GasScheduleV2: gasScheduleV2(),
+}
+
+func gasScheduleV0() *GasSchedule {
+	s := &GasSchedule{
+		Version:       GasScheduleV0,
+		BulkByteCost:  1,
+		TableElemCost: 1,
+	}
+	for i := range s.OpCost {
+		s.OpCost[i] = 1
+	}
+	return s
+}
+
+func gasScheduleV1() *GasSchedule {
+	s := &GasSchedule{
+		Version:          GasScheduleV1,
+		MemoryByteCost:   1,
+		CallCost:         10,
+		CallIndirectCost: 15,
+	}
+	for i := range s.OpCost {
+		s.OpCost[i] = 1
+	}
+	for _, op := range []byte{ops.I32Mul, ops.I64Mul, ops.F32Mul, ops.F64Mul} {
+		s.OpCost[op] = 3
+	}
+	for _, op := range []byte{
+		ops.I32DivS, ops.I32DivU, ops.I32RemS, ops.I32RemU,
+		ops.I64DivS, ops.I64DivU, ops.I64RemS, ops.I64RemU,
+		ops.F32Div, ops.F64Div, ops.F32Sqrt, ops.F64Sqrt,
+	} {
+		s.OpCost[op] = 16
+	}
+	for _, op := range []byte{
+		ops.F32Add, ops.F32Sub, ops.F64Add, ops.F64Sub,
+		ops.F32Min, ops.F32Max, ops.F64Min, ops.F64Max,
+		ops.F32Ceil, ops.F32Floor, ops.F32Trunc, ops.F32Nearest,
+		ops.F64Ceil, ops.F64Floor, ops.F64Trunc, ops.F64Nearest,
+	} {
+		s.OpCost[op] = 4
+	}
+	return s
+}
+
+func gasScheduleV2() *GasSchedule {
+	s := gasScheduleV1()
+	s.Version = GasScheduleV2
+	s.MemoryPageCost = 4096
+	s.BulkByteCost = 1
+	s.TableElemCost = 10
+
+	// SIMD operators process up to 16 lanes, and are priced like the
+	// scalar operators applied to every lane, at a discount.
+	simd := func(cost uint64, subs ...uint32) {
+		for _, sub := range subs {
+			s.PrefixedOpCost[ops.PrefixedOpcode{Prefix: ops.SIMDPrefix, Sub: sub}] = cost
+		}
+	}
+	s.PrefixedOpCost = make(map[ops.PrefixedOpcode]uint64)
+	simd(2,
+		ops.I8x16Shuffle, ops.I8x16Swizzle, ops.I8x16Popcnt, ops.I8x16Bitmask, ops.I16x8Bitmask,
+		ops.I8x16NarrowI16x8S, ops.I8x16NarrowI16x8U, ops.I16x8NarrowI32x4S, ops.I16x8NarrowI32x4U,
+	)
+	simd(4,
+		ops.I16x8Mul, ops.I32x4Mul, ops.I64x2Mul, ops.I16x8Q15mulrSatS,
+		ops.I16x8ExtmulLowI8x16S, ops.I16x8ExtmulHighI8x16S, ops.I16x8ExtmulLowI8x16U, ops.I16x8ExtmulHighI8x16U,
+		ops.I32x4ExtmulLowI16x8S, ops.I32x4ExtmulHighI16x8S, ops.I32x4ExtmulLowI16x8U, ops.I32x4ExtmulHighI16x8U,
+		ops.I64x2ExtmulLowI32x4S, ops.I64x2ExtmulHighI32x4S, ops.I64x2ExtmulLowI32x4U, ops.I64x2ExtmulHighI32x4U,
+	)
+	simd(6, ops.I32x4DotI16x8S)
+	simd(8,
+		ops.F32x4Add, ops.F32x4Sub, ops.F32x4Min, ops.F32x4Max,
+		ops.F32x4Ceil, ops.F32x4Floor, ops.F32x4Trunc, ops.F32x4Nearest,
+		ops.F64x2Add, ops.F64x2Sub, ops.F64x2Min, ops.F64x2Max,
+		ops.F64x2Ceil, ops.F64x2Floor, ops.F64x2Trunc, ops.F64x2Nearest,
+		ops.F32x4Eq, ops.F32x4Ne, ops.F32x4Lt, ops.F32x4Gt, ops.F32x4Le, ops.F32x4Ge, ops.F32x4Pmin, ops.F32x4Pmax,
+		ops.F64x2Eq, ops.F64x2Ne, ops.F64x2Lt, ops.F64x2Gt, ops.F64x2Le, ops.F64x2Ge, ops.F64x2Pmin, ops.F64x2Pmax,
+		ops.F32x4DemoteF64x2Zero, ops.F64x2PromoteLowF32x4,
+		ops.I32x4TruncSatF32x4S, ops.I32x4TruncSatF32x4U, ops.I32x4TruncSatF64x2SZero, ops.I32x4TruncSatF64x2UZero,
+		ops.F32x4ConvertI32x4S, ops.F32x4ConvertI32x4U, ops.F64x2ConvertLowI32x4S, ops.F64x2ConvertLowI32x4U,
+	)
+	simd(12, ops.F32x4Mul, ops.F64x2Mul)
+	simd(64, ops.F32x4Div, ops.F32x4Sqrt, ops.F64x2Div, ops.F64x2Sqrt)
+	return s
+}
+
+// cost returns the gas charged for executing instr once.
+func (s *GasSchedule) cost(instr disasm.Instr) uint64 {
+	op := instr.Op.Code
+	cost := s.OpCost[op]
+	if instr.Op.IsPrefixed() {
+		if c, ok := s.PrefixedOpCost[ops.PrefixedOpcode{Prefix: op, Sub: instr.Op.Sub}]; ok {
+			cost = c
+		}
+	}
+	switch op {
+	case ops.Call, ops.ReturnCall:
+		cost += s.CallCost
+	case ops.CallIndirect, ops.ReturnCallIndirect:
+		cost += s.CallIndirectCost
+	default:
+		cost += memoryAccessWidth(instr.Op) * s.MemoryByteCost
+	}
+	return cost
+}
+
+// memoryAccessWidth returns the number of bytes of linear memory accessed by
+// the load or store operator op, or 0 if op does not access memory.
+func memoryAccessWidth(op ops.Op) uint64 {
+	if op.Code == ops.SIMDPrefix {
+		return simdMemoryAccessWidth(op.Sub)
+	}
+	switch op.Code {
+	case ops.I32Load8s, ops.I32Load8u, ops.I64Load8s, ops.I64Load8u, ops.I32Store8, ops.I64Store8:
+		return 1
+	case ops.I32Load16s, ops.I32Load16u, ops.I64Load16s, ops.I64Load16u, ops.I32Store16, ops.I64Store16:
+		return 2
+	case ops.I32Load, ops.F32Load, ops.I64Load32s, ops.I64Load32u, ops.I32Store, ops.F32Store, ops.I64Store32:
+		return 4
+	case ops.I64Load, ops.F64Load, ops.I64Store, ops.F64Store:
+		return 8
+	}
+	return 0
+}
+
+// simdMemoryAccessWidth is the counterpart of memoryAccessWidth for the SIMD
+// operator with the sub-opcode sub.
+func simdMemoryAccessWidth(sub uint32) uint64 {
+	switch sub {
+	case ops.V128Load8Splat, ops.V128Load8Lane, ops.V128Store8Lane:
+		return 1
+	case ops.V128Load16Splat, ops.V128Load16Lane, ops.V128Store16Lane:
+		return 2
+	case ops.V128Load32Splat, ops.V128Load32Lane, ops.V128Store32Lane, ops.V128Load32Zero:
+		return 4
+	case ops.V128Load8x8S, ops.V128Load8x8U, ops.V128Load16x4S, ops.V128Load16x4U, ops.V128Load32x2S, ops.V128Load32x2U,
+		ops.V128Load64Splat, ops.V128Load64Lane, ops.V128Store64Lane, ops.V128Load64Zero:
+		return 8
+	case ops.V128Load, ops.V128Store:
+		return 16
+	}
+	return 0
+}
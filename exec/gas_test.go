@@ -0,0 +1,263 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// moduleGas defines div(i32, i32) i32, returning the unsigned quotient of
+// its arguments, and load(i32) i64, loading an i64 from the given address.
+var moduleGas = moduleBytes(
+	section(0x01, 0x02, 0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7f, 0x60, 0x01, 0x7f, 0x01, 0x7e),
+	section(0x03, 0x02, 0x00, 0x01),
+	section(0x05, 0x01, 0x00, 0x01),
+	section(0x0a, 0x02,
+		0x07, 0x00, 0x20, 0x00, 0x20, 0x01, 0x6e, 0x0b,
+		0x07, 0x00, 0x20, 0x00, 0x29, 0x03, 0x00, 0x0b),
+)
+
+func TestGasSchedule(t *testing.T) {
+	m := readTestModule(t, moduleGas, nil)
+
+	v1, err := NewGasSchedule(GasScheduleV1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	custom := *v1
+	custom.OpCost[ops.I32DivU] = 100
+	custom.MemoryByteCost = 10
+
+	for _, tc := range []struct {
+		name     string
+		schedule *GasSchedule
+		div      uint64
+		load     uint64
+	}{
+		// get_local, get_local and i32.div_u, plus the nop the compiler appends to
+		// every function body.
+		{"default", nil, 4, 3},
+		{"v1", v1, 19, 11},
+		{"custom", &custom, 103, 83},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			compiled, err := CompileModule(m, tc.schedule)
+			if err != nil {
+				t.Fatalf("could not compile module: %v", err)
+			}
+			vm := newTestVM(t, compiled)
+			for _, fn := range []struct {
+				index int64
+				args  []uint64
+				want  uint64
+			}{
+				{0, []uint64{7, 2}, tc.div},
+				{1, []uint64{0}, tc.load},
+			} {
+				vm.ExecMetrics = testGas(math.MaxUint64)
+				if _, err := vm.ExecCode(fn.index, fn.args...); err != nil {
+					t.Fatalf("function %d: %v", fn.index, err)
+				}
+				if got := math.MaxUint64 - *vm.ExecMetrics.ExecStep; got != fn.want {
+					t.Errorf("function %d: charged %d, want %d", fn.index, got, fn.want)
+				}
+			}
+		})
+	}
+}
+
+func TestNewGasSchedule(t *testing.T) {
+	s, err := NewGasSchedule(LatestGasScheduleVersion)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Version != LatestGasScheduleVersion {
+		t.Errorf("got version %d, want %d", s.Version, LatestGasScheduleVersion)
+	}
+	s.OpCost[ops.Nop] = 42
+	if gasSchedules[LatestGasScheduleVersion].OpCost[ops.Nop] == 42 {
+		t.Error("NewGasSchedule returned a shared schedule")
+	}
+
+	if _, err := NewGasSchedule(LatestGasScheduleVersion + 1); err != UnknownGasScheduleError(LatestGasScheduleVersion+1) {
+		t.Errorf("got error %v for unknown version", err)
+	}
+}
+
+func TestGasScheduleV2(t *testing.T) {
+	// Published schedules never change, so V2 only adds the page, bulk and
+	// SIMD prices to V1.
+	v1, _ := NewGasSchedule(GasScheduleV1)
+	v2, _ := NewGasSchedule(GasScheduleV2)
+	if v1.MemoryPageCost != 0 || v1.BulkByteCost != 0 || v1.TableElemCost != 0 || v1.PrefixedOpCost != nil {
+		t.Errorf("V1 charges for pages, bulk or SIMD operators: %+v", v1)
+	}
+	if v2.MemoryPageCost == 0 {
+		t.Error("V2 does not charge for memory pages")
+	}
+	v2.Version = v1.Version
+	v2.MemoryPageCost = v1.MemoryPageCost
+	v2.BulkByteCost = v1.BulkByteCost
+	v2.TableElemCost = v1.TableElemCost
+	v2.PrefixedOpCost = v1.PrefixedOpCost
+	if !reflect.DeepEqual(v1, v2) {
+		t.Error("V2 differs from V1 in more than the page, bulk and SIMD prices")
+	}
+}
+
+// moduleGrow has one page of memory and defines grow(i32) i32, which grows
+// the memory by the given number of pages.
+var moduleGrow = moduleBytes(
+	section(0x01, 0x01, 0x60, 0x01, 0x7f, 0x01, 0x7f),
+	section(0x03, 0x01, 0x00),
+	section(0x05, 0x01, 0x00, 0x01),
+	section(0x0a, 0x01, 0x06, 0x00, 0x20, 0x00, 0x40, 0x00, 0x0b),
+)
+
+func TestMemoryGas(t *testing.T) {
+	schedule := DefaultGasSchedule()
+	schedule.MemoryPageCost = 10
+	compiled := compileTestModule(t, moduleGrow, schedule)
+	metrics := testGas(5)
+	execStep := metrics.ExecStep
+	if _, err := NewVMWithGas(compiled, 4*wasmPageSize, metrics); err == nil {
+		t.Fatal("instantiated the vm without enough gas for its memory")
+	}
+
+	// The initial page is charged when the VM is instantiated.
+	*execStep = 1000
+	vm, err := NewVMWithGas(compiled, 4*wasmPageSize, metrics)
+	if err != nil {
+		t.Fatalf("could not instantiate vm: %v", err)
+	}
+	vm.RecoverPanic = true
+	vm.CallStackDepth = 10
+	if got, want := 1000-*execStep, uint64(10); got != want {
+		t.Errorf("instantiation charged %d, want %d", got, want)
+	}
+
+	// Two new pages and three instructions.
+	*execStep = 1000
+	res, err := vm.ExecCode(0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != uint32(1) {
+		t.Errorf("grow(2) = %v, want 1", res)
+	}
+	if got, want := 1000-*execStep, uint64(20+3); got != want {
+		t.Errorf("grow(2) charged %d, want %d", got, want)
+	}
+
+	// Growing past MemoryLimitation fails without charging for the pages.
+	*execStep = 1000
+	res, err = vm.ExecCode(0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != uint32(math.MaxUint32) {
+		t.Errorf("grow(2) = %v, want -1", res)
+	}
+	if got, want := 1000-*execStep, uint64(3); got != want {
+		t.Errorf("failed grow(2) charged %d, want %d", got, want)
+	}
+
+	// Running out of steps while growing suspends the VM before the
+	// memory is grown.
+	*execStep = 5
+	if _, err = vm.ExecCode(0, 1); err == nil || !vm.Suspended() {
+		t.Fatalf("grow(1) returned %v without enough steps, want a suspended execution", err)
+	}
+	if got, want := len(vm.Memory()), 3*wasmPageSize; got != want {
+		t.Errorf("memory size is %d after failed grow, want %d", got, want)
+	}
+	*execStep = 1000
+	res, err = vm.Resume()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != uint32(3) {
+		t.Errorf("resumed grow(1) = %v, want 3", res)
+	}
+	if got, want := 1000-*execStep, uint64(10+3); got != want {
+		t.Errorf("resumed grow(1) charged %d, want %d", got, want)
+	}
+}
+
+func TestResumeChargesOnce(t *testing.T) {
+	compiled := compileTestModule(t, moduleSum, nil)
+	vm := newTestVM(t, compiled)
+	if _, err := vm.ExecCode(0, 10); err != nil {
+		t.Fatal(err)
+	}
+	want := math.MaxUint64 - *vm.ExecMetrics.GasLimit
+
+	// Running out of gas sets the gas limit to zero. The gas it could not
+	// cover is paid when the execution is resumed, so the execution costs
+	// as much gas as an uninterrupted one.
+	vm = newTestVM(t, compiled)
+	gasLimit := vm.ExecMetrics.GasLimit
+	*gasLimit = 5
+	given := uint64(5)
+	res, err := vm.ExecCode(0, 10)
+	for err != nil {
+		if !vm.Suspended() {
+			t.Fatalf("execution failed without being suspended: %v", err)
+		}
+		if *gasLimit != 0 {
+			t.Fatalf("gas limit is %d after running out of gas, want 0", *gasLimit)
+		}
+		*gasLimit += 3
+		given += 3
+		res, err = vm.Resume()
+	}
+	if res != uint32(110) {
+		t.Errorf("sum(10) = %v, want 110", res)
+	}
+	if got := given - *gasLimit; got != want {
+		t.Errorf("charged %d gas, want %d", got, want)
+	}
+}
+
+func TestCheckExecLimitOverflow(t *testing.T) {
+	gasLimit, execStep := uint64(100), uint64(math.MaxUint64)
+	vm := &VM{ExecMetrics: &Gas{GasLimit: &gasLimit, GasFactor: 1, ExecStep: &execStep, LocalGasCounter: 10}}
+	if err := vm.CheckExecLimit(math.MaxUint64 - 5); err == nil {
+		t.Fatal("an overflowing charge succeeded")
+	}
+	if gasLimit != 0 {
+		t.Errorf("gas limit is %d after an overflowing charge, want 0", gasLimit)
+	}
+}
+
+func TestPrefixedOpCost(t *testing.T) {
+	schedule, err := NewGasSchedule(GasScheduleV0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schedule.OpCost[ops.MiscPrefix] = 7
+	schedule.PrefixedOpCost = map[ops.PrefixedOpcode]uint64{
+		{Prefix: ops.MiscPrefix, Sub: ops.MemoryCopy}: 50,
+	}
+	vm := newTestVM(t, compileTestModuleWithFeatures(t, moduleBulk, schedule, ops.FeatureBulkMemory))
+
+	used := func(fn int64) uint64 {
+		before := *vm.ExecMetrics.ExecStep
+		mustExec(t, vm, fn, 0, 0, 0)
+		return before - *vm.ExecMetrics.ExecStep
+	}
+	// Both functions push their three parameters, and the compiler
+	// appends a nop to them.
+	if got, want := used(bulkFill), uint64(4+7); got != want {
+		t.Errorf("memory.fill used %d gas, want %d", got, want)
+	}
+	if got, want := used(bulkCopy), uint64(4+50); got != want {
+		t.Errorf("memory.copy used %d gas, want %d", got, want)
+	}
+}
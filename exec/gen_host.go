@@ -0,0 +1,116 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+// +build ignore
+
+// gen_host generates host_adapters.go, which holds the HostFunc* adapters of
+// typed Go functions to HostFunction for the signatures listed below. Other
+// signatures are served by NewHostFunction or by reflection.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+type valueType struct {
+	name   string // name in the adapter names, e.g. I32
+	wasm   string // wasm.ValueType constant
+	goType string
+	decode string // converts a uint64 to goType
+	encode string // converts a goType to a uint64
+}
+
+var (
+	i32 = &valueType{"I32", "wasm.ValueTypeI32", "uint32", "uint32(%s)", "uint64(%s)"}
+	i64 = &valueType{"I64", "wasm.ValueTypeI64", "uint64", "%s", "%s"}
+	f32 = &valueType{"F32", "wasm.ValueTypeF32", "float32", "math.Float32frombits(uint32(%s))", "uint64(math.Float32bits(%s))"}
+	f64 = &valueType{"F64", "wasm.ValueTypeF64", "float64", "math.Float64frombits(%s)", "math.Float64bits(%s)"}
+)
+
+// signatures lists the signatures of the generated adapters: those of the
+// spectest host module and of the tests.
+var signatures = []struct {
+	params []*valueType
+	result *valueType
+}{
+	{nil, nil},
+	{[]*valueType{i32}, nil},
+	{[]*valueType{i64}, nil},
+	{[]*valueType{f32}, nil},
+	{[]*valueType{f64}, nil},
+	{[]*valueType{i32, f32}, nil},
+	{[]*valueType{f64, f64}, nil},
+	{[]*valueType{f64}, f64},
+	{[]*valueType{i32, f32}, f32},
+	{[]*valueType{i32, i32}, i64},
+	{[]*valueType{i32, i64}, i64},
+}
+
+func main() {
+	buf := new(bytes.Buffer)
+	fmt.Fprint(buf, `// Code generated by gen_host.go; DO NOT EDIT.
+
+package exec
+
+import (
+	"math"
+
+	"github.com/ontio/wagon/wasm"
+)
+`)
+	for _, sig := range signatures {
+		generate(buf, sig.params, sig.result)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("host_adapters.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generate(buf *bytes.Buffer, params []*valueType, result *valueType) {
+	name := "HostFunc"
+	var sigParams, goParams, args, wasmParams, wasmResults []string
+	for i, t := range params {
+		name += t.name
+		sigParams = append(sigParams, strings.ToLower(t.name))
+		goParams = append(goParams, fmt.Sprintf("a%d %s", i, t.goType))
+		args = append(args, fmt.Sprintf(t.decode, fmt.Sprintf("args[%d]", i)))
+		wasmParams = append(wasmParams, t.wasm)
+	}
+	goResults := "error"
+	sigResult := ""
+	if result != nil {
+		name += "To" + result.name
+		goResults = fmt.Sprintf("(%s, error)", result.goType)
+		sigResult = strings.ToLower(result.name)
+		wasmResults = append(wasmResults, result.wasm)
+	}
+
+	fmt.Fprintf(buf, "\n// %s returns a host function with the signature (%s) -> (%s) that calls fn.\n",
+		name, strings.Join(sigParams, ", "), sigResult)
+	fmt.Fprintf(buf, "func %s(fn func(%s) %s) wasm.Function {\n",
+		name, strings.Join(append([]string{"proc *Process"}, goParams...), ", "), goResults)
+	fmt.Fprintf(buf, "sig := wasm.FunctionSig{ParamTypes: []wasm.ValueType{%s}, ReturnTypes: []wasm.ValueType{%s}}\n",
+		strings.Join(wasmParams, ", "), strings.Join(wasmResults, ", "))
+	fmt.Fprintf(buf, "return NewHostFunction(sig, func(proc *Process, args []uint64) ([]uint64, error) {\n")
+	call := fmt.Sprintf("fn(%s)", strings.Join(append([]string{"proc"}, args...), ", "))
+	if result == nil {
+		fmt.Fprintf(buf, "return nil, %s\n", call)
+	} else {
+		fmt.Fprintf(buf, "r, err := %s\n", call)
+		fmt.Fprintf(buf, "if err != nil {\nreturn nil, err\n}\n")
+		fmt.Fprintf(buf, "return []uint64{%s}, nil\n", fmt.Sprintf(result.encode, "r"))
+	}
+	fmt.Fprintf(buf, "})\n}\n")
+}
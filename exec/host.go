@@ -0,0 +1,90 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+//go:generate go run gen_host.go
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ontio/wagon/wasm"
+)
+
+// HostFunction is a host function called without reflection. args holds the
+// arguments of the call and the returned slice its results, one value per
+// parameter and result of the signature of the function: i32 and i64 values
+// are stored in the low bits, f32 and f64 values as their IEEE 754 binary
+// representation. v128 values take two values, the low 64 bits first. args
+// is only valid until the function returns.
+//
+// If the function returns a non-nil error, the execution traps with code
+// TrapHostFunction and the error is available through errors.Is and
+// errors.As.
+type HostFunction func(proc *Process, args []uint64) ([]uint64, error)
+
+var hostFunctionType = reflect.TypeOf(HostFunction(nil))
+
+// NewHostFunction returns an entry of the function index space of a module
+// with the signature sig, that calls fn. The signature is checked against
+// the import declarations when a module importing the function is read.
+//
+// The HostFunc* functions return entries for a few common signatures,
+// calling functions with typed arguments and results. Functions with other
+// signatures take their arguments from args, or are given to the module as
+// reflected Go functions.
+func NewHostFunction(sig wasm.FunctionSig, fn HostFunction) wasm.Function {
+	sig.Form = wasm.TypeFunc
+	return wasm.Function{
+		Sig:  &sig,
+		Body: &wasm.FunctionBody{},
+		Host: reflect.ValueOf(fn),
+	}
+}
+
+// hostFunction is the function of the index space created by NewHostFunction.
+type hostFunction struct {
+	fn      HostFunction
+	params  int
+	results int
+}
+
+func (fn hostFunction) call(vm *VM, index int64) {
+	vm.checkCallStackDepth()
+	defer func() {
+		vm.CallStackDepth++
+	}()
+
+	sp := len(vm.ctx.stack) - fn.params
+	args := vm.ctx.stack[sp:]
+	vm.ctx.stack = vm.ctx.stack[:sp]
+
+	results, err := fn.callHost(vm.process(), index, args)
+	if err != nil {
+		panic(hostPanic{index: index, value: err})
+	}
+	if vm.paused {
+		// The results are supplied to (*VM).Resume instead.
+		vm.pendingResults = fn.results
+		return
+	}
+	if len(results) != fn.results {
+		panic(hostPanic{index: index, value: fmt.Errorf("exec: host function returned %d values, expected %d", len(results), fn.results)})
+	}
+	for _, v := range results {
+		vm.pushUint64(v)
+	}
+}
+
+// callHost calls the host function, wrapping the value it may panic with in
+// a hostPanic.
+func (fn hostFunction) callHost(proc *Process, index int64, args []uint64) ([]uint64, error) {
+	defer func() {
+		if r := recover(); r != nil {
+			panic(hostPanic{index: index, value: r})
+		}
+	}()
+	return fn.fn(proc, args)
+}
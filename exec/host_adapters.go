@@ -0,0 +1,113 @@
+// Code generated by gen_host.go; DO NOT EDIT.
+
+package exec
+
+import (
+	"math"
+
+	"github.com/ontio/wagon/wasm"
+)
+
+// HostFunc returns a host function with the signature () -> () that calls fn.
+func HostFunc(fn func(proc *Process) error) wasm.Function {
+	sig := wasm.FunctionSig{ParamTypes: []wasm.ValueType{}, ReturnTypes: []wasm.ValueType{}}
+	return NewHostFunction(sig, func(proc *Process, args []uint64) ([]uint64, error) {
+		return nil, fn(proc)
+	})
+}
+
+// HostFuncI32 returns a host function with the signature (i32) -> () that calls fn.
+func HostFuncI32(fn func(proc *Process, a0 uint32) error) wasm.Function {
+	sig := wasm.FunctionSig{ParamTypes: []wasm.ValueType{wasm.ValueTypeI32}, ReturnTypes: []wasm.ValueType{}}
+	return NewHostFunction(sig, func(proc *Process, args []uint64) ([]uint64, error) {
+		return nil, fn(proc, uint32(args[0]))
+	})
+}
+
+// HostFuncI64 returns a host function with the signature (i64) -> () that calls fn.
+func HostFuncI64(fn func(proc *Process, a0 uint64) error) wasm.Function {
+	sig := wasm.FunctionSig{ParamTypes: []wasm.ValueType{wasm.ValueTypeI64}, ReturnTypes: []wasm.ValueType{}}
+	return NewHostFunction(sig, func(proc *Process, args []uint64) ([]uint64, error) {
+		return nil, fn(proc, args[0])
+	})
+}
+
+// HostFuncF32 returns a host function with the signature (f32) -> () that calls fn.
+func HostFuncF32(fn func(proc *Process, a0 float32) error) wasm.Function {
+	sig := wasm.FunctionSig{ParamTypes: []wasm.ValueType{wasm.ValueTypeF32}, ReturnTypes: []wasm.ValueType{}}
+	return NewHostFunction(sig, func(proc *Process, args []uint64) ([]uint64, error) {
+		return nil, fn(proc, math.Float32frombits(uint32(args[0])))
+	})
+}
+
+// HostFuncF64 returns a host function with the signature (f64) -> () that calls fn.
+func HostFuncF64(fn func(proc *Process, a0 float64) error) wasm.Function {
+	sig := wasm.FunctionSig{ParamTypes: []wasm.ValueType{wasm.ValueTypeF64}, ReturnTypes: []wasm.ValueType{}}
+	return NewHostFunction(sig, func(proc *Process, args []uint64) ([]uint64, error) {
+		return nil, fn(proc, math.Float64frombits(args[0]))
+	})
+}
+
+// HostFuncI32F32 returns a host function with the signature (i32, f32) -> () that calls fn.
+func HostFuncI32F32(fn func(proc *Process, a0 uint32, a1 float32) error) wasm.Function {
+	sig := wasm.FunctionSig{ParamTypes: []wasm.ValueType{wasm.ValueTypeI32, wasm.ValueTypeF32}, ReturnTypes: []wasm.ValueType{}}
+	return NewHostFunction(sig, func(proc *Process, args []uint64) ([]uint64, error) {
+		return nil, fn(proc, uint32(args[0]), math.Float32frombits(uint32(args[1])))
+	})
+}
+
+// HostFuncF64F64 returns a host function with the signature (f64, f64) -> () that calls fn.
+func HostFuncF64F64(fn func(proc *Process, a0 float64, a1 float64) error) wasm.Function {
+	sig := wasm.FunctionSig{ParamTypes: []wasm.ValueType{wasm.ValueTypeF64, wasm.ValueTypeF64}, ReturnTypes: []wasm.ValueType{}}
+	return NewHostFunction(sig, func(proc *Process, args []uint64) ([]uint64, error) {
+		return nil, fn(proc, math.Float64frombits(args[0]), math.Float64frombits(args[1]))
+	})
+}
+
+// HostFuncF64ToF64 returns a host function with the signature (f64) -> (f64) that calls fn.
+func HostFuncF64ToF64(fn func(proc *Process, a0 float64) (float64, error)) wasm.Function {
+	sig := wasm.FunctionSig{ParamTypes: []wasm.ValueType{wasm.ValueTypeF64}, ReturnTypes: []wasm.ValueType{wasm.ValueTypeF64}}
+	return NewHostFunction(sig, func(proc *Process, args []uint64) ([]uint64, error) {
+		r, err := fn(proc, math.Float64frombits(args[0]))
+		if err != nil {
+			return nil, err
+		}
+		return []uint64{math.Float64bits(r)}, nil
+	})
+}
+
+// HostFuncI32F32ToF32 returns a host function with the signature (i32, f32) -> (f32) that calls fn.
+func HostFuncI32F32ToF32(fn func(proc *Process, a0 uint32, a1 float32) (float32, error)) wasm.Function {
+	sig := wasm.FunctionSig{ParamTypes: []wasm.ValueType{wasm.ValueTypeI32, wasm.ValueTypeF32}, ReturnTypes: []wasm.ValueType{wasm.ValueTypeF32}}
+	return NewHostFunction(sig, func(proc *Process, args []uint64) ([]uint64, error) {
+		r, err := fn(proc, uint32(args[0]), math.Float32frombits(uint32(args[1])))
+		if err != nil {
+			return nil, err
+		}
+		return []uint64{uint64(math.Float32bits(r))}, nil
+	})
+}
+
+// HostFuncI32I32ToI64 returns a host function with the signature (i32, i32) -> (i64) that calls fn.
+func HostFuncI32I32ToI64(fn func(proc *Process, a0 uint32, a1 uint32) (uint64, error)) wasm.Function {
+	sig := wasm.FunctionSig{ParamTypes: []wasm.ValueType{wasm.ValueTypeI32, wasm.ValueTypeI32}, ReturnTypes: []wasm.ValueType{wasm.ValueTypeI64}}
+	return NewHostFunction(sig, func(proc *Process, args []uint64) ([]uint64, error) {
+		r, err := fn(proc, uint32(args[0]), uint32(args[1]))
+		if err != nil {
+			return nil, err
+		}
+		return []uint64{r}, nil
+	})
+}
+
+// HostFuncI32I64ToI64 returns a host function with the signature (i32, i64) -> (i64) that calls fn.
+func HostFuncI32I64ToI64(fn func(proc *Process, a0 uint32, a1 uint64) (uint64, error)) wasm.Function {
+	sig := wasm.FunctionSig{ParamTypes: []wasm.ValueType{wasm.ValueTypeI32, wasm.ValueTypeI64}, ReturnTypes: []wasm.ValueType{wasm.ValueTypeI64}}
+	return NewHostFunction(sig, func(proc *Process, args []uint64) ([]uint64, error) {
+		r, err := fn(proc, uint32(args[0]), args[1])
+		if err != nil {
+			return nil, err
+		}
+		return []uint64{r}, nil
+	})
+}
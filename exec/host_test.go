@@ -0,0 +1,164 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/ontio/wagon/wasm"
+)
+
+// moduleHost imports env.add(i32, i64) i64, env.fail() and env.half(f64) f64,
+// and defines functions with the same signatures calling them.
+var moduleHost = moduleBytes(
+	section(0x01, 0x03,
+		0x60, 0x02, 0x7f, 0x7e, 0x01, 0x7e,
+		0x60, 0x00, 0x00,
+		0x60, 0x01, 0x7c, 0x01, 0x7c),
+	section(0x02, 0x03,
+		0x03, 'e', 'n', 'v', 0x03, 'a', 'd', 'd', 0x00, 0x00,
+		0x03, 'e', 'n', 'v', 0x04, 'f', 'a', 'i', 'l', 0x00, 0x01,
+		0x03, 'e', 'n', 'v', 0x04, 'h', 'a', 'l', 'f', 0x00, 0x02),
+	section(0x03, 0x03, 0x00, 0x01, 0x02),
+	section(0x0a, 0x03,
+		0x08, 0x00, 0x20, 0x00, 0x20, 0x01, 0x10, 0x00, 0x0b,
+		0x04, 0x00, 0x10, 0x01, 0x0b,
+		0x06, 0x00, 0x20, 0x00, 0x10, 0x02, 0x0b),
+)
+
+var errHostFailed = errors.New("host function failed")
+
+var hostFuncs = map[string]wasm.Function{
+	"add": HostFuncI32I64ToI64(func(proc *Process, a uint32, b uint64) (uint64, error) {
+		return uint64(a) + b, nil
+	}),
+	"fail": HostFunc(func(proc *Process) error {
+		return errHostFailed
+	}),
+	"half": HostFuncF64ToF64(func(proc *Process, a float64) (float64, error) {
+		return a / 2, nil
+	}),
+}
+
+// hostImporter returns a resolver of modules exporting funcs.
+func hostImporter(funcs map[string]wasm.Function) wasm.ResolveFunc {
+	return func(name string) (*wasm.Module, error) {
+		m := wasm.NewModule()
+		m.Export = &wasm.SectionExports{Entries: map[string]wasm.ExportEntry{}}
+		for field, fn := range funcs {
+			m.Export.Entries[field] = wasm.ExportEntry{
+				FieldStr: field,
+				Kind:     wasm.ExternalFunction,
+				Index:    uint32(len(m.FunctionIndexSpace)),
+			}
+			m.FunctionIndexSpace = append(m.FunctionIndexSpace, fn)
+		}
+		return m, nil
+	}
+}
+
+func TestHostFunction(t *testing.T) {
+	compiled, err := CompileModule(readTestModule(t, moduleHost, hostImporter(hostFuncs)), nil)
+	if err != nil {
+		t.Fatalf("could not compile module: %v", err)
+	}
+	vm := newTestVM(t, compiled)
+
+	res, err := vm.ExecCode(3, math.MaxUint32, 1<<40)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := uint64(math.MaxUint32 + 1<<40); res != want {
+		t.Errorf("add(MaxUint32, 1<<40) = %v, want %v", res, want)
+	}
+
+	res, err = vm.ExecCode(5, math.Float64bits(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != 2.5 {
+		t.Errorf("half(5) = %v, want 2.5", res)
+	}
+
+	_, err = vm.ExecCode(4)
+	var trap *Trap
+	if !errors.As(err, &trap) || trap.Code != TrapHostFunction {
+		t.Fatalf("got error %v, want a host function trap", err)
+	}
+	if !errors.Is(err, errHostFailed) {
+		t.Errorf("trap %v is not caused by %v", err, errHostFailed)
+	}
+	if want := (TraceFrame{Func: 1, Name: "env.fail", Offset: -1}); trap.Backtrace[0] != want {
+		t.Errorf("trap raised in %v, want %v", trap.Backtrace[0], want)
+	}
+	if vm.CallStackDepth != math.MaxUint32 {
+		t.Errorf("host calls did not give back their call stack depth: %d", vm.CallStackDepth)
+	}
+}
+
+func TestHostFunctionSignatureMismatch(t *testing.T) {
+	funcs := make(map[string]wasm.Function)
+	for name, fn := range hostFuncs {
+		funcs[name] = fn
+	}
+	funcs["add"] = HostFuncI32I32ToI64(func(proc *Process, a, b uint32) (uint64, error) {
+		return uint64(a) + uint64(b), nil
+	})
+	_, err := wasm.ReadModule(bytes.NewReader(moduleHost), hostImporter(funcs))
+	want := wasm.InvalidImportError{ModuleName: "env", FieldName: "add", TypeIndex: 0}
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("got error %v, want %v", err, want)
+	}
+}
+
+func TestHostFuncFloat(t *testing.T) {
+	scale := HostFuncI32F32ToF32(func(proc *Process, a uint32, b float32) (float32, error) {
+		return float32(a) * b, nil
+	})
+	// The high bits of the f32 argument are not part of its value.
+	args := []uint64{3, 1<<32 | uint64(math.Float32bits(1.5))}
+	res, err := scale.Host.Interface().(HostFunction)(nil, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []uint64{uint64(math.Float32bits(4.5))}; !reflect.DeepEqual(res, want) {
+		t.Errorf("scale(3, 1.5) = %x, want %x", res, want)
+	}
+}
+
+func BenchmarkHostCall(b *testing.B) {
+	for _, bc := range []struct {
+		name string
+		add  wasm.Function
+	}{
+		{"reflect", wasm.Function{
+			Sig:  hostFuncs["add"].Sig,
+			Body: &wasm.FunctionBody{},
+			Host: reflect.ValueOf(func(proc *Process, a uint32, b uint64) uint64 {
+				return uint64(a) + b
+			}),
+		}},
+		{"HostFunction", hostFuncs["add"]},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			funcs := map[string]wasm.Function{"add": bc.add, "fail": hostFuncs["fail"], "half": hostFuncs["half"]}
+			compiled, err := CompileModule(readTestModule(b, moduleHost, hostImporter(funcs)), nil)
+			if err != nil {
+				b.Fatalf("could not compile module: %v", err)
+			}
+			vm := newTestVM(b, compiled)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := vm.ExecCode(3, 1, 2); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
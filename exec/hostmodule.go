@@ -0,0 +1,197 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ontio/wagon/wasm"
+	"github.com/ontio/wagon/wasm/leb128"
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// HostModule builds a module exporting functions, globals, a linear memory
+// and a table provided by the host, that can be imported by the modules
+// read with wasm.ReadModule. Its methods return the builder, so that calls
+// can be chained; the first error they encounter is returned by Module.
+type HostModule struct {
+	name   string
+	module *wasm.Module
+	err    error
+}
+
+// NewHostModule returns an empty host module, imported under name.
+func NewHostModule(name string) *HostModule {
+	m := wasm.NewModule()
+	m.Start = nil
+	m.Export.Entries = make(map[string]wasm.ExportEntry)
+	m.LinearMemoryIndexSpace = make([][]byte, 1)
+	return &HostModule{name: name, module: m}
+}
+
+// Name returns the name the module is imported under.
+func (h *HostModule) Name() string {
+	return h.name
+}
+
+// Func exports fn under name. fn is usually created by NewHostFunction or
+// one of the HostFunc* functions; its signature is checked against the
+// import declarations of the modules importing it.
+func (h *HostModule) Func(name string, fn wasm.Function) *HostModule {
+	if fn.Sig == nil || !fn.IsHost() {
+		h.fail(fmt.Errorf("exec: host module %s: function %s is not a host function with a signature", h.name, name))
+		return h
+	}
+	if fn.Body == nil {
+		fn.Body = &wasm.FunctionBody{}
+	}
+	h.export(name, wasm.ExternalFunction, len(h.module.FunctionIndexSpace))
+	h.module.FunctionIndexSpace = append(h.module.FunctionIndexSpace, fn)
+	return h
+}
+
+// Global exports under name an immutable global of type typ, holding value.
+// value is encoded like the arguments of a HostFunction.
+func (h *HostModule) Global(name string, typ wasm.ValueType, value uint64) *HostModule {
+	var init []byte
+	switch typ {
+	case wasm.ValueTypeI32:
+		init = leb128.AppendSleb128([]byte{ops.I32Const}, int64(int32(value)))
+	case wasm.ValueTypeI64:
+		init = leb128.AppendSleb128([]byte{ops.I64Const}, int64(value))
+	case wasm.ValueTypeF32:
+		init = make([]byte, 5)
+		init[0] = ops.F32Const
+		binary.LittleEndian.PutUint32(init[1:], uint32(value))
+	case wasm.ValueTypeF64:
+		init = make([]byte, 9)
+		init[0] = ops.F64Const
+		binary.LittleEndian.PutUint64(init[1:], value)
+	default:
+		h.fail(fmt.Errorf("exec: host module %s: global %s has invalid type %v", h.name, name, typ))
+		return h
+	}
+	init = append(init, ops.End)
+
+	global := wasm.GlobalEntry{Type: wasm.GlobalVar{Type: typ}, Init: init}
+	h.export(name, wasm.ExternalGlobal, len(h.module.GlobalIndexSpace))
+	h.module.Global.Globals = append(h.module.Global.Globals, global)
+	h.module.GlobalIndexSpace = append(h.module.GlobalIndexSpace, global)
+	return h
+}
+
+// Memory exports under name a zeroed linear memory with the given limits, in
+// pages. A module has at most one linear memory.
+func (h *HostModule) Memory(name string, limits wasm.ResizableLimits) *HostModule {
+	if len(h.module.Memory.Entries) != 0 {
+		h.fail(ErrMultipleLinearMemories)
+		return h
+	}
+	h.export(name, wasm.ExternalMemory, 0)
+	h.module.Memory.Entries = append(h.module.Memory.Entries, wasm.Memory{Limits: limits})
+	h.module.LinearMemoryIndexSpace[0] = make([]byte, uint64(limits.Initial)*wasmPageSize)
+	return h
+}
+
+// Table exports under name a table of functions with the given limits, whose
+// elements are uninitialized. Modules importing more than one table need the
+// reference types feature.
+func (h *HostModule) Table(name string, limits wasm.ResizableLimits) *HostModule {
+	h.export(name, wasm.ExternalTable, len(h.module.Table.Entries))
+	h.module.Table.Entries = append(h.module.Table.Entries, wasm.Table{
+		ElementType: wasm.ElemTypeAnyFunc,
+		Limits:      limits,
+	})
+	h.module.TableIndexSpace = append(h.module.TableIndexSpace, make([]wasm.TableEntry, limits.Initial))
+	return h
+}
+
+// Module returns the module built by h, or the first error encountered
+// while building it.
+func (h *HostModule) Module() (*wasm.Module, error) {
+	if h.err != nil {
+		return nil, h.err
+	}
+	return h.module, nil
+}
+
+func (h *HostModule) export(name string, kind wasm.External, index int) {
+	if _, ok := h.module.Export.Entries[name]; ok {
+		h.fail(fmt.Errorf("exec: host module %s: duplicate export %s", h.name, name))
+	}
+	h.module.Export.Entries[name] = wasm.ExportEntry{
+		FieldStr: name,
+		Kind:     kind,
+		Index:    uint32(index),
+	}
+}
+
+func (h *HostModule) fail(err error) {
+	if h.err == nil {
+		h.err = err
+	}
+}
+
+// UnknownModuleError is returned by (*Resolver).Resolve when no module with
+// the given name can be found.
+type UnknownModuleError string
+
+func (e UnknownModuleError) Error() string {
+	return fmt.Sprintf("exec: unknown module %s", string(e))
+}
+
+// Resolver resolves the imports of modules from host modules and, for the
+// other module names, from a function loading modules, e.g. from files.
+// Its Resolve method can be passed to wasm.ReadModule.
+type Resolver struct {
+	modules map[string]*wasm.Module
+
+	// Load, if not nil, is called to resolve the modules that were not
+	// added to the resolver.
+	Load wasm.ResolveFunc
+}
+
+// NewResolver returns a resolver of the given host modules, calling load
+// for the other modules.
+func NewResolver(load wasm.ResolveFunc, hosts ...*HostModule) (*Resolver, error) {
+	r := &Resolver{Load: load}
+	for _, h := range hosts {
+		if err := r.AddHost(h); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// AddHost adds the module built by h, replacing any module with the same
+// name.
+func (r *Resolver) AddHost(h *HostModule) error {
+	m, err := h.Module()
+	if err != nil {
+		return err
+	}
+	r.AddModule(h.Name(), m)
+	return nil
+}
+
+// AddModule adds m under name, replacing any module with the same name.
+func (r *Resolver) AddModule(name string, m *wasm.Module) {
+	if r.modules == nil {
+		r.modules = make(map[string]*wasm.Module)
+	}
+	r.modules[name] = m
+}
+
+// Resolve returns the module with the given name.
+func (r *Resolver) Resolve(name string) (*wasm.Module, error) {
+	if m, ok := r.modules[name]; ok {
+		return m, nil
+	}
+	if r.Load == nil {
+		return nil, UnknownModuleError(name)
+	}
+	return r.Load(name)
+}
@@ -0,0 +1,91 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"testing"
+
+	"github.com/ontio/wagon/wasm"
+)
+
+// moduleGlobal imports the global env.answer and defines answer() i32,
+// which returns it.
+var moduleGlobal = moduleBytes(
+	section(0x01, 0x01, 0x60, 0x00, 0x01, 0x7f),
+	section(0x02, 0x01, 0x03, 'e', 'n', 'v', 0x06, 'a', 'n', 's', 'w', 'e', 'r', 0x03, 0x7f, 0x00),
+	section(0x03, 0x01, 0x00),
+	section(0x0a, 0x01, 0x04, 0x00, 0x23, 0x00, 0x0b),
+)
+
+func TestHostModule(t *testing.T) {
+	env := NewHostModule("env").
+		Func("add", hostFuncs["add"]).
+		Func("fail", hostFuncs["fail"]).
+		Func("half", hostFuncs["half"]).
+		Global("answer", wasm.ValueTypeI32, 42).
+		Memory("memory", wasm.ResizableLimits{Initial: 2}).
+		Table("table", wasm.ResizableLimits{Initial: 3})
+	var loaded []string
+	r, err := NewResolver(func(name string) (*wasm.Module, error) {
+		loaded = append(loaded, name)
+		return nil, UnknownModuleError(name)
+	}, env)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		module []byte
+		fn     int64
+		args   []uint64
+		want   interface{}
+	}{
+		{moduleHost, 3, []uint64{1, 2}, uint64(3)},
+		{moduleGlobal, 0, nil, uint32(42)},
+	} {
+		compiled, err := CompileModule(readTestModule(t, tc.module, r.Resolve), nil)
+		if err != nil {
+			t.Fatalf("could not compile module: %v", err)
+		}
+		res, err := newTestVM(t, compiled).ExecCode(tc.fn, tc.args...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res != tc.want {
+			t.Errorf("function %d returned %v, want %v", tc.fn, res, tc.want)
+		}
+	}
+
+	m, err := env.Module()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(m.LinearMemoryIndexSpace[0]); n != 2*wasmPageSize {
+		t.Errorf("memory has %d bytes, want %d", n, 2*wasmPageSize)
+	}
+	if n := len(m.TableIndexSpace[0]); n != 3 {
+		t.Errorf("table has %d elements, want 3", n)
+	}
+
+	if _, err := r.Resolve("other"); err != UnknownModuleError("other") || len(loaded) != 1 {
+		t.Errorf("resolving an unknown module returned %v and loaded %v", err, loaded)
+	}
+}
+
+func TestHostModuleErrors(t *testing.T) {
+	for _, h := range []*HostModule{
+		NewHostModule("env").Func("f", hostFuncs["add"]).Global("f", wasm.ValueTypeI64, 0),
+		NewHostModule("env").Func("f", wasm.Function{}),
+		NewHostModule("env").Global("g", wasm.ValueType(0), 0),
+		NewHostModule("env").Memory("m1", wasm.ResizableLimits{}).Memory("m2", wasm.ResizableLimits{}),
+	} {
+		if _, err := NewResolver(nil, h); err == nil {
+			t.Errorf("invalid host module %v was accepted", h.module.Export.Entries)
+		}
+	}
+	if _, err := new(Resolver).Resolve("env"); err != UnknownModuleError("env") {
+		t.Errorf("got error %v, want %v", err, UnknownModuleError("env"))
+	}
+}
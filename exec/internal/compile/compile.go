@@ -43,6 +43,7 @@ import (
 	"encoding/binary"
 
 	"github.com/ontio/wagon/disasm"
+	"github.com/ontio/wagon/wasm"
 	ops "github.com/ontio/wagon/wasm/operators"
 )
 
@@ -57,7 +58,8 @@ import (
 // operator. A block with a signature will push a value of that type on the parent
 // stack (that is, the stack of the parent block where this block started). The
 // OpDiscardPreserveTop operator allows us to preserve this value while
-// discarding the remaining ones.
+// discarding the remaining ones. Blocks returning several values, as allowed
+// by the multi-value proposal, use OpDiscardPreserve instead.
 
 // Branches are rewritten as
 //     <jmp> <addr>
@@ -70,26 +72,36 @@ var (
 	// OpJmpZ jumps to the given address if the value at the top of the stack is zero.
 	OpJmpZ byte = 0x03
 	// OpJmpNz jumps to the given address if the value at the top of the
-	// stack is not zero. It also discards elements and optionally preserves
-	// the topmost value on the stack
+	// stack is not zero. It also discards elements while preserving a given
+	// number of values on the top of the stack.
 	OpJmpNz byte = 0x0d
 	// OpDiscard discards a given number of elements from the execution stack.
 	OpDiscard byte = 0x0b
 	// OpDiscardPreserveTop discards a given number of elements from the
 	// execution stack, while preserving the value on the top of the stack.
 	OpDiscardPreserveTop byte = 0x05
+	// OpDiscardPreserve discards a given number of elements from the
+	// execution stack, while preserving a given number of values on the
+	// top of the stack.
+	OpDiscardPreserve byte = 0x07
 	// Carefully chose a byte nerver used.
 	OpGasCounter byte = 0x06
+	// OpSelectWide is select on v128 operands, which take two slots.
+	OpSelectWide byte = 0x02
 )
 
+// v128 values take two consecutive slots of the stack, the locals and the
+// globals, the low 64 bits first. Accesses to v128 variables are compiled to
+// a pair of accesses to their slots, and drop of a v128 value to two drops.
+
 // Target is the "target" of a br_table instruction.
 // Unlike other control instructions, br_table does jumps and discarding all
 // by itself.
 type Target struct {
-	Addr        int64 // The absolute address of the target
-	Discard     int64 // The number of elements to discard
-	PreserveTop bool  // Whether the top of the stack is to be preserved
-	Return      bool  // Whether to return in order to take this branch/target
+	Addr     int64 // The absolute address of the target
+	Discard  int64 // The number of elements to discard
+	Preserve int64 // The number of values on the top of the stack to preserve
+	Return   bool  // Whether to return in order to take this branch/target
 }
 
 // BranchTable is the structure pointed to by a rewritten br_table instruction.
@@ -104,6 +116,13 @@ type BranchTable struct {
 	blocksLen     int      // The length of the blocks map in Compile when this table was initialized
 }
 
+// InstrOffset maps an instruction of the compiled code to the instruction
+// of the original function body it was compiled from.
+type InstrOffset struct {
+	PC     int64 // Offset of the compiled instruction
+	Offset int64 // Offset of the original instruction in the function body
+}
+
 // block stores the information relevant for a block created by a control operator
 // sequence (if...else...end, loop...end, and block...end)
 type block struct {
@@ -133,12 +152,41 @@ type block struct {
 	branchTables []*BranchTable   // All branch tables that were defined in this block.
 }
 
+// GasCost returns the amount of gas charged for executing an instruction once.
+type GasCost func(instr disasm.Instr) uint64
+
+// flatGasCost charges one unit per instruction.
+func flatGasCost(disasm.Instr) uint64 { return 1 }
+
 // Compile rewrites WebAssembly bytecode from its disassembly.
+// Along with the compiled code and its branch tables, it returns the offset
+// of every instruction of the compiled code, in increasing order, and the
+// offsets of the original instructions, sorted by compiled offset.
+// locals and globals are the types of the locals of the function, starting
+// with its parameters, and of the globals of the module. The immediates of
+// the local and global operators are rewritten to the slots holding the
+// variables.
+// The cost of the instructions of every straight-line sequence, as returned
+// by gasCost, is accumulated into the immediate of an OpGasCounter that is
+// emitted at the end of the sequence. If gasCost is nil, every instruction
+// costs one unit.
 // TODO(vibhavp): Add options for optimizing code. Operators like i32.reinterpret/f32
 // are no-ops, and can be safely removed.
-func Compile(disassembly []disasm.Instr) ([]byte, []*BranchTable) {
+func Compile(disassembly []disasm.Instr, gasCost GasCost, locals, globals []wasm.ValueType) ([]byte, []*BranchTable, []int64, []InstrOffset) {
+	if gasCost == nil {
+		gasCost = flatGasCost
+	}
+	localSlots, globalSlots := slotIndices(locals), slotIndices(globals)
+
 	buffer := new(bytes.Buffer)
 	branchTables := []*BranchTable{}
+	instrs := []int64{}
+	// emit writes the opcode of a compiled instruction.
+	emit := func(op byte) {
+		instrs = append(instrs, int64(buffer.Len()))
+		buffer.WriteByte(op)
+	}
+	offsets := []InstrOffset{}
 
 	curBlockDepth := -1
 	blocks := make(map[int]*block) // maps nesting depths (labels) to blocks
@@ -151,24 +199,50 @@ func Compile(disassembly []disasm.Instr) ([]byte, []*BranchTable) {
 			continue
 		}
 
-		scope_gas_counter += 1
+		offsets = append(offsets, InstrOffset{PC: int64(buffer.Len()), Offset: int64(instr.Offset)})
+		scope_gas_counter += gasCost(instr)
 		switch instr.Op.Code {
-		case ops.Unreachable, ops.Block, ops.Br, ops.BrIf, ops.BrTable, ops.Loop, ops.If, ops.Else, ops.CallIndirect, ops.Call, ops.Return, ops.End:
-			buffer.WriteByte(OpGasCounter)
+		case ops.Unreachable, ops.Block, ops.Br, ops.BrIf, ops.BrTable, ops.Loop, ops.If, ops.Else, ops.CallIndirect, ops.Call, ops.Return, ops.ReturnCall, ops.ReturnCallIndirect, ops.End:
+			emit(OpGasCounter)
 			binary.Write(buffer, binary.LittleEndian, scope_gas_counter)
 			scope_gas_counter = 0
 		}
 
 		switch instr.Op.Code {
-		//case ops.I32Load, ops.I64Load, ops.F32Load, ops.F64Load, ops.I32Load8s, ops.I32Load8u, ops.I32Load16s, ops.I32Load16u, ops.I64Load8s, ops.I64Load8u, ops.I64Load16s, ops.I64Load16u, ops.I64Load32s, ops.I64Load32u, ops.I32Store, ops.I64Store, ops.F32Store, ops.F64Store, ops.I32Store8, ops.I32Store16, ops.I64Store8, ops.I64Store16, ops.I64Store32:
-		case ops.I32Load, ops.I64Load, ops.I32Load8s, ops.I32Load8u, ops.I32Load16s, ops.I32Load16u, ops.I64Load8s, ops.I64Load8u, ops.I64Load16s, ops.I64Load16u, ops.I64Load32s, ops.I64Load32u, ops.I32Store, ops.I64Store, ops.I32Store8, ops.I32Store16, ops.I64Store8, ops.I64Store16, ops.I64Store32:
+		case ops.I32Load, ops.I64Load, ops.F32Load, ops.F64Load, ops.I32Load8s, ops.I32Load8u, ops.I32Load16s, ops.I32Load16u, ops.I64Load8s, ops.I64Load8u, ops.I64Load16s, ops.I64Load16u, ops.I64Load32s, ops.I64Load32u, ops.I32Store, ops.I64Store, ops.F32Store, ops.F64Store, ops.I32Store8, ops.I32Store16, ops.I64Store8, ops.I64Store16, ops.I64Store32:
 			// memory_immediate has two fields, the alignment and the offset.
 			// The former is simply an optimization hint and can be safely
 			// discarded.
 			instr.Immediates = []interface{}{instr.Immediates[1].(uint32)}
+		case ops.Select, ops.SelectTyped, ops.RefNull:
+			// The value types are only needed by the validator.
+			instr.Immediates = nil
+			if instr.Wide {
+				emit(OpSelectWide)
+				continue
+			}
+		case ops.Drop:
+			if instr.Wide {
+				emit(ops.Drop)
+			}
+		case ops.GetLocal, ops.SetLocal, ops.TeeLocal:
+			writeVar(emit, buffer, instr.Op.Code, locals, localSlots, instr.Immediates[0].(uint32))
+			continue
+		case ops.GetGlobal, ops.SetGlobal:
+			writeVar(emit, buffer, instr.Op.Code, globals, globalSlots, instr.Immediates[0].(uint32))
+			continue
+		case ops.SIMDPrefix:
+			// The immediates of the SIMD memory operators start with a
+			// memory_immediate, whose alignment is discarded like for
+			// the other memory operators.
+			if len(instr.Immediates) != 0 {
+				if _, ok := instr.Immediates[0].(uint32); ok {
+					instr.Immediates = instr.Immediates[1:]
+				}
+			}
 		case ops.If:
 			curBlockDepth++
-			buffer.WriteByte(OpJmpZ)
+			emit(OpJmpZ)
 			blocks[curBlockDepth] = &block{
 				ifBlock:        true,
 				elseAddrOffset: int64(buffer.Len()),
@@ -196,16 +270,11 @@ func Compile(disassembly []disasm.Instr) ([]byte, []*BranchTable) {
 			continue
 		case ops.Else:
 			ifInstr := disassembly[instr.Block.ElseIfIndex] // the corresponding `if` instruction for this else
-			if ifInstr.NewStack != nil && ifInstr.NewStack.StackTopDiff != 0 {
+			if ifInstr.NewStack != nil {
 				// add code for jumping out of a taken if branch
-				if ifInstr.NewStack.PreserveTop {
-					buffer.WriteByte(OpDiscardPreserveTop)
-				} else {
-					buffer.WriteByte(OpDiscard)
-				}
-				binary.Write(buffer, binary.LittleEndian, ifInstr.NewStack.StackTopDiff)
+				writeDiscard(emit, buffer, *ifInstr.NewStack)
 			}
-			buffer.WriteByte(OpJmp)
+			emit(OpJmp)
 			ifBlockEndOffset := int64(buffer.Len())
 			binary.Write(buffer, binary.LittleEndian, int64(0))
 
@@ -222,19 +291,10 @@ func Compile(disassembly []disasm.Instr) ([]byte, []*BranchTable) {
 			depth := curBlockDepth
 			block := blocks[depth]
 
-			if instr.NewStack.StackTopDiff != 0 {
-				// when exiting a block, discard elements to
-				// restore stack height.
-				if instr.NewStack.PreserveTop {
-					// this is true when the block has a
-					// signature, and therefore pushes
-					// a value on to the stack
-					buffer.WriteByte(OpDiscardPreserveTop)
-				} else {
-					buffer.WriteByte(OpDiscard)
-				}
-				binary.Write(buffer, binary.LittleEndian, instr.NewStack.StackTopDiff)
-			}
+			// when exiting a block, discard elements to
+			// restore stack height, preserving the values
+			// returned by the block.
+			writeDiscard(emit, buffer, *instr.NewStack)
 
 			if !block.loopBlock { // is a normal block
 				block.offset = int64(buffer.Len())
@@ -257,15 +317,10 @@ func Compile(disassembly []disasm.Instr) ([]byte, []*BranchTable) {
 			curBlockDepth--
 			continue
 		case ops.Br:
-			if instr.NewStack != nil && instr.NewStack.StackTopDiff != 0 {
-				if instr.NewStack.PreserveTop {
-					buffer.WriteByte(OpDiscardPreserveTop)
-				} else {
-					buffer.WriteByte(OpDiscard)
-				}
-				binary.Write(buffer, binary.LittleEndian, instr.NewStack.StackTopDiff)
+			if instr.NewStack != nil {
+				writeDiscard(emit, buffer, *instr.NewStack)
 			}
-			buffer.WriteByte(OpJmp)
+			emit(OpJmp)
 			label := int(instr.Immediates[0].(uint32))
 			block := blocks[curBlockDepth-int(label)]
 			block.patchOffsets = append(block.patchOffsets, int64(buffer.Len()))
@@ -273,21 +328,20 @@ func Compile(disassembly []disasm.Instr) ([]byte, []*BranchTable) {
 			binary.Write(buffer, binary.LittleEndian, int64(0))
 			continue
 		case ops.BrIf:
-			buffer.WriteByte(OpJmpNz)
+			emit(OpJmpNz)
 			label := int(instr.Immediates[0].(uint32))
 			block := blocks[curBlockDepth-int(label)]
 			block.patchOffsets = append(block.patchOffsets, int64(buffer.Len()))
 			// write the jump address
 			binary.Write(buffer, binary.LittleEndian, int64(0))
 
-			var stackTopDiff int64
-			// write whether we need to preserve the top
-			if instr.NewStack == nil || !instr.NewStack.PreserveTop || instr.NewStack.StackTopDiff == 0 {
-				buffer.WriteByte(byte(0))
-			} else {
+			var preserve, stackTopDiff int64
+			if instr.NewStack != nil && instr.NewStack.Preserve != 0 && instr.NewStack.StackTopDiff != 0 {
+				preserve = instr.NewStack.Preserve
 				stackTopDiff = instr.NewStack.StackTopDiff
-				buffer.WriteByte(byte(1))
 			}
+			// write the number of values on the top of the stack we need to preserve
+			binary.Write(buffer, binary.LittleEndian, preserve)
 			// write the number of elements on the stack we need to discard
 			binary.Write(buffer, binary.LittleEndian, stackTopDiff)
 			continue
@@ -307,38 +361,55 @@ func Compile(disassembly []disasm.Instr) ([]byte, []*BranchTable) {
 
 				branchTable.Targets[i].Return = branch.IsReturn
 				branchTable.Targets[i].Discard = branch.StackTopDiff
-				branchTable.Targets[i].PreserveTop = branch.PreserveTop
+				branchTable.Targets[i].Preserve = branch.Preserve
 			}
 			defaultLabel := int64(instr.Immediates[len(instr.Immediates)-1].(uint32))
 			branchTable.DefaultTarget.Addr = defaultLabel
 			defaultBranch := instr.Branches[targetCount]
 			branchTable.DefaultTarget.Return = defaultBranch.IsReturn
 			branchTable.DefaultTarget.Discard = defaultBranch.StackTopDiff
-			branchTable.DefaultTarget.PreserveTop = defaultBranch.PreserveTop
+			branchTable.DefaultTarget.Preserve = defaultBranch.Preserve
 			branchTables = append(branchTables, branchTable)
 			for _, block := range blocks {
 				block.branchTables = append(block.branchTables, branchTable)
 			}
 
-			buffer.WriteByte(ops.BrTable)
+			emit(ops.BrTable)
 			binary.Write(buffer, binary.LittleEndian, int64(len(branchTables)-1))
 		}
 
-		buffer.WriteByte(instr.Op.Code)
+		if instr.Op.Code == ops.BrTable {
+			// The index of the branch table emitted above is the
+			// instruction; the original one is never executed.
+			buffer.WriteByte(instr.Op.Code)
+		} else {
+			emit(instr.Op.Code)
+		}
+		if instr.Op.IsPrefixed() {
+			// The sub-opcode of prefixed operators is written as a
+			// fixed-size uint32, like the immediates.
+			binary.Write(buffer, binary.LittleEndian, instr.Op.Sub)
+		}
 		for _, imm := range instr.Immediates {
 			err := binary.Write(buffer, binary.LittleEndian, imm)
 			if err != nil {
 				panic(err)
 			}
 		}
+		if instr.Op.Code == ops.ReturnCall || instr.Op.Code == ops.ReturnCallIndirect {
+			// A tail call to a compiled function replaces the current
+			// frame, but host functions push their results like a call,
+			// which this return then returns to the caller.
+			emit(ops.Return)
+		}
 	}
 
 	// writing nop as the last instructions allows us to branch out of the
 	// function (ie, return)
 	addr := buffer.Len()
-	buffer.WriteByte(ops.Nop)
-	buffer.WriteByte(OpGasCounter)
-	binary.Write(buffer, binary.LittleEndian, scope_gas_counter+1)
+	emit(ops.Nop)
+	emit(OpGasCounter)
+	binary.Write(buffer, binary.LittleEndian, scope_gas_counter+gasCost(nopInstr))
 
 	// patch all references to the "root" block of the function body
 	for _, offset := range blocks[-1].patchOffsets {
@@ -349,7 +420,78 @@ func Compile(disassembly []disasm.Instr) ([]byte, []*BranchTable) {
 	for _, table := range branchTables {
 		table.patchedAddrs = nil
 	}
-	return buffer.Bytes(), branchTables
+	return buffer.Bytes(), branchTables, instrs, offsets
+}
+
+var nopInstr = func() disasm.Instr {
+	op, err := ops.New(ops.Nop)
+	if err != nil {
+		panic(err)
+	}
+	return disasm.Instr{Op: op}
+}()
+
+// slotIndices returns the index of the first slot of each variable of the
+// given types, or nil if every variable takes a single slot.
+func slotIndices(types []wasm.ValueType) []uint32 {
+	if disasm.Slots(types...) == len(types) {
+		return nil
+	}
+	indices := make([]uint32, len(types))
+	slot := uint32(0)
+	for i, t := range types {
+		indices[i] = slot
+		slot += uint32(disasm.Slots(t))
+	}
+	return indices
+}
+
+// writeVar writes the local or global operator op accessing the variable of
+// the given index, whose slots are given by slotIndices, starting its
+// instructions with emit.
+func writeVar(emit func(op byte), buffer *bytes.Buffer, op byte, types []wasm.ValueType, slots []uint32, index uint32) {
+	write := func(op byte, slot uint32) {
+		emit(op)
+		binary.Write(buffer, binary.LittleEndian, slot)
+	}
+	if int(index) >= len(slots) {
+		write(op, index)
+		return
+	}
+	slot := slots[index]
+	if types[index] != wasm.ValueTypeV128 {
+		write(op, slot)
+		return
+	}
+	switch op {
+	case ops.GetLocal, ops.GetGlobal:
+		write(op, slot)
+		write(op, slot+1)
+	case ops.SetLocal, ops.SetGlobal:
+		write(op, slot+1)
+		write(op, slot)
+	case ops.TeeLocal:
+		write(ops.SetLocal, slot+1)
+		write(ops.TeeLocal, slot)
+		write(ops.GetLocal, slot+1)
+	}
+}
+
+// writeDiscard writes the instruction restoring the stack height described by
+// info, if any, starting it with emit.
+func writeDiscard(emit func(op byte), buffer *bytes.Buffer, info disasm.StackInfo) {
+	switch {
+	case info.StackTopDiff == 0:
+		return
+	case info.Preserve == 0:
+		emit(OpDiscard)
+	case info.Preserve == 1:
+		emit(OpDiscardPreserveTop)
+	default:
+		emit(OpDiscardPreserve)
+		binary.Write(buffer, binary.LittleEndian, info.Preserve)
+	}
+	binary.Write(buffer, binary.LittleEndian, info.StackTopDiff)
 }
 
 // replace the address starting at start with addr
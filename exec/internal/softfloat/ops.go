@@ -0,0 +1,59 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package softfloat
+
+// The functions below implement the WebAssembly operator of the same name on
+// raw bit patterns.
+
+func F32Add(a, b uint32) uint32       { return uint32(f32.add(uint64(a), uint64(b))) }
+func F32Sub(a, b uint32) uint32       { return uint32(f32.sub(uint64(a), uint64(b))) }
+func F32Mul(a, b uint32) uint32       { return uint32(f32.mul(uint64(a), uint64(b))) }
+func F32Div(a, b uint32) uint32       { return uint32(f32.div(uint64(a), uint64(b))) }
+func F32Min(a, b uint32) uint32       { return uint32(f32.min(uint64(a), uint64(b))) }
+func F32Max(a, b uint32) uint32       { return uint32(f32.max(uint64(a), uint64(b))) }
+func F32Sqrt(a uint32) uint32         { return uint32(f32.sqrt(uint64(a))) }
+func F32Ceil(a uint32) uint32         { return uint32(f32.round(uint64(a), roundCeil)) }
+func F32Floor(a uint32) uint32        { return uint32(f32.round(uint64(a), roundFloor)) }
+func F32Trunc(a uint32) uint32        { return uint32(f32.round(uint64(a), roundTrunc)) }
+func F32Nearest(a uint32) uint32      { return uint32(f32.round(uint64(a), roundNearest)) }
+func F32Eq(a, b uint32) bool          { return f32.eq(uint64(a), uint64(b)) }
+func F32Lt(a, b uint32) bool          { return f32.lt(uint64(a), uint64(b)) }
+func F32Le(a, b uint32) bool          { return f32.lt(uint64(a), uint64(b)) || f32.eq(uint64(a), uint64(b)) }
+func F32IsNaN(a uint32) bool          { return f32.isNaN(uint64(a)) }
+func F64Add(a, b uint64) uint64       { return f64.add(a, b) }
+func F64Sub(a, b uint64) uint64       { return f64.sub(a, b) }
+func F64Mul(a, b uint64) uint64       { return f64.mul(a, b) }
+func F64Div(a, b uint64) uint64       { return f64.div(a, b) }
+func F64Min(a, b uint64) uint64       { return f64.min(a, b) }
+func F64Max(a, b uint64) uint64       { return f64.max(a, b) }
+func F64Sqrt(a uint64) uint64         { return f64.sqrt(a) }
+func F64Ceil(a uint64) uint64         { return f64.round(a, roundCeil) }
+func F64Floor(a uint64) uint64        { return f64.round(a, roundFloor) }
+func F64Trunc(a uint64) uint64        { return f64.round(a, roundTrunc) }
+func F64Nearest(a uint64) uint64      { return f64.round(a, roundNearest) }
+func F64Eq(a, b uint64) bool          { return f64.eq(a, b) }
+func F64Lt(a, b uint64) bool          { return f64.lt(a, b) }
+func F64Le(a, b uint64) bool          { return f64.lt(a, b) || f64.eq(a, b) }
+func F64IsNaN(a uint64) bool          { return f64.isNaN(a) }
+func F32PromoteToF64(a uint32) uint64 { return convert(f32, f64, uint64(a)) }
+func F64DemoteToF32(a uint64) uint32  { return uint32(convert(f64, f32, a)) }
+
+// F32FromInt returns the binary32 value nearest to the integer with the given
+// sign and magnitude.
+func F32FromInt(neg bool, mag uint64) uint32 { return uint32(f32.fromInt(neg, mag)) }
+
+// F64FromInt returns the binary64 value nearest to the integer with the given
+// sign and magnitude.
+func F64FromInt(neg bool, mag uint64) uint64 { return f64.fromInt(neg, mag) }
+
+// F32ToInt truncates a towards zero, returning the sign and magnitude of the
+// result. ok is false if a is NaN or infinite, or if the magnitude overflows
+// 64 bits.
+func F32ToInt(a uint32) (neg bool, mag uint64, ok bool) { return f32.toInt(uint64(a)) }
+
+// F64ToInt truncates a towards zero, returning the sign and magnitude of the
+// result. ok is false if a is NaN or infinite, or if the magnitude overflows
+// 64 bits.
+func F64ToInt(a uint64) (neg bool, mag uint64, ok bool) { return f64.toInt(a) }
@@ -0,0 +1,483 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package softfloat implements IEEE 754 binary32 and binary64 arithmetic
+// using integer operations only, so that results are bit-identical on every
+// host regardless of its floating-point unit.
+//
+// Values are passed and returned as raw bit patterns. All operations round
+// to nearest, ties to even, and every NaN produced by an arithmetic operation
+// is the canonical quiet NaN with a positive sign, as permitted by the
+// WebAssembly specification.
+package softfloat
+
+import "math/bits"
+
+const (
+	// NaN32 is the canonical binary32 NaN.
+	NaN32 uint32 = 0x7fc00000
+	// NaN64 is the canonical binary64 NaN.
+	NaN64 uint64 = 0x7ff8000000000000
+)
+
+// format describes the layout of an IEEE 754 binary interchange format.
+type format struct {
+	mantBits uint // number of explicitly stored significand bits
+	expBits  uint
+	bias     int
+}
+
+var (
+	f32 = format{mantBits: 23, expBits: 8, bias: 127}
+	f64 = format{mantBits: 52, expBits: 11, bias: 1023}
+)
+
+func (f format) signBit() uint64  { return 1 << (f.mantBits + f.expBits) }
+func (f format) expMask() uint64  { return 1<<f.expBits - 1 }
+func (f format) mantMask() uint64 { return 1<<f.mantBits - 1 }
+func (f format) nan() uint64      { return f.expMask()<<f.mantBits | 1<<(f.mantBits-1) }
+func (f format) one() uint64      { return uint64(f.bias) << f.mantBits }
+
+func (f format) inf(neg bool) uint64 {
+	return f.sign(neg) | f.expMask()<<f.mantBits
+}
+
+func (f format) sign(neg bool) uint64 {
+	if neg {
+		return f.signBit()
+	}
+	return 0
+}
+
+type class uint8
+
+const (
+	classZero class = iota
+	classFinite
+	classInf
+	classNaN
+)
+
+// unpacked is a decoded floating-point value. Finite non-zero values are
+// normalized so that value = mant * 2^exp and bit mantBits of mant is set.
+type unpacked struct {
+	class class
+	neg   bool
+	exp   int
+	mant  uint64
+}
+
+func (f format) unpack(x uint64) unpacked {
+	u := unpacked{neg: x&f.signBit() != 0}
+	e := x >> f.mantBits & f.expMask()
+	m := x & f.mantMask()
+	switch {
+	case e == f.expMask() && m == 0:
+		u.class = classInf
+	case e == f.expMask():
+		u.class = classNaN
+	case e == 0 && m == 0:
+		u.class = classZero
+	case e == 0:
+		// subnormal: normalize the significand.
+		shift := uint(bits.LeadingZeros64(m)) - (63 - f.mantBits)
+		u.class = classFinite
+		u.mant = m << shift
+		u.exp = 1 - f.bias - int(f.mantBits) - int(shift)
+	default:
+		u.class = classFinite
+		u.mant = m | 1<<f.mantBits
+		u.exp = int(e) - f.bias - int(f.mantBits)
+	}
+	return u
+}
+
+// shiftRightSticky shifts m right by n bits, or-ing any bits shifted out
+// into the least significant bit of the result.
+func shiftRightSticky(m uint64, n uint) uint64 {
+	switch {
+	case n == 0:
+		return m
+	case n >= 64:
+		if m != 0 {
+			return 1
+		}
+		return 0
+	}
+	r := m >> n
+	if m&(1<<n-1) != 0 {
+		r |= 1
+	}
+	return r
+}
+
+// pack rounds the non-zero value mant * 2^exp to the nearest representable
+// value of format f. Any inexactness of mant must be recorded in its least
+// significant bit, which must lie below the rounding position.
+func (f format) pack(neg bool, exp int, mant uint64) uint64 {
+	// normalize so that bit 62 is the leading bit.
+	if lz := bits.LeadingZeros64(mant); lz == 0 {
+		mant = shiftRightSticky(mant, 1)
+		exp++
+	} else {
+		mant <<= uint(lz - 1)
+		exp -= lz - 1
+	}
+
+	e := exp + 62 // unbiased exponent of the value
+	if e > f.bias {
+		return f.inf(neg)
+	}
+
+	var (
+		shift = 62 - f.mantBits
+		base  uint64
+	)
+	if minExp := 1 - f.bias; e >= minExp {
+		base = uint64(e + f.bias - 1)
+	} else {
+		shift += uint(minExp - e)
+	}
+	if shift > 63 {
+		// less than half of the smallest subnormal.
+		return f.sign(neg)
+	}
+
+	q := mant >> shift
+	rem := mant & (1<<shift - 1)
+	half := uint64(1) << (shift - 1)
+	if rem > half || rem == half && q&1 == 1 {
+		q++
+	}
+
+	// Adding q (which includes the implicit bit for normal numbers) to the
+	// shifted exponent propagates any rounding carry into the exponent.
+	r := base<<f.mantBits + q
+	if r >= f.expMask()<<f.mantBits {
+		return f.inf(neg)
+	}
+	return f.sign(neg) | r
+}
+
+func (f format) add(a, b uint64) uint64 {
+	ua, ub := f.unpack(a), f.unpack(b)
+	switch {
+	case ua.class == classNaN || ub.class == classNaN:
+		return f.nan()
+	case ua.class == classInf:
+		if ub.class == classInf && ua.neg != ub.neg {
+			return f.nan()
+		}
+		return f.inf(ua.neg)
+	case ub.class == classInf:
+		return f.inf(ub.neg)
+	case ua.class == classZero && ub.class == classZero:
+		return f.sign(ua.neg && ub.neg)
+	case ua.class == classZero:
+		return b
+	case ub.class == classZero:
+		return a
+	}
+
+	// leave one bit of headroom for the carry of the addition.
+	shift := 61 - f.mantBits
+	ma, ea := ua.mant<<shift, ua.exp-int(shift)
+	mb, eb := ub.mant<<shift, ub.exp-int(shift)
+	if ea < eb || ea == eb && ma < mb {
+		ua, ub = ub, ua
+		ma, ea, mb, eb = mb, eb, ma, ea
+	}
+	mb = shiftRightSticky(mb, uint(ea-eb))
+
+	var m uint64
+	if ua.neg == ub.neg {
+		m = ma + mb
+	} else {
+		m = ma - mb
+	}
+	if m == 0 {
+		return 0
+	}
+	return f.pack(ua.neg, ea, m)
+}
+
+func (f format) sub(a, b uint64) uint64 {
+	ub := f.unpack(b)
+	if ub.class == classNaN {
+		return f.nan()
+	}
+	return f.add(a, b^f.signBit())
+}
+
+func (f format) mul(a, b uint64) uint64 {
+	ua, ub := f.unpack(a), f.unpack(b)
+	neg := ua.neg != ub.neg
+	switch {
+	case ua.class == classNaN || ub.class == classNaN:
+		return f.nan()
+	case ua.class == classInf || ub.class == classInf:
+		if ua.class == classZero || ub.class == classZero {
+			return f.nan()
+		}
+		return f.inf(neg)
+	case ua.class == classZero || ub.class == classZero:
+		return f.sign(neg)
+	}
+
+	hi, lo := bits.Mul64(ua.mant, ub.mant)
+	exp := ua.exp + ub.exp
+	m := lo
+	if hi != 0 {
+		n := uint(64 - bits.LeadingZeros64(hi))
+		m = hi<<(64-n) | lo>>n
+		if lo&(1<<n-1) != 0 {
+			m |= 1
+		}
+		exp += int(n)
+	}
+	return f.pack(neg, exp, m)
+}
+
+func (f format) div(a, b uint64) uint64 {
+	ua, ub := f.unpack(a), f.unpack(b)
+	neg := ua.neg != ub.neg
+	switch {
+	case ua.class == classNaN || ub.class == classNaN:
+		return f.nan()
+	case ua.class == classInf:
+		if ub.class == classInf {
+			return f.nan()
+		}
+		return f.inf(neg)
+	case ub.class == classInf:
+		return f.sign(neg)
+	case ua.class == classZero:
+		if ub.class == classZero {
+			return f.nan()
+		}
+		return f.sign(neg)
+	case ub.class == classZero:
+		return f.inf(neg)
+	}
+
+	// Both significands have their leading bit at the same position, so
+	// ua.mant>>1 < ub.mant and the 64-bit quotient cannot overflow.
+	q, r := bits.Div64(ua.mant>>1, ua.mant<<63, ub.mant)
+	if r != 0 {
+		q |= 1
+	}
+	return f.pack(neg, ua.exp-ub.exp-63, q)
+}
+
+func (f format) sqrt(a uint64) uint64 {
+	u := f.unpack(a)
+	switch {
+	case u.class == classNaN:
+		return f.nan()
+	case u.class == classZero:
+		return a
+	case u.neg:
+		return f.nan()
+	case u.class == classInf:
+		return a
+	}
+
+	m, e := u.mant, u.exp
+	if e&1 != 0 {
+		m <<= 1
+		e--
+	}
+	// scale the significand by an even power of two so that the integer
+	// square root carries more than 61 significant bits.
+	s := (126 - (f.mantBits + 2)) &^ 1
+	root, exact := isqrt128(m<<(s-64), 0)
+	if !exact {
+		root |= 1
+	}
+	return f.pack(false, (e-int(s))/2, root)
+}
+
+// isqrt128 returns the integer square root of hi<<64|lo, which must be less
+// than 2^126, and reports whether the root is exact.
+func isqrt128(hi, lo uint64) (root uint64, exact bool) {
+	for i := 62; i >= 0; i-- {
+		c := root | 1<<uint(i)
+		h, l := bits.Mul64(c, c)
+		if h < hi || h == hi && l <= lo {
+			root = c
+		}
+	}
+	h, l := bits.Mul64(root, root)
+	return root, h == hi && l == lo
+}
+
+func (f format) isNaN(x uint64) bool {
+	return x&^f.signBit() > f.expMask()<<f.mantBits
+}
+
+func (f format) isZero(x uint64) bool {
+	return x&^f.signBit() == 0
+}
+
+func (f format) eq(a, b uint64) bool {
+	if f.isNaN(a) || f.isNaN(b) {
+		return false
+	}
+	return a == b || f.isZero(a) && f.isZero(b)
+}
+
+func (f format) lt(a, b uint64) bool {
+	if f.isNaN(a) || f.isNaN(b) || f.isZero(a) && f.isZero(b) {
+		return false
+	}
+	na, nb := a&f.signBit() != 0, b&f.signBit() != 0
+	switch {
+	case na != nb:
+		return na
+	case na:
+		return a&^f.signBit() > b&^f.signBit()
+	default:
+		return a < b
+	}
+}
+
+func (f format) min(a, b uint64) uint64 {
+	switch {
+	case f.isNaN(a) || f.isNaN(b):
+		return f.nan()
+	case f.isZero(a) && f.isZero(b):
+		return a | b
+	case f.lt(b, a):
+		return b
+	}
+	return a
+}
+
+func (f format) max(a, b uint64) uint64 {
+	switch {
+	case f.isNaN(a) || f.isNaN(b):
+		return f.nan()
+	case f.isZero(a) && f.isZero(b):
+		return a & b
+	case f.lt(a, b):
+		return b
+	}
+	return a
+}
+
+type roundMode uint8
+
+const (
+	roundTrunc roundMode = iota
+	roundCeil
+	roundFloor
+	roundNearest
+)
+
+// round rounds x to an integral value in the given direction.
+func (f format) round(x uint64, mode roundMode) uint64 {
+	biased := x >> f.mantBits & f.expMask()
+	if biased == f.expMask() {
+		if f.isNaN(x) {
+			return f.nan()
+		}
+		return x
+	}
+	e := int(biased) - f.bias
+	if e >= int(f.mantBits) {
+		return x
+	}
+
+	sign := x & f.signBit()
+	neg := sign != 0
+	if e < 0 {
+		// |x| < 1
+		if f.isZero(x) {
+			return x
+		}
+		switch mode {
+		case roundCeil:
+			if !neg {
+				return f.one()
+			}
+		case roundFloor:
+			if neg {
+				return sign | f.one()
+			}
+		case roundNearest:
+			if e == -1 && x&f.mantMask() != 0 {
+				return sign | f.one()
+			}
+		}
+		return sign
+	}
+
+	fracBits := f.mantBits - uint(e)
+	mask := uint64(1)<<fracBits - 1
+	frac := x & mask
+	if frac == 0 {
+		return x
+	}
+	r := x &^ mask
+	switch mode {
+	case roundCeil:
+		if !neg {
+			r += 1 << fracBits
+		}
+	case roundFloor:
+		if neg {
+			r += 1 << fracBits
+		}
+	case roundNearest:
+		half := uint64(1) << (fracBits - 1)
+		if frac > half || frac == half && r>>fracBits&1 == 1 {
+			r += 1 << fracBits
+		}
+	}
+	return r
+}
+
+// fromInt converts the integer whose magnitude is mag and sign neg.
+func (f format) fromInt(neg bool, mag uint64) uint64 {
+	if mag == 0 {
+		return 0
+	}
+	return f.pack(neg, 0, mag)
+}
+
+// toInt truncates x towards zero. ok is false if x is NaN, infinite or its
+// truncated magnitude does not fit in 64 bits.
+func (f format) toInt(x uint64) (neg bool, mag uint64, ok bool) {
+	u := f.unpack(x)
+	switch u.class {
+	case classNaN, classInf:
+		return u.neg, 0, false
+	case classZero:
+		return u.neg, 0, true
+	}
+	if u.exp >= 0 {
+		if bits.Len64(u.mant)+u.exp > 64 {
+			return u.neg, 0, false
+		}
+		return u.neg, u.mant << uint(u.exp), true
+	}
+	if u.exp <= -64 {
+		return u.neg, 0, true
+	}
+	return u.neg, u.mant >> uint(-u.exp), true
+}
+
+// convert converts x from format from to format to.
+func convert(from, to format, x uint64) uint64 {
+	u := from.unpack(x)
+	switch u.class {
+	case classNaN:
+		return to.nan()
+	case classInf:
+		return to.inf(u.neg)
+	case classZero:
+		return to.sign(u.neg)
+	}
+	return to.pack(u.neg, u.exp, u.mant)
+}
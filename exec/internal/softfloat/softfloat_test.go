@@ -0,0 +1,267 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package softfloat
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// The host FPU is used as the reference implementation: Go guarantees IEEE
+// round-to-nearest-even semantics for its floating-point operators.
+
+var special32 = []uint32{
+	0, 0x80000000, 1, 0x80000001, 0x007fffff, 0x00800000, 0x3f800000,
+	0xbf800000, 0x3f000000, 0x3fc00000, 0x40200000, 0x7f7fffff, 0xff7fffff,
+	0x7f800000, 0xff800000, 0x7fc00000, 0xffc00000, 0x7fa00000, 0x4b000000,
+	0x4affffff, 0x4effffff, 0x4f000000, 0x5effffff, 0x5f000000, 0xcf000000,
+	0xdf000000, 0x3effffff,
+}
+
+var special64 = []uint64{
+	0, 1 << 63, 1, 1<<63 | 1, 0x000fffffffffffff, 0x0010000000000000,
+	0x3ff0000000000000, 0xbff0000000000000, 0x3fe0000000000000,
+	0x3ff8000000000000, 0x4004000000000000, 0x7fefffffffffffff,
+	0xffefffffffffffff, 0x7ff0000000000000, 0xfff0000000000000,
+	0x7ff8000000000000, 0xfff8000000000000, 0x7ff4000000000000,
+	0x4330000000000000, 0x432fffffffffffff, 0x41dfffffffc00000,
+	0x41e0000000000000, 0x43dfffffffffffff, 0x43e0000000000000,
+	0xc3e0000000000000, 0x3fdfffffffffffff,
+}
+
+func random32(r *rand.Rand) uint32 {
+	switch r.Intn(4) {
+	case 0:
+		return special32[r.Intn(len(special32))]
+	case 1:
+		// small exponent range, to exercise cancellation and exact results.
+		return uint32(r.Intn(2))<<31 | uint32(120+r.Intn(16))<<23 | uint32(r.Intn(1<<23))
+	case 2:
+		// subnormals
+		return uint32(r.Intn(2))<<31 | uint32(r.Intn(1<<23))
+	}
+	return r.Uint32()
+}
+
+func random64(r *rand.Rand) uint64 {
+	switch r.Intn(4) {
+	case 0:
+		return special64[r.Intn(len(special64))]
+	case 1:
+		return uint64(r.Intn(2))<<63 | uint64(1015+r.Intn(16))<<52 | uint64(r.Int63n(1<<52))
+	case 2:
+		return uint64(r.Intn(2))<<63 | uint64(r.Int63n(1<<52))
+	}
+	return r.Uint64()
+}
+
+func canon32(f float32) uint32 {
+	if f != f {
+		return NaN32
+	}
+	return math.Float32bits(f)
+}
+
+func canon64(f float64) uint64 {
+	if f != f {
+		return NaN64
+	}
+	return math.Float64bits(f)
+}
+
+// wasmMin and wasmMax differ from math.Min and math.Max in that a NaN operand
+// always produces a NaN, even when the other operand is infinite.
+func wasmMin(a, b float64) float64 {
+	if a != a || b != b {
+		return math.NaN()
+	}
+	return math.Min(a, b)
+}
+
+func wasmMax(a, b float64) float64 {
+	if a != a || b != b {
+		return math.NaN()
+	}
+	return math.Max(a, b)
+}
+
+const iterations = 200000
+
+func TestF32Binary(t *testing.T) {
+	tests := []struct {
+		name string
+		soft func(a, b uint32) uint32
+		hard func(a, b float32) float32
+	}{
+		{"add", F32Add, func(a, b float32) float32 { return a + b }},
+		{"sub", F32Sub, func(a, b float32) float32 { return a - b }},
+		{"mul", F32Mul, func(a, b float32) float32 { return a * b }},
+		{"div", F32Div, func(a, b float32) float32 { return a / b }},
+		{"min", F32Min, func(a, b float32) float32 { return float32(wasmMin(float64(a), float64(b))) }},
+		{"max", F32Max, func(a, b float32) float32 { return float32(wasmMax(float64(a), float64(b))) }},
+	}
+	r := rand.New(rand.NewSource(1))
+	for _, test := range tests {
+		for i := 0; i < iterations; i++ {
+			a, b := random32(r), random32(r)
+			got := test.soft(a, b)
+			want := canon32(test.hard(math.Float32frombits(a), math.Float32frombits(b)))
+			if got != want {
+				t.Fatalf("f32.%s(%#08x, %#08x) = %#08x, want %#08x", test.name, a, b, got, want)
+			}
+		}
+	}
+}
+
+func TestF64Binary(t *testing.T) {
+	tests := []struct {
+		name string
+		soft func(a, b uint64) uint64
+		hard func(a, b float64) float64
+	}{
+		{"add", F64Add, func(a, b float64) float64 { return a + b }},
+		{"sub", F64Sub, func(a, b float64) float64 { return a - b }},
+		{"mul", F64Mul, func(a, b float64) float64 { return a * b }},
+		{"div", F64Div, func(a, b float64) float64 { return a / b }},
+		{"min", F64Min, wasmMin},
+		{"max", F64Max, wasmMax},
+	}
+	r := rand.New(rand.NewSource(2))
+	for _, test := range tests {
+		for i := 0; i < iterations; i++ {
+			a, b := random64(r), random64(r)
+			got := test.soft(a, b)
+			want := canon64(test.hard(math.Float64frombits(a), math.Float64frombits(b)))
+			if got != want {
+				t.Fatalf("f64.%s(%#016x, %#016x) = %#016x, want %#016x", test.name, a, b, got, want)
+			}
+		}
+	}
+}
+
+func TestUnary(t *testing.T) {
+	tests32 := []struct {
+		name string
+		soft func(a uint32) uint32
+		hard func(a float64) float64
+	}{
+		{"sqrt", F32Sqrt, math.Sqrt},
+		{"ceil", F32Ceil, math.Ceil},
+		{"floor", F32Floor, math.Floor},
+		{"trunc", F32Trunc, math.Trunc},
+		{"nearest", F32Nearest, math.RoundToEven},
+	}
+	tests64 := []struct {
+		name string
+		soft func(a uint64) uint64
+		hard func(a float64) float64
+	}{
+		{"sqrt", F64Sqrt, math.Sqrt},
+		{"ceil", F64Ceil, math.Ceil},
+		{"floor", F64Floor, math.Floor},
+		{"trunc", F64Trunc, math.Trunc},
+		{"nearest", F64Nearest, math.RoundToEven},
+	}
+	r := rand.New(rand.NewSource(3))
+	for _, test := range tests32 {
+		for i := 0; i < iterations; i++ {
+			a := random32(r)
+			got := test.soft(a)
+			want := canon32(float32(test.hard(float64(math.Float32frombits(a)))))
+			if got != want {
+				t.Fatalf("f32.%s(%#08x) = %#08x, want %#08x", test.name, a, got, want)
+			}
+		}
+	}
+	for _, test := range tests64 {
+		for i := 0; i < iterations; i++ {
+			a := random64(r)
+			got := test.soft(a)
+			want := canon64(test.hard(math.Float64frombits(a)))
+			if got != want {
+				t.Fatalf("f64.%s(%#016x) = %#016x, want %#016x", test.name, a, got, want)
+			}
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	for i := 0; i < iterations; i++ {
+		a, b := random32(r), random32(r)
+		fa, fb := math.Float32frombits(a), math.Float32frombits(b)
+		if F32Eq(a, b) != (fa == fb) || F32Lt(a, b) != (fa < fb) || F32Le(a, b) != (fa <= fb) {
+			t.Fatalf("f32 comparison of %#08x and %#08x disagrees with hardware", a, b)
+		}
+		c, d := random64(r), random64(r)
+		fc, fd := math.Float64frombits(c), math.Float64frombits(d)
+		if F64Eq(c, d) != (fc == fd) || F64Lt(c, d) != (fc < fd) || F64Le(c, d) != (fc <= fd) {
+			t.Fatalf("f64 comparison of %#016x and %#016x disagrees with hardware", c, d)
+		}
+	}
+}
+
+func TestConversions(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	for i := 0; i < iterations; i++ {
+		a := random32(r)
+		if got, want := F32PromoteToF64(a), canon64(float64(math.Float32frombits(a))); got != want {
+			t.Fatalf("f64.promote_f32(%#08x) = %#016x, want %#016x", a, got, want)
+		}
+		b := random64(r)
+		if got, want := F64DemoteToF32(b), canon32(float32(math.Float64frombits(b))); got != want {
+			t.Fatalf("f32.demote_f64(%#016x) = %#08x, want %#08x", b, got, want)
+		}
+
+		n := r.Uint64() >> uint(r.Intn(64))
+		neg := r.Intn(2) == 0 && n <= 1<<63
+		s := int64(n)
+		if neg {
+			s = -s
+		}
+		var want32 float32
+		var want64 float64
+		if neg || n < 1<<63 {
+			want32, want64 = float32(s), float64(s)
+		} else {
+			want32, want64 = float32(n), float64(n)
+		}
+		if got := F32FromInt(neg, n); got != math.Float32bits(want32) {
+			t.Fatalf("f32 from int (%v, %d) = %#08x, want %#08x", neg, n, got, math.Float32bits(want32))
+		}
+		if got := F64FromInt(neg, n); got != math.Float64bits(want64) {
+			t.Fatalf("f64 from int (%v, %d) = %#016x, want %#016x", neg, n, got, math.Float64bits(want64))
+		}
+	}
+}
+
+func TestToInt(t *testing.T) {
+	tests := []struct {
+		in  uint64
+		neg bool
+		mag uint64
+		ok  bool
+	}{
+		{0x0000000000000000, false, 0, true},
+		{0x8000000000000000, true, 0, true},
+		{0x3fe0000000000000, false, 0, true},            // 0.5
+		{0xbff8000000000000, true, 1, true},             // -1.5
+		{0x43dfffffffffffff, false, 1<<63 - 1024, true}, // largest below 2^63
+		{0x43f0000000000000, false, 0, false},           // 2^64
+		{0x43efffffffffffff, false, 1<<64 - 2048, true},
+		{0x7ff0000000000000, false, 0, false},
+		{0x7ff8000000000000, false, 0, false},
+	}
+	for _, test := range tests {
+		neg, mag, ok := F64ToInt(test.in)
+		if neg != test.neg || mag != test.mag || ok != test.ok {
+			t.Errorf("F64ToInt(%#016x) = (%v, %d, %v), want (%v, %d, %v)", test.in, neg, mag, ok, test.neg, test.mag, test.ok)
+		}
+	}
+	if neg, mag, ok := F32ToInt(0xcf000000); !neg || mag != 1<<31 || !ok {
+		t.Errorf("F32ToInt(-2^31) = (%v, %d, %v)", neg, mag, ok)
+	}
+}
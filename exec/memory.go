@@ -115,7 +115,7 @@ func (vm *VM) i64Load32u() {
 }
 
 func (vm *VM) f32Store() {
-	v := math.Float32bits(vm.popFloat32())
+	v := vm.popUint32()
 	if !vm.inBounds(3) {
 		panic(ErrOutOfBoundsMemoryAccess)
 	}
@@ -126,11 +126,11 @@ func (vm *VM) f32Load() {
 	if !vm.inBounds(3) {
 		panic(ErrOutOfBoundsMemoryAccess)
 	}
-	vm.pushFloat32(math.Float32frombits(endianess.Uint32(vm.curMem())))
+	vm.pushUint32(endianess.Uint32(vm.curMem()))
 }
 
 func (vm *VM) f64Store() {
-	v := math.Float64bits(vm.popFloat64())
+	v := vm.popUint64()
 	if !vm.inBounds(7) {
 		panic(ErrOutOfBoundsMemoryAccess)
 	}
@@ -141,7 +141,7 @@ func (vm *VM) f64Load() {
 	if !vm.inBounds(7) {
 		panic(ErrOutOfBoundsMemoryAccess)
 	}
-	vm.pushFloat64(math.Float64frombits(endianess.Uint64(vm.curMem())))
+	vm.pushUint64(endianess.Uint64(vm.curMem()))
 }
 
 func (vm *VM) i32Store() {
@@ -208,13 +208,43 @@ func (vm *VM) currentMemory() {
 func (vm *VM) growMemory() {
 	_ = vm.fetchInt8() // reserved (https://github.com/WebAssembly/design/blob/27ac254c854994103c24834a994be16f74f54186/BinaryEncoding.md#memory-related-operators-described-here)
 	curLen := len(vm.memory) / wasmPageSize
-	n := vm.popUint32()
+	n := uint32(vm.ctx.stack[len(vm.ctx.stack)-1])
 
-	if uint64(n+uint32(len(vm.memory)/wasmPageSize)) > 1<<16 || uint64(len(vm.memory))+uint64(n*wasmPageSize) > vm.MemoryLimitation {
+	if uint64(n)+uint64(curLen) > 1<<16 || uint64(len(vm.memory))+uint64(n)*wasmPageSize > vm.MemoryLimitation {
+		vm.popUint32()
 		vm.pushInt32(-1)
 		return
 	}
+	// Pages are paid for before they are allocated. The operand is only
+	// popped once they are, so that the instruction can be executed again
+	// when the execution is resumed.
+	if cost := vm.memoryPagesCost(uint64(n)); cost != 0 && !vm.charge(cost) {
+		return
+	}
+	vm.popUint32()
 
-	vm.memory = append(vm.memory, make([]byte, n*wasmPageSize)...)
+	vm.memory = append(vm.memory, make([]byte, uint64(n)*wasmPageSize)...)
 	vm.pushInt32(int32(curLen))
 }
+
+// memoryPagesCost returns the gas charged for allocating n pages of linear
+// memory.
+func (vm *VM) memoryPagesCost(n uint64) uint64 {
+	if vm.gas == nil {
+		return 0
+	}
+	return unitsCost(n, vm.gas.MemoryPageCost)
+}
+
+// unitsCost returns n times the cost of a unit, or math.MaxUint64 if it
+// overflows.
+func unitsCost(n, unit uint64) uint64 {
+	if unit == 0 || n == 0 {
+		return 0
+	}
+	cost := n * unit
+	if cost/n != unit {
+		cost = math.MaxUint64
+	}
+	return cost
+}
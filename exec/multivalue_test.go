@@ -0,0 +1,117 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/ontio/wagon/wasm"
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// moduleMultiValue imports env.two() (i64, i32) and defines:
+//
+//	swap(a i32, b i64) (i64, i32), returning b and a
+//	callswap() (i64, i32), returning swap(1, 2)
+//	sumloop(n i32) i32, adding n down to 1 in a loop taking the counter and
+//	the sum as parameters
+//	pair() (i32, i32), branching out of a block with the values 2 and 3
+//	callhost() (i64, i32), returning two()
+var moduleMultiValue = moduleBytes(
+	section(0x01, 0x05,
+		0x60, 0x02, 0x7f, 0x7e, 0x02, 0x7e, 0x7f,
+		0x60, 0x00, 0x02, 0x7e, 0x7f,
+		0x60, 0x02, 0x7f, 0x7f, 0x02, 0x7f, 0x7f,
+		0x60, 0x01, 0x7f, 0x01, 0x7f,
+		0x60, 0x00, 0x02, 0x7f, 0x7f),
+	section(0x02, 0x01, 0x03, 'e', 'n', 'v', 0x03, 't', 'w', 'o', 0x00, 0x01),
+	section(0x03, 0x05, 0x00, 0x01, 0x03, 0x04, 0x01),
+	section(0x0a, 0x05,
+		0x06, 0x00, 0x20, 0x01, 0x20, 0x00, 0x0b,
+		0x08, 0x00, 0x41, 0x01, 0x42, 0x02, 0x10, 0x01, 0x0b,
+		0x21, 0x01, 0x02, 0x7f,
+		0x20, 0x00, 0x41, 0x00,
+		0x03, 0x02,
+		0x21, 0x02, 0x21, 0x01,
+		0x20, 0x01, 0x41, 0x01, 0x6b,
+		0x20, 0x02, 0x20, 0x01, 0x6a,
+		0x20, 0x01, 0x41, 0x01, 0x4b, 0x0d, 0x00,
+		0x0b,
+		0x6a, 0x0b,
+		0x0d, 0x00, 0x02, 0x04, 0x41, 0x01, 0x41, 0x02, 0x41, 0x03, 0x0c, 0x00, 0x0b, 0x0b,
+		0x04, 0x00, 0x10, 0x00, 0x0b),
+)
+
+func TestMultiValue(t *testing.T) {
+	env := NewHostModule("env").Func("two", NewHostFunction(wasm.FunctionSig{
+		ReturnTypes: []wasm.ValueType{wasm.ValueTypeI64, wasm.ValueTypeI32},
+	}, func(proc *Process, args []uint64) ([]uint64, error) {
+		return []uint64{math.MaxUint64, 7}, nil
+	}))
+	r, err := NewResolver(nil, env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	compiled, err := CompileModuleWithFeatures(readTestModule(t, moduleMultiValue, r.Resolve), nil, ops.FeatureMultiValue)
+	if err != nil {
+		t.Fatalf("could not compile module: %v", err)
+	}
+	vm := newTestVM(t, compiled)
+
+	for _, tc := range []struct {
+		name string
+		fn   int64
+		args []uint64
+		want interface{}
+	}{
+		{"swap", 1, []uint64{7, 9}, []interface{}{uint64(9), uint32(7)}},
+		{"callswap", 2, nil, []interface{}{uint64(2), uint32(1)}},
+		{"sumloop", 3, []uint64{100}, uint32(5050)},
+		{"pair", 4, nil, []interface{}{uint32(2), uint32(3)}},
+		{"callhost", 5, nil, []interface{}{uint64(math.MaxUint64), uint32(7)}},
+	} {
+		res, err := vm.ExecCode(tc.fn, tc.args...)
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(res, tc.want) {
+			t.Errorf("%s returned %#v, want %#v", tc.name, res, tc.want)
+		}
+	}
+}
+
+// moduleBlockTypeIndex defines f() i32, whose body is a block returning 1
+// with the signature given by the type index 0.
+var moduleBlockTypeIndex = moduleBytes(
+	section(0x01, 0x01, 0x60, 0x00, 0x01, 0x7f),
+	section(0x03, 0x01, 0x00),
+	section(0x0a, 0x01, 0x07, 0x00, 0x02, 0x00, 0x41, 0x01, 0x0b, 0x0b),
+)
+
+func TestMultiValueDisabled(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		module   []byte
+		importer wasm.ResolveFunc
+	}{
+		{"results", moduleMultiValue, func(name string) (*wasm.Module, error) {
+			return NewHostModule("env").Func("two", NewHostFunction(wasm.FunctionSig{
+				ReturnTypes: []wasm.ValueType{wasm.ValueTypeI64, wasm.ValueTypeI32},
+			}, nil)).Module()
+		}},
+		{"block type index", moduleBlockTypeIndex, nil},
+	} {
+		m := readTestModule(t, tc.module, tc.importer)
+		if _, err := CompileModule(m, nil); err != ErrMultiValueDisabled {
+			t.Errorf("%s: got error %v, want %v", tc.name, err, ErrMultiValueDisabled)
+		}
+		if _, err := CompileModuleWithFeatures(m, nil, ops.FeatureMultiValue); err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+		}
+	}
+}
@@ -10,6 +10,10 @@ import (
 	"math/bits"
 )
 
+// ErrIntegerDivideByZero is the error value used while trapping the VM when
+// an integer division or remainder operator has a zero divisor.
+var ErrIntegerDivideByZero = errors.New("exec: integer divide by zero")
+
 // int32 operators
 
 func (vm *VM) i32Clz() {
@@ -35,8 +39,11 @@ func (vm *VM) i32Mul() {
 func (vm *VM) i32DivS() {
 	v2 := vm.popInt32()
 	v1 := vm.popInt32()
+	if v2 == 0 {
+		panic(ErrIntegerDivideByZero)
+	}
 	if v1 == math.MinInt32 && v2 == -1 {
-		panic(errors.New("integer overflow"))
+		panic(ErrIntegerOverflow)
 	}
 	vm.pushInt32(v1 / v2)
 }
@@ -44,18 +51,27 @@ func (vm *VM) i32DivS() {
 func (vm *VM) i32DivU() {
 	v2 := vm.popUint32()
 	v1 := vm.popUint32()
+	if v2 == 0 {
+		panic(ErrIntegerDivideByZero)
+	}
 	vm.pushUint32(v1 / v2)
 }
 
 func (vm *VM) i32RemS() {
 	v2 := vm.popInt32()
 	v1 := vm.popInt32()
+	if v2 == 0 {
+		panic(ErrIntegerDivideByZero)
+	}
 	vm.pushInt32(v1 % v2)
 }
 
 func (vm *VM) i32RemU() {
 	v2 := vm.popUint32()
 	v1 := vm.popUint32()
+	if v2 == 0 {
+		panic(ErrIntegerDivideByZero)
+	}
 	vm.pushUint32(v1 % v2)
 }
 
@@ -198,8 +214,11 @@ func (vm *VM) i64Mul() {
 func (vm *VM) i64DivS() {
 	v2 := vm.popInt64()
 	v1 := vm.popInt64()
+	if v2 == 0 {
+		panic(ErrIntegerDivideByZero)
+	}
 	if v1 == math.MinInt64 && v2 == -1 {
-		panic(errors.New("integer overflow"))
+		panic(ErrIntegerOverflow)
 	}
 	vm.pushInt64(v1 / v2)
 }
@@ -207,18 +226,27 @@ func (vm *VM) i64DivS() {
 func (vm *VM) i64DivU() {
 	v2 := vm.popUint64()
 	v1 := vm.popUint64()
+	if v2 == 0 {
+		panic(ErrIntegerDivideByZero)
+	}
 	vm.pushUint64(v1 / v2)
 }
 
 func (vm *VM) i64RemS() {
 	v2 := vm.popInt64()
 	v1 := vm.popInt64()
+	if v2 == 0 {
+		panic(ErrIntegerDivideByZero)
+	}
 	vm.pushInt64(v1 % v2)
 }
 
 func (vm *VM) i64RemU() {
 	v2 := vm.popUint64()
 	v1 := vm.popUint64()
+	if v2 == 0 {
+		panic(ErrIntegerDivideByZero)
+	}
 	vm.pushUint64(v1 % v2)
 }
 
@@ -325,191 +353,226 @@ func (vm *VM) i64GeU() {
 }
 
 // float32 operators
+//
+// Floating-point values are kept on the stack as raw IEEE 754 bit patterns.
+// abs, neg and copysign only manipulate the sign bit and never canonicalize
+// NaNs; every other operator is delegated to the VM's fpu.
+
+const (
+	f32SignBit = 1 << 31
+	f64SignBit = 1 << 63
+)
 
 func (vm *VM) f32Abs() {
-	vm.pushFloat32(float32(math.Abs(float64(vm.popFloat32()))))
+	vm.pushUint32(vm.popUint32() &^ f32SignBit)
 }
 
 func (vm *VM) f32Neg() {
-	vm.pushFloat32(-vm.popFloat32())
+	vm.pushUint32(vm.popUint32() ^ f32SignBit)
 }
 
 func (vm *VM) f32Ceil() {
-	vm.pushFloat32(float32(math.Ceil(float64(vm.popFloat32()))))
+	vm.pushUint32(vm.fpu().f32Ceil(vm.popUint32()))
 }
 
 func (vm *VM) f32Floor() {
-	vm.pushFloat32(float32(math.Floor(float64(vm.popFloat32()))))
+	vm.pushUint32(vm.fpu().f32Floor(vm.popUint32()))
 }
 
 func (vm *VM) f32Trunc() {
-	vm.pushFloat32(float32(math.Trunc(float64(vm.popFloat32()))))
+	vm.pushUint32(vm.fpu().f32Trunc(vm.popUint32()))
 }
 
 func (vm *VM) f32Nearest() {
-	f := vm.popFloat32()
-	vm.pushFloat32(float32(int32(f + float32(math.Copysign(0.5, float64(f))))))
+	vm.pushUint32(vm.fpu().f32Nearest(vm.popUint32()))
 }
 
 func (vm *VM) f32Sqrt() {
-	vm.pushFloat32(float32(math.Sqrt(float64(vm.popFloat32()))))
+	vm.pushUint32(vm.fpu().f32Sqrt(vm.popUint32()))
 }
 
 func (vm *VM) f32Add() {
-	vm.pushFloat32(vm.popFloat32() + vm.popFloat32())
+	v2 := vm.popUint32()
+	v1 := vm.popUint32()
+	vm.pushUint32(vm.fpu().f32Add(v1, v2))
 }
 
 func (vm *VM) f32Sub() {
-	v2 := vm.popFloat32()
-	v1 := vm.popFloat32()
-	vm.pushFloat32(v1 - v2)
+	v2 := vm.popUint32()
+	v1 := vm.popUint32()
+	vm.pushUint32(vm.fpu().f32Sub(v1, v2))
 }
 
 func (vm *VM) f32Mul() {
-	vm.pushFloat32(vm.popFloat32() * vm.popFloat32())
+	v2 := vm.popUint32()
+	v1 := vm.popUint32()
+	vm.pushUint32(vm.fpu().f32Mul(v1, v2))
 }
 
 func (vm *VM) f32Div() {
-	v2 := vm.popFloat32()
-	v1 := vm.popFloat32()
-	vm.pushFloat32(v1 / v2)
+	v2 := vm.popUint32()
+	v1 := vm.popUint32()
+	vm.pushUint32(vm.fpu().f32Div(v1, v2))
 }
 
 func (vm *VM) f32Min() {
-	vm.pushFloat32(float32(math.Min(float64(vm.popFloat32()), float64(vm.popFloat32()))))
+	v2 := vm.popUint32()
+	v1 := vm.popUint32()
+	vm.pushUint32(vm.fpu().f32Min(v1, v2))
 }
 
 func (vm *VM) f32Max() {
-	vm.pushFloat32(float32(math.Max(float64(vm.popFloat32()), float64(vm.popFloat32()))))
+	v2 := vm.popUint32()
+	v1 := vm.popUint32()
+	vm.pushUint32(vm.fpu().f32Max(v1, v2))
 }
 
 func (vm *VM) f32Copysign() {
-	vm.pushFloat32(float32(math.Copysign(float64(vm.popFloat32()), float64(vm.popFloat32()))))
+	v2 := vm.popUint32()
+	v1 := vm.popUint32()
+	vm.pushUint32(v1&^f32SignBit | v2&f32SignBit)
 }
 
 func (vm *VM) f32Eq() {
-	vm.pushBool(vm.popFloat32() == vm.popFloat32())
+	v2 := vm.popUint32()
+	v1 := vm.popUint32()
+	vm.pushBool(vm.fpu().f32Eq(v1, v2))
 }
 
 func (vm *VM) f32Ne() {
-	vm.pushBool(vm.popFloat32() != vm.popFloat32())
+	v2 := vm.popUint32()
+	v1 := vm.popUint32()
+	vm.pushBool(!vm.fpu().f32Eq(v1, v2))
 }
 
 func (vm *VM) f32Lt() {
-	v2 := vm.popFloat32()
-	v1 := vm.popFloat32()
-	vm.pushBool(v1 < v2)
+	v2 := vm.popUint32()
+	v1 := vm.popUint32()
+	vm.pushBool(vm.fpu().f32Lt(v1, v2))
 }
 
 func (vm *VM) f32Gt() {
-	v2 := vm.popFloat32()
-	v1 := vm.popFloat32()
-	vm.pushBool(v1 > v2)
+	v2 := vm.popUint32()
+	v1 := vm.popUint32()
+	vm.pushBool(vm.fpu().f32Lt(v2, v1))
 }
 
 func (vm *VM) f32Le() {
-	v2 := vm.popFloat32()
-	v1 := vm.popFloat32()
-	vm.pushBool(v1 <= v2)
+	v2 := vm.popUint32()
+	v1 := vm.popUint32()
+	vm.pushBool(vm.fpu().f32Le(v1, v2))
 }
 
 func (vm *VM) f32Ge() {
-	v2 := vm.popFloat32()
-	v1 := vm.popFloat32()
-	vm.pushBool(v1 >= v2)
+	v2 := vm.popUint32()
+	v1 := vm.popUint32()
+	vm.pushBool(vm.fpu().f32Le(v2, v1))
 }
 
 // float64 operators
 
 func (vm *VM) f64Abs() {
-	vm.pushFloat64(math.Abs(vm.popFloat64()))
+	vm.pushUint64(vm.popUint64() &^ f64SignBit)
 }
 
 func (vm *VM) f64Neg() {
-	vm.pushFloat64(-vm.popFloat64())
+	vm.pushUint64(vm.popUint64() ^ f64SignBit)
 }
 
 func (vm *VM) f64Ceil() {
-	vm.pushFloat64(math.Ceil(vm.popFloat64()))
+	vm.pushUint64(vm.fpu().f64Ceil(vm.popUint64()))
 }
 
 func (vm *VM) f64Floor() {
-	vm.pushFloat64(math.Floor(vm.popFloat64()))
+	vm.pushUint64(vm.fpu().f64Floor(vm.popUint64()))
 }
 
 func (vm *VM) f64Trunc() {
-	vm.pushFloat64(math.Trunc(vm.popFloat64()))
+	vm.pushUint64(vm.fpu().f64Trunc(vm.popUint64()))
 }
 
 func (vm *VM) f64Nearest() {
-	f := vm.popFloat64()
-	vm.pushFloat64(float64(int64(f + math.Copysign(0.5, f))))
+	vm.pushUint64(vm.fpu().f64Nearest(vm.popUint64()))
 }
 
 func (vm *VM) f64Sqrt() {
-	vm.pushFloat64(math.Sqrt(vm.popFloat64()))
+	vm.pushUint64(vm.fpu().f64Sqrt(vm.popUint64()))
 }
 
 func (vm *VM) f64Add() {
-	vm.pushFloat64(vm.popFloat64() + vm.popFloat64())
+	v2 := vm.popUint64()
+	v1 := vm.popUint64()
+	vm.pushUint64(vm.fpu().f64Add(v1, v2))
 }
 
 func (vm *VM) f64Sub() {
-	v2 := vm.popFloat64()
-	v1 := vm.popFloat64()
-	vm.pushFloat64(v1 - v2)
+	v2 := vm.popUint64()
+	v1 := vm.popUint64()
+	vm.pushUint64(vm.fpu().f64Sub(v1, v2))
 }
 
 func (vm *VM) f64Mul() {
-	vm.pushFloat64(vm.popFloat64() * vm.popFloat64())
+	v2 := vm.popUint64()
+	v1 := vm.popUint64()
+	vm.pushUint64(vm.fpu().f64Mul(v1, v2))
 }
 
 func (vm *VM) f64Div() {
-	v2 := vm.popFloat64()
-	v1 := vm.popFloat64()
-	vm.pushFloat64(v1 / v2)
+	v2 := vm.popUint64()
+	v1 := vm.popUint64()
+	vm.pushUint64(vm.fpu().f64Div(v1, v2))
 }
 
 func (vm *VM) f64Min() {
-	vm.pushFloat64(math.Min(vm.popFloat64(), vm.popFloat64()))
+	v2 := vm.popUint64()
+	v1 := vm.popUint64()
+	vm.pushUint64(vm.fpu().f64Min(v1, v2))
 }
 
 func (vm *VM) f64Max() {
-	vm.pushFloat64(math.Max(vm.popFloat64(), vm.popFloat64()))
+	v2 := vm.popUint64()
+	v1 := vm.popUint64()
+	vm.pushUint64(vm.fpu().f64Max(v1, v2))
 }
 
 func (vm *VM) f64Copysign() {
-	vm.pushFloat64(math.Copysign(vm.popFloat64(), vm.popFloat64()))
+	v2 := vm.popUint64()
+	v1 := vm.popUint64()
+	vm.pushUint64(v1&^f64SignBit | v2&f64SignBit)
 }
 
 func (vm *VM) f64Eq() {
-	vm.pushBool(vm.popFloat64() == vm.popFloat64())
+	v2 := vm.popUint64()
+	v1 := vm.popUint64()
+	vm.pushBool(vm.fpu().f64Eq(v1, v2))
 }
 
 func (vm *VM) f64Ne() {
-	vm.pushBool(vm.popFloat64() != vm.popFloat64())
+	v2 := vm.popUint64()
+	v1 := vm.popUint64()
+	vm.pushBool(!vm.fpu().f64Eq(v1, v2))
 }
 
 func (vm *VM) f64Lt() {
-	v2 := vm.popFloat64()
-	v1 := vm.popFloat64()
-	vm.pushBool(v1 < v2)
+	v2 := vm.popUint64()
+	v1 := vm.popUint64()
+	vm.pushBool(vm.fpu().f64Lt(v1, v2))
 }
 
 func (vm *VM) f64Gt() {
-	v2 := vm.popFloat64()
-	v1 := vm.popFloat64()
-	vm.pushBool(v1 > v2)
+	v2 := vm.popUint64()
+	v1 := vm.popUint64()
+	vm.pushBool(vm.fpu().f64Lt(v2, v1))
 }
 
 func (vm *VM) f64Le() {
-	v2 := vm.popFloat64()
-	v1 := vm.popFloat64()
-	vm.pushBool(v1 <= v2)
+	v2 := vm.popUint64()
+	v1 := vm.popUint64()
+	vm.pushBool(vm.fpu().f64Le(v1, v2))
 }
 
 func (vm *VM) f64Ge() {
-	v2 := vm.popFloat64()
-	v1 := vm.popFloat64()
-	vm.pushBool(v1 >= v2)
+	v2 := vm.popUint64()
+	v1 := vm.popUint64()
+	vm.pushBool(vm.fpu().f64Le(v2, v1))
 }
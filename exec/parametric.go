@@ -19,3 +19,18 @@ func (vm *VM) selectOp() {
 		vm.pushUint64(val2)
 	}
 }
+
+// selectWide is select on operands taking two slots.
+func (vm *VM) selectWide() {
+	c := vm.popUint32()
+	hi2, lo2 := vm.popUint64(), vm.popUint64()
+	hi1, lo1 := vm.popUint64(), vm.popUint64()
+
+	if c != 0 {
+		vm.pushUint64(lo1)
+		vm.pushUint64(hi1)
+	} else {
+		vm.pushUint64(lo2)
+		vm.pushUint64(hi2)
+	}
+}
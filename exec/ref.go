@@ -0,0 +1,135 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"github.com/ontio/wagon/wasm"
+)
+
+// References are stored on the stack, in locals and in globals as the index
+// of the function or the extern value they refer to plus one, so that the
+// null reference is 0.
+
+// refValue returns the reference stored in a table entry.
+func refValue(e wasm.TableEntry) uint64 {
+	if !e.Initialized {
+		return 0
+	}
+	return uint64(e.Index) + 1
+}
+
+// refEntry returns the table entry storing the reference ref.
+func refEntry(ref uint64) wasm.TableEntry {
+	if ref == 0 {
+		return wasm.TableEntry{}
+	}
+	return wasm.TableEntry{Index: uint32(ref - 1), Initialized: true}
+}
+
+// NewExternRef returns a new externref value referring to v, which host
+// functions can return to the module or store in its tables. The values of
+// extern references are recorded in snapshots.
+func (proc *Process) NewExternRef(v interface{}) uint64 {
+	proc.vm.externs = append(proc.vm.externs, v)
+	return uint64(len(proc.vm.externs))
+}
+
+// ExternRef returns the value referred to by the externref value ref, which
+// was returned by NewExternRef. It returns false if ref is null or invalid.
+func (proc *Process) ExternRef(ref uint64) (interface{}, bool) {
+	if ref == 0 || ref > uint64(len(proc.vm.externs)) {
+		return nil, false
+	}
+	return proc.vm.externs[ref-1], true
+}
+
+func (vm *VM) refNull() {
+	vm.pushUint64(0)
+}
+
+func (vm *VM) refIsNull() {
+	if vm.popUint64() == 0 {
+		vm.pushUint32(1)
+	} else {
+		vm.pushUint32(0)
+	}
+}
+
+func (vm *VM) refFunc() {
+	vm.pushUint64(uint64(vm.fetchUint32()) + 1)
+}
+
+func (vm *VM) tableGet() {
+	table := vm.tables[vm.fetchUint32()]
+	i := vm.popUint32()
+	if int64(i) >= int64(len(table)) {
+		panic(ErrOutOfBoundsTableAccess)
+	}
+	vm.pushUint64(refValue(table[i]))
+}
+
+func (vm *VM) tableSet() {
+	table := vm.fetchUint32()
+	val := refEntry(vm.popUint64())
+	i := vm.popUint32()
+	if int64(i) >= int64(len(vm.tables[table])) {
+		panic(ErrOutOfBoundsTableAccess)
+	}
+	vm.writableTables()[table][i] = val
+}
+
+func (vm *VM) tableSize() {
+	vm.pushUint32(uint32(len(vm.tables[vm.fetchUint32()])))
+}
+
+func (vm *VM) tableGrow() {
+	table := vm.fetchUint32()
+	n := uint32(vm.ctx.stack[len(vm.ctx.stack)-1])
+	size := len(vm.tables[table])
+
+	// Tables never grow beyond wasm.MaxTableSize, like the tables
+	// calibrated by wasm.ReadModule.
+	max := uint64(wasm.MaxTableSize)
+	if limits := vm.module.GetTable(int(table)).Limits; limits.Flags&0x1 != 0 && uint64(limits.Maximum) < max {
+		max = uint64(limits.Maximum)
+	}
+	if uint64(size)+uint64(n) > max {
+		vm.ctx.stack = vm.ctx.stack[:len(vm.ctx.stack)-2]
+		vm.pushInt32(-1)
+		return
+	}
+	// Like grow_memory, the operands are only popped once the elements are
+	// paid for.
+	if cost := vm.tableElemsCost(n); cost != 0 && !vm.charge(cost) {
+		return
+	}
+	vm.popUint32()
+	val := refEntry(vm.popUint64())
+
+	tables := vm.writableTables()
+	grown := make([]wasm.TableEntry, size+int(n))
+	copy(grown, tables[table])
+	for i := size; i < len(grown); i++ {
+		grown[i] = val
+	}
+	tables[table] = grown
+	vm.pushUint32(uint32(size))
+}
+
+func (vm *VM) tableFill() {
+	table := vm.fetchUint32()
+	dst, _, n := vm.bulkOperands()
+	val := refEntry(vm.ctx.stack[len(vm.ctx.stack)-2])
+	if !inRange(dst, n, len(vm.tables[table])) {
+		panic(ErrOutOfBoundsTableAccess)
+	}
+	if !vm.chargeBulk(vm.tableElemsCost(n)) {
+		return
+	}
+	entries := vm.writableTables()[table][dst : dst+n]
+	for i := range entries {
+		entries[i] = val
+	}
+}
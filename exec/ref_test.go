@@ -0,0 +1,178 @@
+// Copyright 2020 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ontio/wagon/wasm"
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// moduleRef has two tables of functions: table 0 of 2 elements and table 1
+// of 1 element, function 5, returning 10. Functions 0 to 8 are:
+//
+//	isnull(i) i32  ref.is_null (table.get 0)
+//	set(i)         table.set 0 (ref.func 5)
+//	grow(n) i32    table.grow 1 (ref.null func)
+//	size() i32     table.size 1
+//	call1(i) i32   call_indirect 1 () i32
+//	ten() i32
+//	fill(d, n)     table.fill 0 (ref.func 5)
+//	call0(i) i32   call_indirect 0 () i32
+//	sel(c) i32     ref.is_null (select funcref (ref.func 5) (ref.null func))
+var moduleRef = moduleBytes(
+	section(0x01, 0x04,
+		0x60, 0x01, 0x7f, 0x01, 0x7f,
+		0x60, 0x00, 0x01, 0x7f,
+		0x60, 0x01, 0x7f, 0x00,
+		0x60, 0x02, 0x7f, 0x7f, 0x00),
+	section(0x03, 0x09, 0x00, 0x02, 0x00, 0x01, 0x00, 0x01, 0x03, 0x00, 0x00),
+	section(0x04, 0x02, 0x70, 0x00, 0x02, 0x70, 0x00, 0x01),
+	section(0x09, 0x01, 0x02, 0x01, 0x41, 0x00, 0x0b, 0x00, 0x01, 0x05),
+	section(0x0a, bytes.Join([][]byte{
+		{0x09},
+		funcBody(0x20, 0x00, 0x25, 0x00, 0xd1),
+		funcBody(0x20, 0x00, 0xd2, 0x05, 0x26, 0x00),
+		funcBody(0xd0, 0x70, 0x20, 0x00, 0xfc, 0x0f, 0x01),
+		funcBody(0xfc, 0x10, 0x01),
+		funcBody(0x20, 0x00, 0x11, 0x01, 0x01),
+		funcBody(0x41, 0x0a),
+		funcBody(0x20, 0x00, 0xd2, 0x05, 0x20, 0x01, 0xfc, 0x11, 0x00),
+		funcBody(0x20, 0x00, 0x11, 0x01, 0x00),
+		funcBody(0xd2, 0x05, 0xd0, 0x70, 0x20, 0x00, 0x1c, 0x01, 0x70, 0xd1),
+	}, nil)...),
+)
+
+const (
+	refIsNull = iota
+	refSet
+	refGrow
+	refSize
+	refCall1
+	refTen
+	refFill
+	refCall0
+	refSelect
+)
+
+func TestReferenceTypesDisabled(t *testing.T) {
+	_, err := CompileModuleWithFeatures(readTestModule(t, moduleRef, nil), nil, ops.FeatureBulkMemory)
+	var disabled ops.DisabledOpcodeError
+	if !errors.As(err, &disabled) {
+		t.Fatalf("got error %v, want a DisabledOpcodeError", err)
+	}
+}
+
+func TestReferenceTypes(t *testing.T) {
+	vm := newTestVM(t, compileTestModuleWithFeatures(t, moduleRef, nil, ops.FeatureReferenceTypes))
+
+	if got := mustExec(t, vm, refIsNull, 0); got != uint32(1) {
+		t.Errorf("isnull(0) = %v, want 1", got)
+	}
+	mustExec(t, vm, refSet, 0)
+	if got := mustExec(t, vm, refIsNull, 0); got != uint32(0) {
+		t.Errorf("isnull(0) after set = %v, want 0", got)
+	}
+	if got := mustExec(t, vm, refCall0, 0); got != uint32(10) {
+		t.Errorf("call0(0) = %v, want 10", got)
+	}
+	if got := mustExec(t, vm, refCall1, 0); got != uint32(10) {
+		t.Errorf("call1(0) = %v, want 10", got)
+	}
+	_, err := vm.ExecCode(refIsNull, 2)
+	checkTrap(t, err, TrapTableOutOfBounds)
+	_, err = vm.ExecCode(refSet, 2)
+	checkTrap(t, err, TrapTableOutOfBounds)
+
+	for _, tt := range []struct {
+		n, want uint32
+		size    uint32
+	}{{2, 1, 3}, {wasm.MaxTableSize, 0xffffffff, 3}, {0, 3, 3}} {
+		if got := mustExec(t, vm, refGrow, uint64(tt.n)); got != tt.want {
+			t.Errorf("grow(%d) = %v, want %d", tt.n, got, tt.want)
+		}
+		if got := mustExec(t, vm, refSize); got != tt.size {
+			t.Errorf("size() after grow(%d) = %v, want %d", tt.n, got, tt.size)
+		}
+	}
+
+	_, err = vm.ExecCode(refFill, 1, 2)
+	checkTrap(t, err, TrapTableOutOfBounds)
+	mustExec(t, vm, refFill, 1, 1)
+	if got := mustExec(t, vm, refCall0, 1); got != uint32(10) {
+		t.Errorf("call0(1) after fill = %v, want 10", got)
+	}
+
+	for c, want := range []uint32{1, 0} {
+		if got := mustExec(t, vm, refSelect, uint64(c)); got != want {
+			t.Errorf("sel(%d) = %v, want %d", c, got, want)
+		}
+	}
+}
+
+func TestReferenceTypesSnapshot(t *testing.T) {
+	compiled := compileTestModuleWithFeatures(t, moduleRef, nil, ops.FeatureReferenceTypes)
+	vm := newTestVM(t, compiled)
+	mustExec(t, vm, refGrow, 2)
+	mustExec(t, vm, refSet, 1)
+	s := vm.Snapshot()
+
+	restored := newTestVM(t, compiled)
+	if err := restored.Restore(s); err != nil {
+		t.Fatalf("could not restore snapshot: %v", err)
+	}
+	if got := mustExec(t, restored, refSize); got != uint32(3) {
+		t.Errorf("size() = %v, want 3", got)
+	}
+	if got := mustExec(t, restored, refCall0, 1); got != uint32(10) {
+		t.Errorf("call0(1) = %v, want 10", got)
+	}
+}
+
+func TestExternRef(t *testing.T) {
+	proc := NewProcess(newTestVM(t, compileTestModuleWithFeatures(t, moduleRef, nil, ops.FeatureReferenceTypes)))
+	ref := proc.NewExternRef("value")
+	if ref == 0 {
+		t.Fatal("NewExternRef returned the null reference")
+	}
+	if v, ok := proc.ExternRef(ref); !ok || v != "value" {
+		t.Errorf("ExternRef(%d) = %v, %v, want value, true", ref, v, ok)
+	}
+	for _, ref := range []uint64{0, ref + 1} {
+		if v, ok := proc.ExternRef(ref); ok {
+			t.Errorf("ExternRef(%d) = %v, want an invalid reference", ref, v)
+		}
+	}
+}
+
+func TestExternRefSnapshot(t *testing.T) {
+	compiled := compileTestModuleWithFeatures(t, moduleRef, nil, ops.FeatureReferenceTypes)
+	vm := newTestVM(t, compiled)
+	ref := NewProcess(vm).NewExternRef("value")
+	buf, err := json.Marshal(vm.Snapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Snapshot
+	if err := json.Unmarshal(buf, &s); err != nil {
+		t.Fatal(err)
+	}
+
+	restored := newTestVM(t, compiled)
+	if err := restored.Restore(&s); err != nil {
+		t.Fatalf("could not restore snapshot: %v", err)
+	}
+	proc := NewProcess(restored)
+	if v, ok := proc.ExternRef(ref); !ok || v != "value" {
+		t.Errorf("restored ExternRef(%d) = %v, %v, want value, true", ref, v, ok)
+	}
+	if next := proc.NewExternRef("next"); next != ref+1 {
+		t.Errorf("NewExternRef after restore = %d, want %d", next, ref+1)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2020 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"testing"
+
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// moduleSignExt exports ext8(i32) i32, applying i32.extend8_s to its
+// argument, and ext32(i64) i64, applying i64.extend32_s to its argument.
+var moduleSignExt = moduleBytes(
+	section(0x01, 0x02, 0x60, 0x01, 0x7f, 0x01, 0x7f, 0x60, 0x01, 0x7e, 0x01, 0x7e),
+	section(0x03, 0x02, 0x00, 0x01),
+	section(0x07, 0x02, 0x04, 'e', 'x', 't', '8', 0x00, 0x00, 0x05, 'e', 'x', 't', '3', '2', 0x00, 0x01),
+	section(0x0a, 0x02,
+		0x05, 0x00, 0x20, 0x00, 0xc0, 0x0b,
+		0x05, 0x00, 0x20, 0x00, 0xc4, 0x0b),
+)
+
+func TestSignExtOps(t *testing.T) {
+	for _, tc := range []struct {
+		opcode byte
+		arg    uint64
+		want   uint64
+	}{
+		{ops.I32Extend8S, 0x7f, 0x7f},
+		{ops.I32Extend8S, 0x80, 0xffffff80},
+		{ops.I32Extend8S, 0x12345680, 0xffffff80},
+		{ops.I32Extend16S, 0x7fff, 0x7fff},
+		{ops.I32Extend16S, 0x12348000, 0xffff8000},
+		{ops.I64Extend8S, 0x80, 0xffffffffffffff80},
+		{ops.I64Extend8S, 0x0123456789abcd01, 0x01},
+		{ops.I64Extend16S, 0x8000, 0xffffffffffff8000},
+		{ops.I64Extend32S, 0x7fffffff, 0x7fffffff},
+		{ops.I64Extend32S, 0x0123456780000000, 0xffffffff80000000},
+	} {
+		vm := new(VM)
+		vm.newFuncTable()
+		vm.pushUint64(tc.arg)
+		vm.funcTable[tc.opcode]()
+		if got := vm.popUint64(); got != tc.want {
+			op, _ := ops.New(tc.opcode)
+			t.Errorf("%s(%#x): got=%#x, want=%#x", op.Name, tc.arg, got, tc.want)
+		}
+	}
+}
+
+func TestCompileModuleWithFeatures(t *testing.T) {
+	m := readTestModule(t, moduleSignExt, nil)
+	_, err := CompileModule(m, nil)
+	if err != ops.DisabledOpcodeError(ops.I32Extend8S) {
+		t.Fatalf("CompileModule: got error %v, want %v", err, ops.DisabledOpcodeError(ops.I32Extend8S))
+	}
+
+	compiled, err := CompileModuleWithFeatures(m, nil, ops.FeatureSignExt)
+	if err != nil {
+		t.Fatalf("could not compile module: %v", err)
+	}
+	vm := newTestVM(t, compiled)
+	for _, tc := range []struct {
+		fn   int64
+		arg  uint64
+		want interface{}
+	}{
+		{0, 0xff, uint32(0xffffffff)},
+		{0, 0x17f, uint32(0x7f)},
+		{1, 0x80000000, uint64(0xffffffff80000000)},
+	} {
+		got, err := vm.ExecCode(tc.fn, tc.arg)
+		if err != nil {
+			t.Fatalf("function %d(%#x): %v", tc.fn, tc.arg, err)
+		}
+		if got != tc.want {
+			t.Errorf("function %d(%#x): got=%#x, want=%#x", tc.fn, tc.arg, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,729 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"math"
+	"math/bits"
+
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// v128 is a value of type v128, as its low and high 64 bits. It takes two
+// slots of the stack, the high bits on top.
+type v128 [2]uint64
+
+// lane returns the lane i of v, viewed as lanes of the given width in bits.
+func (v v128) lane(width, i int) uint64 {
+	per := 64 / width
+	x := v[i/per] >> uint(i%per*width)
+	if width < 64 {
+		x &= 1<<uint(width) - 1
+	}
+	return x
+}
+
+// setLane sets the lane i of v, viewed as lanes of the given width in bits,
+// to the low bits of x.
+func (v *v128) setLane(width, i int, x uint64) {
+	per := 64 / width
+	shift := uint(i % per * width)
+	mask := ^uint64(0)
+	if width < 64 {
+		mask = 1<<uint(width) - 1
+	}
+	v[i/per] = v[i/per]&^(mask<<shift) | (x&mask)<<shift
+}
+
+// signExtend interprets the low width bits of x as a signed integer.
+func signExtend(x uint64, width int) int64 {
+	shift := uint(64 - width)
+	return int64(x<<shift) >> shift
+}
+
+// laneMask returns a lane with all bits set if b is true, and zero otherwise.
+func laneMask(b bool) uint64 {
+	if b {
+		return ^uint64(0)
+	}
+	return 0
+}
+
+// saturateS clamps x to the range of signed integers of the given width.
+func saturateS(x int64, width int) uint64 {
+	max := int64(1)<<uint(width-1) - 1
+	min := -max - 1
+	if x > max {
+		x = max
+	} else if x < min {
+		x = min
+	}
+	return uint64(x)
+}
+
+// saturateU clamps x to the range of unsigned integers of the given width.
+func saturateU(x int64, width int) uint64 {
+	max := int64(1)<<uint(width) - 1
+	if x > max {
+		x = max
+	} else if x < 0 {
+		x = 0
+	}
+	return uint64(x)
+}
+
+func (vm *VM) popV128() v128 {
+	hi := vm.popUint64()
+	lo := vm.popUint64()
+	return v128{lo, hi}
+}
+
+func (vm *VM) pushV128(v v128) {
+	vm.pushUint64(v[0])
+	vm.pushUint64(v[1])
+}
+
+// fetchLane fetches a lane index immediate.
+func (vm *VM) fetchLane() int {
+	return int(uint8(vm.fetchInt8()))
+}
+
+// simdAddr pops the base address of a memory operator with the given offset
+// immediate, and returns the memory accessed by n bytes from the effective
+// address. The VM traps if the access is out of bounds.
+func (vm *VM) simdAddr(offset uint32, n int) []byte {
+	addr := uint64(offset) + uint64(vm.popUint32())
+	if addr+uint64(n) > uint64(len(vm.memory)) {
+		panic(ErrOutOfBoundsMemoryAccess)
+	}
+	return vm.memory[addr : addr+uint64(n)]
+}
+
+// v128Unop applies f to every lane of the given width of the operand.
+func (vm *VM) v128Unop(width int, f func(x uint64) uint64) {
+	a := vm.popV128()
+	var r v128
+	for i := 0; i < 128/width; i++ {
+		r.setLane(width, i, f(a.lane(width, i)))
+	}
+	vm.pushV128(r)
+}
+
+// v128Binop applies f to every pair of lanes of the given width of the
+// operands.
+func (vm *VM) v128Binop(width int, f func(a, b uint64) uint64) {
+	b := vm.popV128()
+	a := vm.popV128()
+	var r v128
+	for i := 0; i < 128/width; i++ {
+		r.setLane(width, i, f(a.lane(width, i), b.lane(width, i)))
+	}
+	vm.pushV128(r)
+}
+
+// v128Shift shifts every lane of the given width of the operand by the i32
+// operand, modulo the width.
+func (vm *VM) v128Shift(width int, f func(x uint64, n uint) uint64) {
+	n := uint(vm.popUint32()) % uint(width)
+	vm.v128Unop(width, func(x uint64) uint64 { return f(x, n) })
+}
+
+// v128Convert maps the lanes of the operand to the lanes of the result,
+// which may have a different width. Lanes of the result from index n on are
+// set to zero.
+func (vm *VM) v128Convert(from, to, start, n int, f func(x uint64) uint64) {
+	a := vm.popV128()
+	var r v128
+	for i := 0; i < n; i++ {
+		r.setLane(to, i, f(a.lane(from, start+i)))
+	}
+	vm.pushV128(r)
+}
+
+// v128Narrow narrows the lanes of the given width of the operands to
+// lanes of half the width, with the saturation f.
+func (vm *VM) v128Narrow(width int, f func(x int64, width int) uint64) {
+	b := vm.popV128()
+	a := vm.popV128()
+	var r v128
+	n := 128 / width
+	for i := 0; i < n; i++ {
+		r.setLane(width/2, i, f(signExtend(a.lane(width, i), width), width/2))
+		r.setLane(width/2, n+i, f(signExtend(b.lane(width, i), width), width/2))
+	}
+	vm.pushV128(r)
+}
+
+// v128ExtMul multiplies the extended lanes of the given width of the low
+// or high halves of the operands.
+func (vm *VM) v128ExtMul(width int, high, signed bool) {
+	b := vm.popV128()
+	a := vm.popV128()
+	var r v128
+	n := 64 / width
+	start := 0
+	if high {
+		start = n
+	}
+	for i := 0; i < n; i++ {
+		x, y := a.lane(width, start+i), b.lane(width, start+i)
+		if signed {
+			x, y = uint64(signExtend(x, width)), uint64(signExtend(y, width))
+		}
+		r.setLane(2*width, i, x*y)
+	}
+	vm.pushV128(r)
+}
+
+// v128ExtAddPairwise adds the extended pairs of adjacent lanes of the given
+// width of the operand.
+func (vm *VM) v128ExtAddPairwise(width int, signed bool) {
+	a := vm.popV128()
+	var r v128
+	for i := 0; i < 64/width; i++ {
+		x, y := a.lane(width, 2*i), a.lane(width, 2*i+1)
+		if signed {
+			x, y = uint64(signExtend(x, width)), uint64(signExtend(y, width))
+		}
+		r.setLane(2*width, i, x+y)
+	}
+	vm.pushV128(r)
+}
+
+// v128AllTrue pushes whether every lane of the given width is non-zero.
+func (vm *VM) v128AllTrue(width int) {
+	a := vm.popV128()
+	for i := 0; i < 128/width; i++ {
+		if a.lane(width, i) == 0 {
+			vm.pushBool(false)
+			return
+		}
+	}
+	vm.pushBool(true)
+}
+
+// v128Bitmask pushes the most significant bits of the lanes of the given
+// width.
+func (vm *VM) v128Bitmask(width int) {
+	a := vm.popV128()
+	var mask uint32
+	for i := 0; i < 128/width; i++ {
+		mask |= uint32(a.lane(width, i)>>uint(width-1)) << uint(i)
+	}
+	vm.pushUint32(mask)
+}
+
+func (vm *VM) v128Load() {
+	mem := vm.simdAddr(vm.fetchUint32(), 16)
+	vm.pushV128(v128{endianess.Uint64(mem), endianess.Uint64(mem[8:])})
+}
+
+// v128LoadExtend loads 8 bytes as lanes of the given width, extended to
+// lanes of twice the width.
+func (vm *VM) v128LoadExtend(width int, signed bool) {
+	mem := vm.simdAddr(vm.fetchUint32(), 8)
+	a := v128{endianess.Uint64(mem)}
+	var r v128
+	for i := 0; i < 64/width; i++ {
+		x := a.lane(width, i)
+		if signed {
+			x = uint64(signExtend(x, width))
+		}
+		r.setLane(2*width, i, x)
+	}
+	vm.pushV128(r)
+}
+
+// loadLane returns the little-endian value of width bits at mem.
+func loadLane(mem []byte, width int) uint64 {
+	var x uint64
+	for i := width/8 - 1; i >= 0; i-- {
+		x = x<<8 | uint64(mem[i])
+	}
+	return x
+}
+
+// storeLane stores the low width bits of x at mem, in little endian.
+func storeLane(mem []byte, width int, x uint64) {
+	for i := 0; i < width/8; i++ {
+		mem[i] = byte(x >> uint(8*i))
+	}
+}
+
+func (vm *VM) v128LoadSplat(width int) {
+	x := loadLane(vm.simdAddr(vm.fetchUint32(), width/8), width)
+	var r v128
+	for i := 0; i < 128/width; i++ {
+		r.setLane(width, i, x)
+	}
+	vm.pushV128(r)
+}
+
+func (vm *VM) v128LoadZero(width int) {
+	var r v128
+	r.setLane(width, 0, loadLane(vm.simdAddr(vm.fetchUint32(), width/8), width))
+	vm.pushV128(r)
+}
+
+func (vm *VM) v128Store() {
+	v := vm.popV128()
+	mem := vm.simdAddr(vm.fetchUint32(), 16)
+	endianess.PutUint64(mem, v[0])
+	endianess.PutUint64(mem[8:], v[1])
+}
+
+func (vm *VM) v128LoadLane(width int) {
+	offset := vm.fetchUint32()
+	lane := vm.fetchLane()
+	v := vm.popV128()
+	v.setLane(width, lane, loadLane(vm.simdAddr(offset, width/8), width))
+	vm.pushV128(v)
+}
+
+func (vm *VM) v128StoreLane(width int) {
+	offset := vm.fetchUint32()
+	lane := vm.fetchLane()
+	v := vm.popV128()
+	storeLane(vm.simdAddr(offset, width/8), width, v.lane(width, lane))
+}
+
+func (vm *VM) v128Const() {
+	lo := vm.fetchUint64()
+	hi := vm.fetchUint64()
+	vm.pushV128(v128{lo, hi})
+}
+
+func (vm *VM) i8x16Shuffle() {
+	var lanes [16]int
+	for i := range lanes {
+		lanes[i] = vm.fetchLane()
+	}
+	b := vm.popV128()
+	a := vm.popV128()
+	var r v128
+	for i, l := range lanes {
+		if l < 16 {
+			r.setLane(8, i, a.lane(8, l))
+		} else {
+			r.setLane(8, i, b.lane(8, l-16))
+		}
+	}
+	vm.pushV128(r)
+}
+
+func (vm *VM) i8x16Swizzle() {
+	s := vm.popV128()
+	a := vm.popV128()
+	var r v128
+	for i := 0; i < 16; i++ {
+		if l := int(s.lane(8, i)); l < 16 {
+			r.setLane(8, i, a.lane(8, l))
+		}
+	}
+	vm.pushV128(r)
+}
+
+func (vm *VM) v128Splat(width int, x uint64) {
+	var r v128
+	for i := 0; i < 128/width; i++ {
+		r.setLane(width, i, x)
+	}
+	vm.pushV128(r)
+}
+
+func (vm *VM) v128ExtractLane(width int, signed bool) {
+	lane := vm.fetchLane()
+	x := vm.popV128().lane(width, lane)
+	if signed {
+		x = uint64(signExtend(x, width))
+	}
+	if width < 64 {
+		vm.pushUint32(uint32(x))
+	} else {
+		vm.pushUint64(x)
+	}
+}
+
+func (vm *VM) v128ReplaceLane(width int) {
+	lane := vm.fetchLane()
+	x := vm.popUint64()
+	v := vm.popV128()
+	v.setLane(width, lane, x)
+	vm.pushV128(v)
+}
+
+func (vm *VM) v128Bitselect() {
+	c := vm.popV128()
+	b := vm.popV128()
+	a := vm.popV128()
+	vm.pushV128(v128{a[0]&c[0] | b[0]&^c[0], a[1]&c[1] | b[1]&^c[1]})
+}
+
+func (vm *VM) v128AnyTrue() {
+	v := vm.popV128()
+	vm.pushBool(v[0]|v[1] != 0)
+}
+
+// intAbs returns the absolute value of the lane x of the given width.
+func intAbs(x uint64, width int) uint64 {
+	if v := signExtend(x, width); v < 0 {
+		return uint64(-v)
+	}
+	return x
+}
+
+// newSIMDFuncTable registers the implementations of the SIMD operators. The
+// floating-point lanes are computed by the fpu of the VM, so that their
+// results, NaNs included, are the same as the scalar operators'.
+func (vm *VM) newSIMDFuncTable() {
+	set := func(sub uint32, fn func()) {
+		vm.setPrefixedFunc(ops.SIMDPrefix, sub, fn)
+	}
+	unop := func(sub uint32, width int, f func(x uint64) uint64) {
+		set(sub, func() { vm.v128Unop(width, f) })
+	}
+	binop := func(sub uint32, width int, f func(a, b uint64) uint64) {
+		set(sub, func() { vm.v128Binop(width, f) })
+	}
+	f32Unop := func(sub uint32, f func(fp fpu, x uint32) uint32) {
+		set(sub, func() {
+			fp := vm.fpu()
+			vm.v128Unop(32, func(x uint64) uint64 { return uint64(f(fp, uint32(x))) })
+		})
+	}
+	f64Unop := func(sub uint32, f func(fp fpu, x uint64) uint64) {
+		set(sub, func() {
+			fp := vm.fpu()
+			vm.v128Unop(64, func(x uint64) uint64 { return f(fp, x) })
+		})
+	}
+	f32Binop := func(sub uint32, f func(fp fpu, a, b uint32) uint64) {
+		set(sub, func() {
+			fp := vm.fpu()
+			vm.v128Binop(32, func(a, b uint64) uint64 { return f(fp, uint32(a), uint32(b)) })
+		})
+	}
+	f64Binop := func(sub uint32, f func(fp fpu, a, b uint64) uint64) {
+		set(sub, func() {
+			fp := vm.fpu()
+			vm.v128Binop(64, func(a, b uint64) uint64 { return f(fp, a, b) })
+		})
+	}
+
+	// Memory operators.
+	set(ops.V128Load, vm.v128Load)
+	set(ops.V128Load8x8S, func() { vm.v128LoadExtend(8, true) })
+	set(ops.V128Load8x8U, func() { vm.v128LoadExtend(8, false) })
+	set(ops.V128Load16x4S, func() { vm.v128LoadExtend(16, true) })
+	set(ops.V128Load16x4U, func() { vm.v128LoadExtend(16, false) })
+	set(ops.V128Load32x2S, func() { vm.v128LoadExtend(32, true) })
+	set(ops.V128Load32x2U, func() { vm.v128LoadExtend(32, false) })
+	set(ops.V128Load8Splat, func() { vm.v128LoadSplat(8) })
+	set(ops.V128Load16Splat, func() { vm.v128LoadSplat(16) })
+	set(ops.V128Load32Splat, func() { vm.v128LoadSplat(32) })
+	set(ops.V128Load64Splat, func() { vm.v128LoadSplat(64) })
+	set(ops.V128Store, vm.v128Store)
+	set(ops.V128Load8Lane, func() { vm.v128LoadLane(8) })
+	set(ops.V128Load16Lane, func() { vm.v128LoadLane(16) })
+	set(ops.V128Load32Lane, func() { vm.v128LoadLane(32) })
+	set(ops.V128Load64Lane, func() { vm.v128LoadLane(64) })
+	set(ops.V128Store8Lane, func() { vm.v128StoreLane(8) })
+	set(ops.V128Store16Lane, func() { vm.v128StoreLane(16) })
+	set(ops.V128Store32Lane, func() { vm.v128StoreLane(32) })
+	set(ops.V128Store64Lane, func() { vm.v128StoreLane(64) })
+	set(ops.V128Load32Zero, func() { vm.v128LoadZero(32) })
+	set(ops.V128Load64Zero, func() { vm.v128LoadZero(64) })
+
+	// Constants, shuffles, splats and lanes.
+	set(ops.V128Const, vm.v128Const)
+	set(ops.I8x16Shuffle, vm.i8x16Shuffle)
+	set(ops.I8x16Swizzle, vm.i8x16Swizzle)
+	set(ops.I8x16Splat, func() { vm.v128Splat(8, vm.popUint64()) })
+	set(ops.I16x8Splat, func() { vm.v128Splat(16, vm.popUint64()) })
+	set(ops.I32x4Splat, func() { vm.v128Splat(32, vm.popUint64()) })
+	set(ops.I64x2Splat, func() { vm.v128Splat(64, vm.popUint64()) })
+	set(ops.F32x4Splat, func() { vm.v128Splat(32, vm.popUint64()) })
+	set(ops.F64x2Splat, func() { vm.v128Splat(64, vm.popUint64()) })
+	set(ops.I8x16ExtractLaneS, func() { vm.v128ExtractLane(8, true) })
+	set(ops.I8x16ExtractLaneU, func() { vm.v128ExtractLane(8, false) })
+	set(ops.I16x8ExtractLaneS, func() { vm.v128ExtractLane(16, true) })
+	set(ops.I16x8ExtractLaneU, func() { vm.v128ExtractLane(16, false) })
+	set(ops.I32x4ExtractLane, func() { vm.v128ExtractLane(32, false) })
+	set(ops.I64x2ExtractLane, func() { vm.v128ExtractLane(64, false) })
+	set(ops.F32x4ExtractLane, func() { vm.v128ExtractLane(32, false) })
+	set(ops.F64x2ExtractLane, func() { vm.v128ExtractLane(64, false) })
+	set(ops.I8x16ReplaceLane, func() { vm.v128ReplaceLane(8) })
+	set(ops.I16x8ReplaceLane, func() { vm.v128ReplaceLane(16) })
+	set(ops.I32x4ReplaceLane, func() { vm.v128ReplaceLane(32) })
+	set(ops.I64x2ReplaceLane, func() { vm.v128ReplaceLane(64) })
+	set(ops.F32x4ReplaceLane, func() { vm.v128ReplaceLane(32) })
+	set(ops.F64x2ReplaceLane, func() { vm.v128ReplaceLane(64) })
+
+	// Integer comparisons.
+	for _, shape := range []struct {
+		width                                          int
+		eq, ne, ltS, ltU, gtS, gtU, leS, leU, geS, geU uint32
+	}{
+		{8, ops.I8x16Eq, ops.I8x16Ne, ops.I8x16LtS, ops.I8x16LtU, ops.I8x16GtS, ops.I8x16GtU, ops.I8x16LeS, ops.I8x16LeU, ops.I8x16GeS, ops.I8x16GeU},
+		{16, ops.I16x8Eq, ops.I16x8Ne, ops.I16x8LtS, ops.I16x8LtU, ops.I16x8GtS, ops.I16x8GtU, ops.I16x8LeS, ops.I16x8LeU, ops.I16x8GeS, ops.I16x8GeU},
+		{32, ops.I32x4Eq, ops.I32x4Ne, ops.I32x4LtS, ops.I32x4LtU, ops.I32x4GtS, ops.I32x4GtU, ops.I32x4LeS, ops.I32x4LeU, ops.I32x4GeS, ops.I32x4GeU},
+	} {
+		w := shape.width
+		binop(shape.eq, w, func(a, b uint64) uint64 { return laneMask(a == b) })
+		binop(shape.ne, w, func(a, b uint64) uint64 { return laneMask(a != b) })
+		binop(shape.ltS, w, func(a, b uint64) uint64 { return laneMask(signExtend(a, w) < signExtend(b, w)) })
+		binop(shape.ltU, w, func(a, b uint64) uint64 { return laneMask(a < b) })
+		binop(shape.gtS, w, func(a, b uint64) uint64 { return laneMask(signExtend(a, w) > signExtend(b, w)) })
+		binop(shape.gtU, w, func(a, b uint64) uint64 { return laneMask(a > b) })
+		binop(shape.leS, w, func(a, b uint64) uint64 { return laneMask(signExtend(a, w) <= signExtend(b, w)) })
+		binop(shape.leU, w, func(a, b uint64) uint64 { return laneMask(a <= b) })
+		binop(shape.geS, w, func(a, b uint64) uint64 { return laneMask(signExtend(a, w) >= signExtend(b, w)) })
+		binop(shape.geU, w, func(a, b uint64) uint64 { return laneMask(a >= b) })
+	}
+	binop(ops.I64x2Eq, 64, func(a, b uint64) uint64 { return laneMask(a == b) })
+	binop(ops.I64x2Ne, 64, func(a, b uint64) uint64 { return laneMask(a != b) })
+	binop(ops.I64x2LtS, 64, func(a, b uint64) uint64 { return laneMask(int64(a) < int64(b)) })
+	binop(ops.I64x2GtS, 64, func(a, b uint64) uint64 { return laneMask(int64(a) > int64(b)) })
+	binop(ops.I64x2LeS, 64, func(a, b uint64) uint64 { return laneMask(int64(a) <= int64(b)) })
+	binop(ops.I64x2GeS, 64, func(a, b uint64) uint64 { return laneMask(int64(a) >= int64(b)) })
+
+	// Floating-point comparisons.
+	f32Binop(ops.F32x4Eq, func(fp fpu, a, b uint32) uint64 { return laneMask(fp.f32Eq(a, b)) })
+	f32Binop(ops.F32x4Ne, func(fp fpu, a, b uint32) uint64 { return laneMask(!fp.f32Eq(a, b)) })
+	f32Binop(ops.F32x4Lt, func(fp fpu, a, b uint32) uint64 { return laneMask(fp.f32Lt(a, b)) })
+	f32Binop(ops.F32x4Gt, func(fp fpu, a, b uint32) uint64 { return laneMask(fp.f32Lt(b, a)) })
+	f32Binop(ops.F32x4Le, func(fp fpu, a, b uint32) uint64 { return laneMask(fp.f32Le(a, b)) })
+	f32Binop(ops.F32x4Ge, func(fp fpu, a, b uint32) uint64 { return laneMask(fp.f32Le(b, a)) })
+	f64Binop(ops.F64x2Eq, func(fp fpu, a, b uint64) uint64 { return laneMask(fp.f64Eq(a, b)) })
+	f64Binop(ops.F64x2Ne, func(fp fpu, a, b uint64) uint64 { return laneMask(!fp.f64Eq(a, b)) })
+	f64Binop(ops.F64x2Lt, func(fp fpu, a, b uint64) uint64 { return laneMask(fp.f64Lt(a, b)) })
+	f64Binop(ops.F64x2Gt, func(fp fpu, a, b uint64) uint64 { return laneMask(fp.f64Lt(b, a)) })
+	f64Binop(ops.F64x2Le, func(fp fpu, a, b uint64) uint64 { return laneMask(fp.f64Le(a, b)) })
+	f64Binop(ops.F64x2Ge, func(fp fpu, a, b uint64) uint64 { return laneMask(fp.f64Le(b, a)) })
+
+	// Bitwise operators.
+	unop(ops.V128Not, 64, func(x uint64) uint64 { return ^x })
+	binop(ops.V128And, 64, func(a, b uint64) uint64 { return a & b })
+	binop(ops.V128Andnot, 64, func(a, b uint64) uint64 { return a &^ b })
+	binop(ops.V128Or, 64, func(a, b uint64) uint64 { return a | b })
+	binop(ops.V128Xor, 64, func(a, b uint64) uint64 { return a ^ b })
+	set(ops.V128Bitselect, vm.v128Bitselect)
+	set(ops.V128AnyTrue, vm.v128AnyTrue)
+
+	// Integer arithmetic, by lane width.
+	for _, shape := range []struct {
+		width                                       int
+		abs, neg, allTrue, bitmask, shl, shrS, shrU uint32
+		add, sub, mul                               uint32
+	}{
+		{8, ops.I8x16Abs, ops.I8x16Neg, ops.I8x16AllTrue, ops.I8x16Bitmask, ops.I8x16Shl, ops.I8x16ShrS, ops.I8x16ShrU, ops.I8x16Add, ops.I8x16Sub, 0},
+		{16, ops.I16x8Abs, ops.I16x8Neg, ops.I16x8AllTrue, ops.I16x8Bitmask, ops.I16x8Shl, ops.I16x8ShrS, ops.I16x8ShrU, ops.I16x8Add, ops.I16x8Sub, ops.I16x8Mul},
+		{32, ops.I32x4Abs, ops.I32x4Neg, ops.I32x4AllTrue, ops.I32x4Bitmask, ops.I32x4Shl, ops.I32x4ShrS, ops.I32x4ShrU, ops.I32x4Add, ops.I32x4Sub, ops.I32x4Mul},
+		{64, ops.I64x2Abs, ops.I64x2Neg, ops.I64x2AllTrue, ops.I64x2Bitmask, ops.I64x2Shl, ops.I64x2ShrS, ops.I64x2ShrU, ops.I64x2Add, ops.I64x2Sub, ops.I64x2Mul},
+	} {
+		w := shape.width
+		unop(shape.abs, w, func(x uint64) uint64 { return intAbs(x, w) })
+		unop(shape.neg, w, func(x uint64) uint64 { return -x })
+		set(shape.allTrue, func() { vm.v128AllTrue(w) })
+		set(shape.bitmask, func() { vm.v128Bitmask(w) })
+		set(shape.shl, func() { vm.v128Shift(w, func(x uint64, n uint) uint64 { return x << n }) })
+		set(shape.shrS, func() { vm.v128Shift(w, func(x uint64, n uint) uint64 { return uint64(signExtend(x, w) >> n) }) })
+		set(shape.shrU, func() { vm.v128Shift(w, func(x uint64, n uint) uint64 { return x >> n }) })
+		binop(shape.add, w, func(a, b uint64) uint64 { return a + b })
+		binop(shape.sub, w, func(a, b uint64) uint64 { return a - b })
+		if shape.mul != 0 {
+			binop(shape.mul, w, func(a, b uint64) uint64 { return a * b })
+		}
+	}
+	for _, shape := range []struct {
+		width                                     int
+		addSatS, addSatU, subSatS, subSatU, avgrU uint32
+	}{
+		{8, ops.I8x16AddSatS, ops.I8x16AddSatU, ops.I8x16SubSatS, ops.I8x16SubSatU, ops.I8x16AvgrU},
+		{16, ops.I16x8AddSatS, ops.I16x8AddSatU, ops.I16x8SubSatS, ops.I16x8SubSatU, ops.I16x8AvgrU},
+	} {
+		w := shape.width
+		binop(shape.addSatS, w, func(a, b uint64) uint64 { return saturateS(signExtend(a, w)+signExtend(b, w), w) })
+		binop(shape.addSatU, w, func(a, b uint64) uint64 { return saturateU(int64(a+b), w) })
+		binop(shape.subSatS, w, func(a, b uint64) uint64 { return saturateS(signExtend(a, w)-signExtend(b, w), w) })
+		binop(shape.subSatU, w, func(a, b uint64) uint64 { return saturateU(int64(a)-int64(b), w) })
+		binop(shape.avgrU, w, func(a, b uint64) uint64 { return (a + b + 1) / 2 })
+	}
+	for _, shape := range []struct {
+		width                  int
+		minS, minU, maxS, maxU uint32
+	}{
+		{8, ops.I8x16MinS, ops.I8x16MinU, ops.I8x16MaxS, ops.I8x16MaxU},
+		{16, ops.I16x8MinS, ops.I16x8MinU, ops.I16x8MaxS, ops.I16x8MaxU},
+		{32, ops.I32x4MinS, ops.I32x4MinU, ops.I32x4MaxS, ops.I32x4MaxU},
+	} {
+		w := shape.width
+		binop(shape.minS, w, func(a, b uint64) uint64 {
+			if signExtend(a, w) < signExtend(b, w) {
+				return a
+			}
+			return b
+		})
+		binop(shape.minU, w, func(a, b uint64) uint64 {
+			if a < b {
+				return a
+			}
+			return b
+		})
+		binop(shape.maxS, w, func(a, b uint64) uint64 {
+			if signExtend(a, w) > signExtend(b, w) {
+				return a
+			}
+			return b
+		})
+		binop(shape.maxU, w, func(a, b uint64) uint64 {
+			if a > b {
+				return a
+			}
+			return b
+		})
+	}
+	unop(ops.I8x16Popcnt, 8, func(x uint64) uint64 { return uint64(bits.OnesCount64(x)) })
+	binop(ops.I16x8Q15mulrSatS, 16, func(a, b uint64) uint64 {
+		return saturateS((signExtend(a, 16)*signExtend(b, 16)+0x4000)>>15, 16)
+	})
+	set(ops.I32x4DotI16x8S, func() {
+		b := vm.popV128()
+		a := vm.popV128()
+		var r v128
+		for i := 0; i < 4; i++ {
+			x := signExtend(a.lane(16, 2*i), 16) * signExtend(b.lane(16, 2*i), 16)
+			y := signExtend(a.lane(16, 2*i+1), 16) * signExtend(b.lane(16, 2*i+1), 16)
+			r.setLane(32, i, uint64(x+y))
+		}
+		vm.pushV128(r)
+	})
+
+	// Narrowing and widening.
+	set(ops.I8x16NarrowI16x8S, func() { vm.v128Narrow(16, saturateS) })
+	set(ops.I8x16NarrowI16x8U, func() { vm.v128Narrow(16, saturateU) })
+	set(ops.I16x8NarrowI32x4S, func() { vm.v128Narrow(32, saturateS) })
+	set(ops.I16x8NarrowI32x4U, func() { vm.v128Narrow(32, saturateU) })
+	for _, shape := range []struct {
+		width                                int
+		lowS, highS, lowU, highU             uint32
+		mulLowS, mulHighS, mulLowU, mulHighU uint32
+	}{
+		{8, ops.I16x8ExtendLowI8x16S, ops.I16x8ExtendHighI8x16S, ops.I16x8ExtendLowI8x16U, ops.I16x8ExtendHighI8x16U,
+			ops.I16x8ExtmulLowI8x16S, ops.I16x8ExtmulHighI8x16S, ops.I16x8ExtmulLowI8x16U, ops.I16x8ExtmulHighI8x16U},
+		{16, ops.I32x4ExtendLowI16x8S, ops.I32x4ExtendHighI16x8S, ops.I32x4ExtendLowI16x8U, ops.I32x4ExtendHighI16x8U,
+			ops.I32x4ExtmulLowI16x8S, ops.I32x4ExtmulHighI16x8S, ops.I32x4ExtmulLowI16x8U, ops.I32x4ExtmulHighI16x8U},
+		{32, ops.I64x2ExtendLowI32x4S, ops.I64x2ExtendHighI32x4S, ops.I64x2ExtendLowI32x4U, ops.I64x2ExtendHighI32x4U,
+			ops.I64x2ExtmulLowI32x4S, ops.I64x2ExtmulHighI32x4S, ops.I64x2ExtmulLowI32x4U, ops.I64x2ExtmulHighI32x4U},
+	} {
+		w := shape.width
+		n := 64 / w
+		sext := func(x uint64) uint64 { return uint64(signExtend(x, w)) }
+		zext := func(x uint64) uint64 { return x }
+		set(shape.lowS, func() { vm.v128Convert(w, 2*w, 0, n, sext) })
+		set(shape.highS, func() { vm.v128Convert(w, 2*w, n, n, sext) })
+		set(shape.lowU, func() { vm.v128Convert(w, 2*w, 0, n, zext) })
+		set(shape.highU, func() { vm.v128Convert(w, 2*w, n, n, zext) })
+		set(shape.mulLowS, func() { vm.v128ExtMul(w, false, true) })
+		set(shape.mulHighS, func() { vm.v128ExtMul(w, true, true) })
+		set(shape.mulLowU, func() { vm.v128ExtMul(w, false, false) })
+		set(shape.mulHighU, func() { vm.v128ExtMul(w, true, false) })
+	}
+	set(ops.I16x8ExtaddPairwiseI8x16S, func() { vm.v128ExtAddPairwise(8, true) })
+	set(ops.I16x8ExtaddPairwiseI8x16U, func() { vm.v128ExtAddPairwise(8, false) })
+	set(ops.I32x4ExtaddPairwiseI16x8S, func() { vm.v128ExtAddPairwise(16, true) })
+	set(ops.I32x4ExtaddPairwiseI16x8U, func() { vm.v128ExtAddPairwise(16, false) })
+
+	// Floating-point arithmetic. abs and neg only change the sign bit, like
+	// the scalar operators.
+	unop(ops.F32x4Abs, 32, func(x uint64) uint64 { return x &^ f32SignBit })
+	unop(ops.F32x4Neg, 32, func(x uint64) uint64 { return x ^ f32SignBit })
+	unop(ops.F64x2Abs, 64, func(x uint64) uint64 { return x &^ f64SignBit })
+	unop(ops.F64x2Neg, 64, func(x uint64) uint64 { return x ^ f64SignBit })
+	f32Unop(ops.F32x4Sqrt, fpu.f32Sqrt)
+	f32Unop(ops.F32x4Ceil, fpu.f32Ceil)
+	f32Unop(ops.F32x4Floor, fpu.f32Floor)
+	f32Unop(ops.F32x4Trunc, fpu.f32Trunc)
+	f32Unop(ops.F32x4Nearest, fpu.f32Nearest)
+	f64Unop(ops.F64x2Sqrt, fpu.f64Sqrt)
+	f64Unop(ops.F64x2Ceil, fpu.f64Ceil)
+	f64Unop(ops.F64x2Floor, fpu.f64Floor)
+	f64Unop(ops.F64x2Trunc, fpu.f64Trunc)
+	f64Unop(ops.F64x2Nearest, fpu.f64Nearest)
+	f32Binop(ops.F32x4Add, func(fp fpu, a, b uint32) uint64 { return uint64(fp.f32Add(a, b)) })
+	f32Binop(ops.F32x4Sub, func(fp fpu, a, b uint32) uint64 { return uint64(fp.f32Sub(a, b)) })
+	f32Binop(ops.F32x4Mul, func(fp fpu, a, b uint32) uint64 { return uint64(fp.f32Mul(a, b)) })
+	f32Binop(ops.F32x4Div, func(fp fpu, a, b uint32) uint64 { return uint64(fp.f32Div(a, b)) })
+	f32Binop(ops.F32x4Min, func(fp fpu, a, b uint32) uint64 { return uint64(fp.f32Min(a, b)) })
+	f32Binop(ops.F32x4Max, func(fp fpu, a, b uint32) uint64 { return uint64(fp.f32Max(a, b)) })
+	f32Binop(ops.F32x4Pmin, func(fp fpu, a, b uint32) uint64 {
+		if fp.f32Lt(b, a) {
+			return uint64(b)
+		}
+		return uint64(a)
+	})
+	f32Binop(ops.F32x4Pmax, func(fp fpu, a, b uint32) uint64 {
+		if fp.f32Lt(a, b) {
+			return uint64(b)
+		}
+		return uint64(a)
+	})
+	f64Binop(ops.F64x2Add, func(fp fpu, a, b uint64) uint64 { return fp.f64Add(a, b) })
+	f64Binop(ops.F64x2Sub, func(fp fpu, a, b uint64) uint64 { return fp.f64Sub(a, b) })
+	f64Binop(ops.F64x2Mul, func(fp fpu, a, b uint64) uint64 { return fp.f64Mul(a, b) })
+	f64Binop(ops.F64x2Div, func(fp fpu, a, b uint64) uint64 { return fp.f64Div(a, b) })
+	f64Binop(ops.F64x2Min, func(fp fpu, a, b uint64) uint64 { return fp.f64Min(a, b) })
+	f64Binop(ops.F64x2Max, func(fp fpu, a, b uint64) uint64 { return fp.f64Max(a, b) })
+	f64Binop(ops.F64x2Pmin, func(fp fpu, a, b uint64) uint64 {
+		if fp.f64Lt(b, a) {
+			return b
+		}
+		return a
+	})
+	f64Binop(ops.F64x2Pmax, func(fp fpu, a, b uint64) uint64 {
+		if fp.f64Lt(a, b) {
+			return b
+		}
+		return a
+	})
+
+	// Conversions.
+	set(ops.F32x4DemoteF64x2Zero, func() {
+		fp := vm.fpu()
+		vm.v128Convert(64, 32, 0, 2, func(x uint64) uint64 { return uint64(fp.f32DemoteF64(x)) })
+	})
+	set(ops.F64x2PromoteLowF32x4, func() {
+		fp := vm.fpu()
+		vm.v128Convert(32, 64, 0, 2, func(x uint64) uint64 { return fp.f64PromoteF32(uint32(x)) })
+	})
+	set(ops.I32x4TruncSatF32x4S, func() {
+		vm.v128Convert(32, 32, 0, 4, func(x uint64) uint64 { return vm.truncSatF32(uint32(x), math.MaxInt32, 1<<31) })
+	})
+	set(ops.I32x4TruncSatF32x4U, func() {
+		vm.v128Convert(32, 32, 0, 4, func(x uint64) uint64 { return vm.truncSatF32(uint32(x), math.MaxUint32, 0) })
+	})
+	set(ops.I32x4TruncSatF64x2SZero, func() {
+		vm.v128Convert(64, 32, 0, 2, func(x uint64) uint64 { return vm.truncSatF64(x, math.MaxInt32, 1<<31) })
+	})
+	set(ops.I32x4TruncSatF64x2UZero, func() {
+		vm.v128Convert(64, 32, 0, 2, func(x uint64) uint64 { return vm.truncSatF64(x, math.MaxUint32, 0) })
+	})
+	set(ops.F32x4ConvertI32x4S, func() {
+		fp := vm.fpu()
+		vm.v128Convert(32, 32, 0, 4, func(x uint64) uint64 { return uint64(fp.f32FromInt(signMagnitude(signExtend(x, 32)))) })
+	})
+	set(ops.F32x4ConvertI32x4U, func() {
+		fp := vm.fpu()
+		vm.v128Convert(32, 32, 0, 4, func(x uint64) uint64 { return uint64(fp.f32FromInt(false, x)) })
+	})
+	set(ops.F64x2ConvertLowI32x4S, func() {
+		fp := vm.fpu()
+		vm.v128Convert(32, 64, 0, 2, func(x uint64) uint64 { return fp.f64FromInt(signMagnitude(signExtend(x, 32))) })
+	})
+	set(ops.F64x2ConvertLowI32x4U, func() {
+		fp := vm.fpu()
+		vm.v128Convert(32, 64, 0, 2, func(x uint64) uint64 { return fp.f64FromInt(false, x) })
+	})
+}
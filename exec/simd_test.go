@@ -0,0 +1,177 @@
+// Copyright 2020 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/ontio/wagon/wasm/leb128"
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// moduleSIMD has a one page memory, an immutable v128 global holding the
+// i32x4 lanes 1 2 3 4 and an immutable i32 global holding 7. Functions 0 to
+// 5 are:
+//
+//	add(a, b v128) v128       i32x4.add
+//	sel(a, b v128, c i32) v128 select
+//	drop(a v128, x i32) i32   x, after dropping a
+//	lane(x i32) i32           lane 3 of (i32x4.splat x) + global 0, plus global 1
+//	mem(a i32) i64            stores bytes 0 to 15 at a, and reloads bytes 8 to 15
+//	div(a, b v128) v128       f32x4.div
+var moduleSIMD = moduleBytes(
+	section(0x01, 0x05,
+		0x60, 0x02, 0x7b, 0x7b, 0x01, 0x7b,
+		0x60, 0x03, 0x7b, 0x7b, 0x7f, 0x01, 0x7b,
+		0x60, 0x02, 0x7b, 0x7f, 0x01, 0x7f,
+		0x60, 0x01, 0x7f, 0x01, 0x7f,
+		0x60, 0x01, 0x7f, 0x01, 0x7e),
+	section(0x03, 0x06, 0x00, 0x01, 0x02, 0x03, 0x04, 0x00),
+	section(0x05, 0x01, 0x00, 0x01),
+	section(0x06, append(append([]byte{0x02, 0x7b, 0x00, 0xfd, 0x0c},
+		simdI32x4(1, 2, 3, 4)...),
+		0x0b, 0x7f, 0x00, 0x41, 0x07, 0x0b)...),
+	section(0x0a, bytes.Join([][]byte{
+		{0x06},
+		funcBody(0x20, 0x00, 0x20, 0x01, 0xfd, 0xae, 0x01),
+		funcBody(0x20, 0x00, 0x20, 0x01, 0x20, 0x02, 0x1b),
+		funcBody(0x20, 0x01, 0x20, 0x00, 0x1a),
+		{0x1c, 0x01, 0x01, 0x7b,
+			0x20, 0x00, 0xfd, 0x11, 0x21, 0x01,
+			0x20, 0x01, 0x23, 0x00, 0xfd, 0xae, 0x01, 0x22, 0x01, 0x1a,
+			0x20, 0x01, 0xfd, 0x1b, 0x03, 0x23, 0x01, 0x6a, 0x0b},
+		funcBody(append(append([]byte{0x20, 0x00, 0xfd, 0x0c},
+			0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07,
+			0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f),
+			0xfd, 0x0b, 0x04, 0x00,
+			0x20, 0x00, 0xfd, 0x5d, 0x03, 0x08, 0xfd, 0x1d, 0x00)...),
+		funcBody(0x20, 0x00, 0x20, 0x01, 0xfd, 0xe7, 0x01),
+	}, nil)...),
+)
+
+// simdI32x4 returns the encoding of a v128 value with the given i32 lanes.
+func simdI32x4(lanes ...uint32) []byte {
+	var v v128
+	for i, l := range lanes {
+		v.setLane(32, i, uint64(l))
+	}
+	b := simdBytes(v)
+	return b[:]
+}
+
+func simdBytes(v v128) [16]byte {
+	var b [16]byte
+	endianess.PutUint64(b[:8], v[0])
+	endianess.PutUint64(b[8:], v[1])
+	return b
+}
+
+// simdLanes returns a v128 value with the given lanes of the given width.
+// Missing lanes are zero.
+func simdLanes(width int, lanes ...uint64) v128 {
+	var v v128
+	for i, l := range lanes {
+		v.setLane(width, i, l)
+	}
+	return v
+}
+
+func compileSIMD(t *testing.T) *CompiledModule {
+	return compileTestModuleWithFeatures(t, moduleSIMD, nil, ops.FeatureSIMD)
+}
+
+func TestSIMDDisabled(t *testing.T) {
+	_, err := CompileModuleWithFeatures(readTestModule(t, moduleSIMD, nil), nil, ops.FeatureBulkMemory)
+	var disabled ops.DisabledPrefixedOpcodeError
+	if !errors.As(err, &disabled) {
+		t.Fatalf("got error %v, want a DisabledPrefixedOpcodeError", err)
+	}
+}
+
+func TestSIMD(t *testing.T) {
+	vm := newTestVM(t, compileSIMD(t))
+	a := simdLanes(32, 1, 2, 3, 4)
+	b := simdLanes(32, 10, 20, 30, 40)
+
+	for _, tt := range []struct {
+		fn   int64
+		args []uint64
+		want interface{}
+	}{
+		{0, []uint64{a[0], a[1], b[0], b[1]}, simdBytes(simdLanes(32, 11, 22, 33, 44))},
+		{1, []uint64{a[0], a[1], b[0], b[1], 1}, simdBytes(a)},
+		{1, []uint64{a[0], a[1], b[0], b[1], 0}, simdBytes(b)},
+		{2, []uint64{a[0], a[1], 5}, uint32(5)},
+		{3, []uint64{10}, uint32(10 + 4 + 7)},
+		{4, []uint64{100}, uint64(0x0f0e0d0c0b0a0908)},
+	} {
+		if got := mustExec(t, vm, tt.fn, tt.args...); got != tt.want {
+			t.Errorf("function %d%v = %v, want %v", tt.fn, tt.args, got, tt.want)
+		}
+	}
+
+	if v, ok := vm.GetGlobal(1); !ok || v != 7 {
+		t.Errorf("GetGlobal(1) = %d, %v, want 7, true", v, ok)
+	}
+
+	_, err := vm.ExecCode(4, uint64(wasmPageSize-8))
+	checkTrap(t, err, TrapMemoryOutOfBounds)
+}
+
+func TestSIMDNaN(t *testing.T) {
+	// 0/0 and inf-inf produce the canonical NaN in both float modes, and
+	// the other lanes are computed exactly.
+	inf := uint64(math.Float32bits(float32(math.Inf(1))))
+	a := simdLanes(32, 0, inf, f32Bits(3), f32Bits(-1))
+	b := simdLanes(32, 0, inf, f32Bits(2), f32Bits(4))
+	want := simdBytes(simdLanes(32, 0x7fc00000, 0x7fc00000, f32Bits(1.5), f32Bits(-0.25)))
+	for _, mode := range []FloatMode{FloatSoft, FloatNative} {
+		vm := newTestVM(t, compileSIMD(t))
+		vm.FloatMode = mode
+		if got := mustExec(t, vm, 5, a[0], a[1], b[0], b[1]); got != want {
+			t.Errorf("%v: f32x4.div = %x, want %x", mode, got, want)
+		}
+	}
+}
+
+func TestSIMDOps(t *testing.T) {
+	bytes16 := simdLanes(8, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15)
+	for _, tt := range []struct {
+		name string
+		sub  uint32
+		a, b v128
+		want v128
+	}{
+		{"i8x16.add_sat_s", ops.I8x16AddSatS, simdLanes(8, 100, 0x9c), simdLanes(8, 100, 0x9c), simdLanes(8, 0x7f, 0x80)},
+		{"i8x16.sub_sat_u", ops.I8x16SubSatU, simdLanes(8, 5, 10), simdLanes(8, 10, 5), simdLanes(8, 0, 5)},
+		{"i8x16.swizzle", ops.I8x16Swizzle, bytes16, simdLanes(8, 15, 14, 0x20), simdLanes(8, 15, 14)},
+		{"i16x8.q15mulr_sat_s", ops.I16x8Q15mulrSatS, simdLanes(16, 0x8000, 0x4000), simdLanes(16, 0x8000, 0x4000), simdLanes(16, 0x7fff, 0x2000)},
+		{"i16x8.narrow_i32x4_u", ops.I16x8NarrowI32x4U, simdLanes(32, 0xffffffff, 70000, 5), simdLanes(32, 1, 2, 3, 4), simdLanes(16, 0, 0xffff, 5, 0, 1, 2, 3, 4)},
+		{"i32x4.dot_i16x8_s", ops.I32x4DotI16x8S, simdLanes(16, 2, 2, 0xffff, 1), simdLanes(16, 3, 3, 3, 3), simdLanes(32, 12, 0)},
+		{"i32x4.min_s", ops.I32x4MinS, simdLanes(32, 0xffffffff, 1), simdLanes(32, 1, 0xffffffff), simdLanes(32, 0xffffffff, 0xffffffff)},
+		{"i64x2.mul", ops.I64x2Mul, simdLanes(64, 3, 1<<32), simdLanes(64, 5, 1<<32), simdLanes(64, 15, 0)},
+		{"i64x2.extmul_low_i32x4_s", ops.I64x2ExtmulLowI32x4S, simdLanes(32, 0xfffffffe, 3), simdLanes(32, 5, 7), simdLanes(64, 0xfffffffffffffff6, 21)},
+		{"i64x2.lt_s", ops.I64x2LtS, simdLanes(64, 0xffffffffffffffff, 1), simdLanes(64, 0, 0), simdLanes(64, 0xffffffffffffffff, 0)},
+		{"f32x4.pmin", ops.F32x4Pmin, simdLanes(32, f32Bits(1), f32Bits(2)), simdLanes(32, f32Bits(4), f32Bits(-3)), simdLanes(32, f32Bits(1), f32Bits(-3))},
+		{"f64x2.max", ops.F64x2Max, simdLanes(64, f64Bits(1), f64Bits(-2)), simdLanes(64, f64Bits(4), f64Bits(-3)), simdLanes(64, f64Bits(4), f64Bits(-2))},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			code := bytes.NewBuffer([]byte{0x20, 0x00, 0x20, 0x01, 0xfd})
+			leb128.WriteVarUint32(code, tt.sub)
+			vm := newTestVM(t, compileTestModuleWithFeatures(t, moduleBytes(
+				section(0x01, 0x01, 0x60, 0x02, 0x7b, 0x7b, 0x01, 0x7b),
+				section(0x03, 0x01, 0x00),
+				section(0x0a, append([]byte{0x01}, funcBody(code.Bytes()...)...)...),
+			), nil, ops.FeatureSIMD))
+			got := mustExec(t, vm, 0, tt.a[0], tt.a[1], tt.b[0], tt.b[1])
+			if want := simdBytes(tt.want); got != want {
+				t.Errorf("got %x, want %x", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ontio/wagon/wasm"
+)
+
+// ErrInvalidSnapshot is returned by (*VM).Restore when a snapshot does not
+// match the module of the VM.
+var ErrInvalidSnapshot = errors.New("exec: snapshot does not match the module")
+
+// Frame is the state of a function activation.
+type Frame struct {
+	Func   int64    // Index of the function in the function index space
+	PC     int64    // Offset of the next instruction in the compiled code
+	Locals []uint64 // Values of the parameters and local variables
+	Stack  []uint64 // Operand stack
+}
+
+// GasState is the state of the gas counters of a VM.
+type GasState struct {
+	GasPrice        uint64
+	GasLimit        uint64
+	LocalGasCounter uint64
+	GasFactor       uint64
+	ExecStep        uint64
+	// UnpaidGas is the gas the suspended execution must pay before it
+	// can be resumed.
+	UnpaidGas uint64
+}
+
+// Snapshot is the state of a VM. All its fields are exported, so that it can
+// be serialized with encoding/gob, encoding/json or a similar package.
+//
+// The program counters refer to the compiled code, so a snapshot can only
+// be restored to a VM running the same module.
+type Snapshot struct {
+	// Frames is the call stack of a suspended execution, starting with
+	// the function ExecCode was called with and ending with the function
+	// that was executing. It is empty if no execution was suspended.
+	Frames []Frame
+	// Entry is the index of the function ExecCode was called with.
+	Entry int64
+	// PendingResults is the number of values Resume must be given.
+	PendingResults int
+
+	Globals        []uint64
+	Memory         []byte
+	CallStackDepth uint32
+	FloatMode      FloatMode
+	Gas            *GasState // nil if the VM has no ExecMetrics
+
+	// Tables holds the contents of the tables, if they were modified by
+	// a table operator such as table.init or table.grow. It is nil
+	// otherwise.
+	Tables [][]wasm.TableEntry
+	// DroppedData and DroppedElems are the indices of the passive data
+	// and element segments dropped by data.drop and elem.drop.
+	DroppedData  []uint32
+	DroppedElems []uint32
+	// Externs holds the values referred to by the externref values
+	// returned by (*Process).NewExternRef, in order. They are copied as
+	// is, so they must be serializable by the package used to serialize
+	// the snapshot, e.g. registered with gob.Register.
+	Externs []interface{}
+}
+
+// Snapshot returns a copy of the state of vm. The snapshot does not share
+// memory with vm.
+func (vm *VM) Snapshot() *Snapshot {
+	s := &Snapshot{
+		Entry:          vm.entry,
+		PendingResults: vm.pendingResults,
+		Globals:        append([]uint64(nil), vm.globals...),
+		Memory:         append([]byte(nil), vm.memory...),
+		CallStackDepth: vm.CallStackDepth,
+		FloatMode:      vm.FloatMode,
+		Externs:        append([]interface{}(nil), vm.externs...),
+	}
+	if vm.ownTables {
+		for _, t := range vm.tables {
+			s.Tables = append(s.Tables, append([]wasm.TableEntry(nil), t...))
+		}
+	}
+	for i, seg := range dataSegments(vm.module) {
+		if seg != nil && vm.data[i] == nil {
+			s.DroppedData = append(s.DroppedData, uint32(i))
+		}
+	}
+	if vm.module.Elements != nil {
+		for i, entry := range vm.module.Elements.Entries {
+			if entry.Passive && vm.elems[i] == nil {
+				s.DroppedElems = append(s.DroppedElems, uint32(i))
+			}
+		}
+	}
+	if vm.suspended {
+		frames := append(append([]context(nil), vm.frames...), vm.ctx)
+		for _, ctx := range frames {
+			s.Frames = append(s.Frames, Frame{
+				Func:   ctx.curFunc,
+				PC:     ctx.pc,
+				Locals: append([]uint64(nil), ctx.locals...),
+				Stack:  append([]uint64(nil), ctx.stack...),
+			})
+		}
+	}
+	if m := vm.ExecMetrics; m != nil {
+		s.Gas = &GasState{
+			GasPrice:        m.GasPrice,
+			LocalGasCounter: m.LocalGasCounter,
+			GasFactor:       m.GasFactor,
+			UnpaidGas:       vm.unpaidGas,
+		}
+		if m.GasLimit != nil {
+			s.Gas.GasLimit = *m.GasLimit
+		}
+		if m.ExecStep != nil {
+			s.Gas.ExecStep = *m.ExecStep
+		}
+	}
+	return s
+}
+
+// Restore replaces the state of vm with the one recorded in s. If s holds a
+// suspended execution, it can then be continued with Resume. vm must have
+// been created from the same module as the VM the snapshot was taken from.
+//
+// If s records gas counters, vm.ExecMetrics is replaced with new counters
+// holding their values.
+func (vm *VM) Restore(s *Snapshot) error {
+	if len(s.Globals) != len(vm.globals) {
+		return ErrInvalidSnapshot
+	}
+	if uint64(len(s.Memory)) > vm.MemoryLimitation || len(s.Memory)%wasmPageSize != 0 {
+		return fmt.Errorf("exec: invalid snapshot memory size %d", len(s.Memory))
+	}
+	if s.FloatMode != FloatSoft && s.FloatMode != FloatNative {
+		return fmt.Errorf("exec: invalid snapshot float mode %d", s.FloatMode)
+	}
+	if s.Gas != nil && s.Gas.GasFactor == 0 {
+		return errors.New("exec: invalid snapshot gas factor 0")
+	}
+	slots := uint64(0)
+	for i, f := range s.Frames {
+		if f.Func < 0 || f.Func >= int64(len(vm.funcs)) {
+			return ErrInvalidSnapshot
+		}
+		compiled, ok := vm.funcs[f.Func].(compiledFunction)
+		if !ok || !compiled.isInstr(f.PC) ||
+			len(f.Locals) != compiled.totalLocalVars || len(f.Stack) > compiled.maxDepth+1 {
+			return ErrInvalidSnapshot
+		}
+		if i == 0 && f.Func != s.Entry {
+			return ErrInvalidSnapshot
+		}
+		slots += uint64(compiled.totalLocalVars + compiled.maxDepth + 1)
+	}
+	if vm.MaxArenaSlots != 0 && slots > vm.MaxArenaSlots {
+		return ErrArenaSlotsExceed
+	}
+	if s.Tables != nil {
+		if len(s.Tables) != len(vm.module.TableIndexSpace) {
+			return ErrInvalidSnapshot
+		}
+		// Tables may have been grown by table.grow.
+		for i, t := range s.Tables {
+			if n := len(vm.module.TableIndexSpace[i]); len(t) < n || (len(t) > n && len(t) > wasm.MaxTableSize) {
+				return ErrInvalidSnapshot
+			}
+			if vm.module.GetTable(i).ElementType != wasm.ElemTypeAnyFunc {
+				continue
+			}
+			for _, e := range t {
+				if e.Initialized && int(e.Index) >= len(vm.funcs) {
+					return ErrInvalidSnapshot
+				}
+			}
+		}
+	}
+	data := dataSegments(vm.module)
+	elems, err := elemSegments(vm.module)
+	if err != nil {
+		return err
+	}
+	for _, i := range s.DroppedData {
+		if int(i) >= len(data) {
+			return ErrInvalidSnapshot
+		}
+		data[i] = nil
+	}
+	for _, i := range s.DroppedElems {
+		if int(i) >= len(elems) {
+			return ErrInvalidSnapshot
+		}
+		elems[i] = nil
+	}
+
+	vm.unwind()
+	copy(vm.globals, s.Globals)
+	vm.memory = append(vm.memory[:0], s.Memory...)
+	vm.CallStackDepth = s.CallStackDepth
+	vm.FloatMode = s.FloatMode
+	vm.tables, vm.ownTables = vm.module.TableIndexSpace, false
+	if s.Tables != nil {
+		vm.tables = nil
+		for _, t := range s.Tables {
+			vm.tables = append(vm.tables, append([]wasm.TableEntry(nil), t...))
+		}
+		vm.ownTables = true
+	}
+	vm.data, vm.elems = data, elems
+	vm.externs = append([]interface{}(nil), s.Externs...)
+	vm.entry = s.Entry
+	base := 0
+	for i, f := range s.Frames {
+		if i != 0 {
+			vm.frames = append(vm.frames, vm.ctx)
+			base = vm.calleeBase()
+		}
+		vm.ctx = vm.newContext(vm.funcs[f.Func].(compiledFunction), f.Func, base)
+		vm.ctx.pc = f.PC
+		copy(vm.ctx.locals, f.Locals)
+		vm.ctx.stack = append(vm.ctx.stack, f.Stack...)
+	}
+	if len(s.Frames) != 0 {
+		vm.suspended = true
+		vm.pendingResults = s.PendingResults
+	}
+	if g := s.Gas; g != nil {
+		gasLimit, execStep := g.GasLimit, g.ExecStep
+		vm.ExecMetrics = &Gas{
+			GasPrice:        g.GasPrice,
+			GasLimit:        &gasLimit,
+			LocalGasCounter: g.LocalGasCounter,
+			GasFactor:       g.GasFactor,
+			ExecStep:        &execStep,
+		}
+		if vm.suspended {
+			vm.unpaidGas = g.UnpaidGas
+		}
+	}
+	return nil
+}
+
+// isInstr reports whether pc is the offset of an instruction of fn, or the
+// end of its code.
+func (fn compiledFunction) isInstr(pc int64) bool {
+	if pc == int64(len(fn.code)) {
+		return true
+	}
+	i := sort.Search(len(fn.instrs), func(i int) bool { return fn.instrs[i] >= pc })
+	return i < len(fn.instrs) && fn.instrs[i] == pc
+}
@@ -0,0 +1,185 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/ontio/wagon/wasm"
+)
+
+// moduleSum defines sum(n i32) i32, which adds double(i) for i from n down
+// to 1, and double(x i32) i32, which returns x + x.
+var moduleSum = moduleBytes(
+	section(0x01, 0x01, 0x60, 0x01, 0x7f, 0x01, 0x7f),
+	section(0x03, 0x02, 0x00, 0x00),
+	section(0x0a, 0x02,
+		0x23, 0x01, 0x01, 0x7f,
+		0x02, 0x40, 0x03, 0x40,
+		0x20, 0x00, 0x45, 0x0d, 0x01,
+		0x20, 0x01, 0x20, 0x00, 0x10, 0x01, 0x6a, 0x21, 0x01,
+		0x20, 0x00, 0x41, 0x01, 0x6b, 0x21, 0x00,
+		0x0c, 0x00, 0x0b, 0x0b,
+		0x20, 0x01, 0x0b,
+		0x07, 0x00, 0x20, 0x00, 0x20, 0x00, 0x6a, 0x0b),
+)
+
+func TestResumeAfterGasExhaustion(t *testing.T) {
+	compiled := compileTestModule(t, moduleSum, nil)
+
+	vm := newTestVM(t, compiled)
+	*vm.ExecMetrics.ExecStep = 7
+	res, err := vm.ExecCode(0, 100)
+	suspensions, nested := 0, false
+	for err != nil {
+		if !vm.Suspended() {
+			t.Fatalf("execution failed without being suspended: %v", err)
+		}
+		suspensions++
+
+		// Move the execution to a new VM through a serialized snapshot.
+		buf, jsonErr := json.Marshal(vm.Snapshot())
+		if jsonErr != nil {
+			t.Fatal(jsonErr)
+		}
+		var s Snapshot
+		if err := json.Unmarshal(buf, &s); err != nil {
+			t.Fatal(err)
+		}
+		if len(s.Frames) > 1 {
+			nested = true
+		}
+		vm = newTestVM(t, compiled)
+		if err := vm.Restore(&s); err != nil {
+			t.Fatalf("could not restore snapshot: %v", err)
+		}
+		if restored, _ := json.Marshal(vm.Snapshot()); !bytes.Equal(restored, buf) {
+			t.Fatalf("restored state differs from snapshot:\n%s\n%s", restored, buf)
+		}
+
+		*vm.ExecMetrics.ExecStep = 7
+		res, err = vm.Resume()
+	}
+	if res != uint32(10100) {
+		t.Errorf("sum(100) = %v, want 10100", res)
+	}
+	if suspensions == 0 || !nested {
+		t.Errorf("execution was suspended %d times, nested=%v", suspensions, nested)
+	}
+	if vm.CallStackDepth != math.MaxUint32 {
+		t.Errorf("CallStackDepth = %d after execution, want %d", vm.CallStackDepth, uint32(math.MaxUint32))
+	}
+	if _, err := vm.Resume(); err != ErrNotSuspended {
+		t.Errorf("Resume on a finished execution returned %v", err)
+	}
+}
+
+func TestRestoreInvalidSnapshot(t *testing.T) {
+	compiled := compileTestModule(t, moduleSum, nil)
+	vm := newTestVM(t, compiled)
+	*vm.ExecMetrics.ExecStep = 7
+	vm.FloatMode = FloatNative
+	if _, err := vm.ExecCode(0, 100); err == nil || !vm.Suspended() {
+		t.Fatalf("got error %v, want a suspended execution", err)
+	}
+	s := vm.Snapshot()
+
+	restored := newTestVM(t, compiled)
+	if err := restored.Restore(s); err != nil {
+		t.Fatalf("could not restore snapshot: %v", err)
+	}
+	if restored.FloatMode != FloatNative {
+		t.Errorf("restored FloatMode = %v, want %v", restored.FloatMode, FloatNative)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		modify func(s *Snapshot)
+	}{
+		{"pc inside an instruction", func(s *Snapshot) { s.Frames[len(s.Frames)-1].PC++ }},
+		{"pc past the code", func(s *Snapshot) { s.Frames[0].PC = 1 << 20 }},
+		{"gas factor", func(s *Snapshot) { s.Gas.GasFactor = 0 }},
+		{"float mode", func(s *Snapshot) { s.FloatMode = FloatNative + 1 }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			invalid := vm.Snapshot()
+			tc.modify(invalid)
+			if err := newTestVM(t, compiled).Restore(invalid); err == nil {
+				t.Error("restored an invalid snapshot")
+			}
+		})
+	}
+}
+
+// modulePause defines f() i32, which returns the result of env.ask plus one.
+var modulePause = moduleBytes(
+	section(0x01, 0x01, 0x60, 0x00, 0x01, 0x7f),
+	section(0x02, 0x01, 0x03, 'e', 'n', 'v', 0x03, 'a', 's', 'k', 0x00, 0x00),
+	section(0x03, 0x01, 0x00),
+	section(0x0a, 0x01, 0x07, 0x00, 0x10, 0x00, 0x41, 0x01, 0x6a, 0x0b),
+)
+
+func ask(proc *Process) int32 {
+	proc.Pause()
+	return 0
+}
+
+func askImporter(name string) (*wasm.Module, error) {
+	m := wasm.NewModule()
+	m.Types = &wasm.SectionTypes{
+		Entries: []wasm.FunctionSig{
+			{
+				Form:        0,
+				ReturnTypes: []wasm.ValueType{wasm.ValueTypeI32},
+			},
+		},
+	}
+	m.FunctionIndexSpace = []wasm.Function{
+		{
+			Sig:  &m.Types.Entries[0],
+			Host: reflect.ValueOf(ask),
+			Body: &wasm.FunctionBody{},
+		},
+	}
+	m.Export = &wasm.SectionExports{
+		Entries: map[string]wasm.ExportEntry{
+			"ask": {
+				FieldStr: "ask",
+				Kind:     wasm.ExternalFunction,
+				Index:    0,
+			},
+		},
+	}
+	return m, nil
+}
+
+func TestResumeAfterPause(t *testing.T) {
+	compiled, err := CompileModule(readTestModule(t, modulePause, askImporter), nil)
+	if err != nil {
+		t.Fatalf("could not compile module: %v", err)
+	}
+	vm := newTestVM(t, compiled)
+
+	if _, err := vm.ExecCode(1); err != ErrPaused {
+		t.Fatalf("got error %v, want %v", err, ErrPaused)
+	}
+	if s := vm.Snapshot(); s.PendingResults != 1 || len(s.Frames) != 1 {
+		t.Fatalf("snapshot has %d pending results and %d frames, want 1 and 1", s.PendingResults, len(s.Frames))
+	}
+	if _, err := vm.Resume(); err != ErrInvalidArgumentCount {
+		t.Fatalf("got error %v, want %v", err, ErrInvalidArgumentCount)
+	}
+	res, err := vm.Resume(41)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != uint32(42) {
+		t.Errorf("f() = %v, want 42", res)
+	}
+}
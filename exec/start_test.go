@@ -0,0 +1,101 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/ontio/wagon/wasm"
+)
+
+// moduleStart returns a module with a mutable i32 global, holding 0, and
+// the functions set() (setting the global to 42), get() i32 and arg(i32).
+// Its start function is the given one.
+func moduleStart(start byte) []byte {
+	return moduleBytes(
+		section(0x01, 0x03, 0x60, 0x00, 0x00, 0x60, 0x00, 0x01, 0x7f, 0x60, 0x01, 0x7f, 0x00),
+		section(0x03, 0x03, 0x00, 0x01, 0x02),
+		section(0x06, 0x01, 0x7f, 0x01, 0x41, 0x00, 0x0b),
+		section(0x08, start),
+		section(0x0a, bytes.Join([][]byte{
+			{0x03},
+			funcBody(0x41, 0x2a, 0x24, 0x00),
+			funcBody(0x23, 0x00),
+			funcBody(),
+		}, nil)...),
+	)
+}
+
+func readStart(t *testing.T, start byte) *wasm.Module {
+	t.Helper()
+	m, err := wasm.ReadModuleWithPolicy(bytes.NewReader(moduleStart(start)), nil, wasm.Policy{AllowStart: true})
+	if err != nil {
+		t.Fatalf("could not read module: %v", err)
+	}
+	return m
+}
+
+func TestStart(t *testing.T) {
+	m := readStart(t, 0)
+	if _, err := CompileModule(m, nil); err != wasm.ErrStartNotAllowed {
+		t.Fatalf("CompileModule: got error %v, want %v", err, wasm.ErrStartNotAllowed)
+	}
+	compiled, err := CompileModuleWithPolicy(m, nil, 0, wasm.Policy{AllowStart: true})
+	if err != nil {
+		t.Fatalf("could not compile module: %v", err)
+	}
+	if _, err := NewVMWithCompiled(compiled, math.MaxUint64); err != ErrStartPending {
+		t.Fatalf("NewVMWithCompiled: got error %v, want %v", err, ErrStartPending)
+	}
+
+	metrics := testGas(math.MaxUint64)
+	vm, err := NewVMWithLimits(compiled, math.MaxUint64, metrics, 20, 10)
+	if err != nil {
+		t.Fatalf("NewVMWithLimits: %v", err)
+	}
+	// i32.const, set_global and the nop appended by the compiler.
+	if got := math.MaxUint64 - *metrics.ExecStep; got != 3 {
+		t.Errorf("start function charged %d, want 3", got)
+	}
+	if vm.ExecMetrics != metrics || vm.CallStackDepth != 20 || vm.FrameLimit != 10 || vm.RecoverPanic {
+		t.Errorf("VM has metrics %v, call stack depth %d, frame limit %d and RecoverPanic %v",
+			vm.ExecMetrics, vm.CallStackDepth, vm.FrameLimit, vm.RecoverPanic)
+	}
+	if got := mustExec(t, vm, 1); got != uint32(42) {
+		t.Errorf("get() = %v, want 42", got)
+	}
+
+	vm, err = NewVMWithLimits(compiled, math.MaxUint64, testGas(1), 20, 10)
+	var trap *Trap
+	if !errors.As(err, &trap) || trap.Code != TrapOutOfGas || !errors.Is(err, ErrExecStepExhausted) || vm != nil {
+		t.Errorf("NewVMWithLimits with one step: got VM %v, error %v, want an out of gas trap", vm, err)
+	}
+}
+
+func TestNewVMWithPolicy(t *testing.T) {
+	m := readStart(t, 0)
+	if _, err := NewVMWithPolicy(m, math.MaxUint64, wasm.Policy{}, testGas(math.MaxUint64), 0, 0); err != wasm.ErrStartNotAllowed {
+		t.Fatalf("got error %v, want %v", err, wasm.ErrStartNotAllowed)
+	}
+	vm, err := NewVMWithPolicy(m, math.MaxUint64, wasm.Policy{AllowStart: true}, testGas(math.MaxUint64), 0, 0)
+	if err != nil {
+		t.Fatalf("NewVMWithPolicy: %v", err)
+	}
+	if got := mustExec(t, vm, 1); got != uint32(42) {
+		t.Errorf("get() = %v, want 42", got)
+	}
+}
+
+func TestStartInvalid(t *testing.T) {
+	for _, start := range []byte{1, 2} {
+		_, err := CompileModuleWithPolicy(readStart(t, start), nil, 0, wasm.Policy{AllowStart: true})
+		if err != ErrInvalidStartFunction {
+			t.Errorf("start function %d: got error %v, want %v", start, err, ErrInvalidStartFunction)
+		}
+	}
+}
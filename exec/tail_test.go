@@ -0,0 +1,95 @@
+// Copyright 2020 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// moduleTail has a table holding function 1. Functions 0 to 2 are:
+//
+//	sum(n, acc) i64          return_call sum (n-1, acc+n), acc if n is 0
+//	sumIndirect(n, acc) i64  return_call_indirect 0 (n-1, acc+n)
+//	sumPlusOne(n) i64        (call sum (n, 0)) + 1
+var moduleTail = moduleBytes(
+	section(0x01, 0x02,
+		0x60, 0x02, 0x7e, 0x7e, 0x01, 0x7e,
+		0x60, 0x01, 0x7e, 0x01, 0x7e),
+	section(0x03, 0x03, 0x00, 0x00, 0x01),
+	section(0x04, 0x01, 0x70, 0x00, 0x01),
+	section(0x09, 0x01, 0x00, 0x41, 0x00, 0x0b, 0x01, 0x01),
+	section(0x0a, bytes.Join([][]byte{
+		{0x03},
+		funcBody(0x20, 0x00, 0x50, 0x04, 0x40, 0x20, 0x01, 0x0f, 0x0b,
+			0x20, 0x00, 0x42, 0x01, 0x7d, 0x20, 0x00, 0x20, 0x01, 0x7c,
+			0x12, 0x00),
+		funcBody(0x20, 0x00, 0x50, 0x04, 0x40, 0x20, 0x01, 0x0f, 0x0b,
+			0x20, 0x00, 0x42, 0x01, 0x7d, 0x20, 0x00, 0x20, 0x01, 0x7c,
+			0x41, 0x00, 0x13, 0x00, 0x00),
+		funcBody(0x20, 0x00, 0x42, 0x00, 0x10, 0x00, 0x42, 0x01, 0x7c),
+	}, nil)...),
+)
+
+func TestTailCallDisabled(t *testing.T) {
+	_, err := CompileModuleWithFeatures(readTestModule(t, moduleTail, nil), nil, ops.FeatureReferenceTypes)
+	var disabled ops.DisabledOpcodeError
+	if !errors.As(err, &disabled) {
+		t.Fatalf("got error %v, want a DisabledOpcodeError", err)
+	}
+}
+
+func TestTailCall(t *testing.T) {
+	vm := newTestVM(t, compileTestModuleWithFeatures(t, moduleTail, nil, ops.FeatureTailCall))
+	// Tail calls reuse the frame of the caller, so the recursion is not
+	// bounded by the frame limit.
+	vm.FrameLimit = 4
+
+	const n = 10000
+	for _, tt := range []struct {
+		fn   int64
+		args []uint64
+		want uint64
+	}{
+		{0, []uint64{0, 7}, 7},
+		{0, []uint64{n, 0}, n * (n + 1) / 2},
+		{1, []uint64{n, 0}, n * (n + 1) / 2},
+		{2, []uint64{n}, n*(n+1)/2 + 1},
+	} {
+		if got := mustExec(t, vm, tt.fn, tt.args...); got != tt.want {
+			t.Errorf("function %d%v = %v, want %d", tt.fn, tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestTailCallHostPause(t *testing.T) {
+	// f() i32 tail calls env.ask, which pauses the execution.
+	module := moduleBytes(
+		section(0x01, 0x01, 0x60, 0x00, 0x01, 0x7f),
+		section(0x02, 0x01, 0x03, 'e', 'n', 'v', 0x03, 'a', 's', 'k', 0x00, 0x00),
+		section(0x03, 0x01, 0x00),
+		section(0x0a, 0x01, 0x04, 0x00, 0x12, 0x00, 0x0b),
+	)
+	compiled, err := CompileModuleWithFeatures(readTestModule(t, module, askImporter), nil, ops.FeatureTailCall)
+	if err != nil {
+		t.Fatalf("could not compile module: %v", err)
+	}
+	vm := newTestVM(t, compiled)
+	if _, err := vm.ExecCode(1); err != ErrPaused {
+		t.Fatalf("got error %v, want %v", err, ErrPaused)
+	}
+
+	// The execution resumes after the host call, in a restored VM.
+	restored := newTestVM(t, compiled)
+	if err := restored.Restore(vm.Snapshot()); err != nil {
+		t.Fatalf("could not restore snapshot: %v", err)
+	}
+	if got, err := restored.Resume(41); err != nil || got != uint32(41) {
+		t.Errorf("f() = %v, %v, want 41", got, err)
+	}
+}
@@ -0,0 +1,314 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ontio/wagon/wasm"
+)
+
+// TrapCode identifies the cause of a Trap.
+type TrapCode uint8
+
+const (
+	// TrapInternal is used when the VM panicked for a reason that is not
+	// a WebAssembly trap, which usually means the module is invalid.
+	TrapInternal TrapCode = iota
+	TrapUnreachable
+	TrapMemoryOutOfBounds
+	TrapIntegerDivideByZero
+	TrapIntegerOverflow
+	TrapInvalidConversionToInteger
+	TrapUndefinedElement
+	TrapUninitializedElement
+	TrapIndirectCallTypeMismatch
+	TrapCallStackExhausted
+	// TrapOutOfGas is used when the execution exceeds its gas or step
+	// limit. The execution is suspended and can be resumed.
+	TrapOutOfGas
+	// TrapHostFunction is used when a host function panicked. If the
+	// host function panicked with a *Trap, for instance from a nested
+	// execution, it is available through errors.As.
+	TrapHostFunction
+	// TrapCanceled is used when the context given to ExecCodeContext or
+	// ResumeContext is done. The execution is suspended and can be resumed.
+	TrapCanceled
+	TrapTableOutOfBounds
+)
+
+var trapCodeNames = [...]string{
+	TrapInternal:                   "internal error",
+	TrapUnreachable:                "unreachable",
+	TrapMemoryOutOfBounds:          "out of bounds memory access",
+	TrapIntegerDivideByZero:        "integer divide by zero",
+	TrapIntegerOverflow:            "integer overflow",
+	TrapInvalidConversionToInteger: "invalid conversion to integer",
+	TrapUndefinedElement:           "undefined element",
+	TrapUninitializedElement:       "uninitialized element",
+	TrapIndirectCallTypeMismatch:   "indirect call type mismatch",
+	TrapCallStackExhausted:         "call stack exhausted",
+	TrapOutOfGas:                   "out of gas",
+	TrapHostFunction:               "host function panicked",
+	TrapCanceled:                   "execution canceled",
+	TrapTableOutOfBounds:           "out of bounds table access",
+}
+
+func (c TrapCode) String() string {
+	if int(c) < len(trapCodeNames) {
+		return trapCodeNames[c]
+	}
+	return fmt.Sprintf("<unknown trap code %d>", uint8(c))
+}
+
+// TraceFrame is a function activation in the backtrace of a Trap.
+type TraceFrame struct {
+	Func   int64  // Index of the function in the function index space
+	Name   string // Name of the function, if known
+	Offset int64  // Offset of the executing instruction in the function body, or -1 for host functions
+}
+
+func (f TraceFrame) String() string {
+	name := f.Name
+	if name == "" {
+		name = "<unnamed>"
+	}
+	if f.Offset < 0 {
+		return fmt.Sprintf("function %d %s (host)", f.Func, name)
+	}
+	return fmt.Sprintf("function %d %s at offset %#x", f.Func, name, f.Offset)
+}
+
+// maxBacktrace is the maximum number of frames in the backtrace of a Trap.
+// Deeper call stacks keep their innermost and outermost frames.
+const maxBacktrace = 64
+
+// Trap is the error returned, or used as panic value if RecoverPanic is
+// false, when the execution of a VM traps.
+type Trap struct {
+	Code   TrapCode
+	Func   int64 // Index of the faulting function
+	Offset int64 // Offset of the faulting instruction in the function body, or -1 for host functions
+	// Backtrace is the call stack at the time of the trap, starting with
+	// the faulting function.
+	Backtrace []TraceFrame
+	// Elided is the number of frames left out of the middle of Backtrace,
+	// after its first maxBacktrace/2 frames, when the call stack is deeper
+	// than maxBacktrace.
+	Elided int
+	// Err is the error that caused the trap, e.g. ErrOutOfBoundsMemoryAccess.
+	Err error
+}
+
+func (t *Trap) Error() string {
+	msg := t.Code.String()
+	if t.Err != nil {
+		msg = t.Err.Error()
+	}
+	if len(t.Backtrace) == 0 {
+		return msg
+	}
+	return fmt.Sprintf("%s (%v)", msg, t.Backtrace[0])
+}
+
+// Unwrap returns the error that caused the trap.
+func (t *Trap) Unwrap() error {
+	return t.Err
+}
+
+// String returns the cause of the trap followed by its backtrace, one frame
+// per line.
+func (t *Trap) String() string {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "trap: %v: %v\n", t.Code, t.Err)
+	for i, f := range t.Backtrace {
+		if i == maxBacktrace/2 && t.Elided != 0 {
+			fmt.Fprintf(buf, "\t... %d frames elided\n", t.Elided)
+		}
+		fmt.Fprintf(buf, "\t%v\n", f)
+	}
+	return buf.String()
+}
+
+// hostPanic wraps the value a host function panicked with.
+type hostPanic struct {
+	index int64
+	value interface{}
+}
+
+// newTrap returns the trap for the panic value r, recording the current call
+// stack of vm.
+func (vm *VM) newTrap(r interface{}) *Trap {
+	trap := &Trap{}
+	if hp, ok := r.(hostPanic); ok {
+		trap.Code = TrapHostFunction
+		trap.Backtrace = append(trap.Backtrace, TraceFrame{
+			Func:   hp.index,
+			Name:   vm.funcName(hp.index),
+			Offset: -1,
+		})
+		r = hp.value
+	}
+	switch e := r.(type) {
+	case *Trap:
+		if trap.Code != TrapHostFunction {
+			// Already converted, e.g. by a nested call to run.
+			return e
+		}
+		trap.Err = e
+	case error:
+		trap.Err = e
+	case string:
+		trap.Err = errors.New(e)
+	default:
+		trap.Err = fmt.Errorf("exec: %v", e)
+	}
+	if trap.Code != TrapHostFunction {
+		trap.Code = errorTrapCode(trap.Err)
+	}
+
+	// k is the position of the frame in the complete backtrace.
+	k := len(trap.Backtrace)
+	if depth := k + len(vm.frames) + 1; depth > maxBacktrace {
+		trap.Elided = depth - maxBacktrace
+	}
+	for i := len(vm.frames); i >= 0; i, k = i-1, k+1 {
+		if k >= maxBacktrace/2 && k < maxBacktrace/2+trap.Elided {
+			continue
+		}
+		ctx := vm.ctx
+		if i < len(vm.frames) {
+			ctx = vm.frames[i]
+		}
+		trap.Backtrace = append(trap.Backtrace, TraceFrame{
+			Func:   ctx.curFunc,
+			Name:   vm.funcName(ctx.curFunc),
+			Offset: vm.instrOffset(ctx),
+		})
+	}
+	trap.Func, trap.Offset = -1, -1
+	if len(trap.Backtrace) > 0 {
+		trap.Func = trap.Backtrace[0].Func
+		trap.Offset = trap.Backtrace[0].Offset
+	}
+	return trap
+}
+
+func errorTrapCode(err error) TrapCode {
+	for ; err != nil; err = errors.Unwrap(err) {
+		switch err {
+		case ErrUnreachable:
+			return TrapUnreachable
+		case ErrOutOfBoundsMemoryAccess:
+			return TrapMemoryOutOfBounds
+		case ErrOutOfBoundsTableAccess:
+			return TrapTableOutOfBounds
+		case ErrIntegerDivideByZero:
+			return TrapIntegerDivideByZero
+		case ErrIntegerOverflow:
+			return TrapIntegerOverflow
+		case ErrInvalidConversionToInteger:
+			return TrapInvalidConversionToInteger
+		case ErrUndefinedElementIndex:
+			return TrapUndefinedElement
+		case ErrSignatureMismatch:
+			return TrapIndirectCallTypeMismatch
+		case ErrCallStackDepthExceed, ErrArenaSlotsExceed:
+			return TrapCallStackExhausted
+		case ErrExecStepExhausted, ErrGasExhausted:
+			return TrapOutOfGas
+		}
+		if _, ok := err.(wasm.UninitializedTableEntryError); ok {
+			return TrapUninitializedElement
+		}
+	}
+	return TrapInternal
+}
+
+// instrOffset returns the offset in the original function body of the
+// instruction ctx is executing.
+func (vm *VM) instrOffset(ctx context) int64 {
+	cf, ok := vm.funcs[ctx.curFunc].(compiledFunction)
+	if !ok {
+		return -1
+	}
+	// The last instruction starting before pc is the one being executed:
+	// pc has moved past its opcode.
+	i := sort.Search(len(cf.offsets), func(i int) bool {
+		return cf.offsets[i].PC >= ctx.pc
+	})
+	if i == 0 {
+		return 0
+	}
+	return cf.offsets[i-1].Offset
+}
+
+// funcNames holds the names of the functions of a module. They are only
+// decoded when a trap first needs them, and then shared by every VM created
+// from the same CompiledModule.
+type funcNames struct {
+	once  sync.Once
+	names []string
+}
+
+// funcName returns the name of the function at index from the name section
+// of the module or, failing that, the name it is imported or exported under.
+func (vm *VM) funcName(index int64) string {
+	if vm.names == nil {
+		return ""
+	}
+	vm.names.once.Do(func() {
+		vm.names.names = decodeFuncNames(vm.module)
+	})
+	if index < 0 || index >= int64(len(vm.names.names)) {
+		return ""
+	}
+	return vm.names.names[index]
+}
+
+// decodeFuncNames returns the names of the functions of module, indexed by
+// their index in the function index space.
+func decodeFuncNames(module *wasm.Module) []string {
+	names := make([]string, len(module.FunctionIndexSpace))
+	if module.Export != nil {
+		for name, e := range module.Export.Entries {
+			i := int(e.Index)
+			if e.Kind == wasm.ExternalFunction && i < len(names) &&
+				(names[i] == "" || name < names[i]) {
+				names[i] = name
+			}
+		}
+	}
+	if module.Import != nil {
+		i := 0
+		for _, e := range module.Import.Entries {
+			if _, ok := e.Type.(wasm.FuncImport); !ok {
+				continue
+			}
+			if i < len(names) {
+				names[i] = e.ModuleName + "." + e.FieldName
+			}
+			i++
+		}
+	}
+	if s := module.Custom(wasm.CustomSectionName); s != nil {
+		var section wasm.NameSection
+		if section.UnmarshalWASM(bytes.NewReader(s.Data)) == nil {
+			sub, _ := section.Decode(wasm.NameFunction)
+			if funcs, ok := sub.(*wasm.FunctionNames); ok {
+				for i, name := range funcs.Names {
+					if uint64(i) < uint64(len(names)) {
+						names[i] = name
+					}
+				}
+			}
+		}
+	}
+	return names
+}
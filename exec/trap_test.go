@@ -0,0 +1,194 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ontio/wagon/wasm"
+)
+
+// moduleTrap imports env.boom(i32) i32 and defines, all taking an i32 and
+// returning an i32:
+//
+//	outer (exported), which calls load
+//	load, which loads from the given address and is named by the name section
+//	div (exported), which divides 1 by its argument
+//	callhost (exported), which calls env.boom
+var moduleTrap = moduleBytes(
+	section(0x01, 0x01, 0x60, 0x01, 0x7f, 0x01, 0x7f),
+	section(0x02, 0x01, 0x03, 'e', 'n', 'v', 0x04, 'b', 'o', 'o', 'm', 0x00, 0x00),
+	section(0x03, 0x04, 0x00, 0x00, 0x00, 0x00),
+	section(0x05, 0x01, 0x00, 0x01),
+	section(0x07, 0x03,
+		0x05, 'o', 'u', 't', 'e', 'r', 0x00, 0x01,
+		0x03, 'd', 'i', 'v', 0x00, 0x03,
+		0x08, 'c', 'a', 'l', 'l', 'h', 'o', 's', 't', 0x00, 0x04),
+	section(0x0a, 0x04,
+		0x06, 0x00, 0x20, 0x00, 0x10, 0x02, 0x0b,
+		0x07, 0x00, 0x20, 0x00, 0x28, 0x02, 0x00, 0x0b,
+		0x07, 0x00, 0x41, 0x01, 0x20, 0x00, 0x6e, 0x0b,
+		0x06, 0x00, 0x20, 0x00, 0x10, 0x00, 0x0b),
+	section(0x00, 0x04, 'n', 'a', 'm', 'e', 0x01, 0x07, 0x01, 0x02, 0x04, 'l', 'o', 'a', 'd'),
+)
+
+// boomImporter resolves the env module of moduleTrap, whose boom function
+// is implemented by boom.
+func boomImporter(boom func(*Process, int32) int32) wasm.ResolveFunc {
+	return func(name string) (*wasm.Module, error) {
+		m := wasm.NewModule()
+		m.Types = &wasm.SectionTypes{
+			Entries: []wasm.FunctionSig{
+				{
+					ParamTypes:  []wasm.ValueType{wasm.ValueTypeI32},
+					ReturnTypes: []wasm.ValueType{wasm.ValueTypeI32},
+				},
+			},
+		}
+		m.FunctionIndexSpace = []wasm.Function{
+			{
+				Sig:  &m.Types.Entries[0],
+				Host: reflect.ValueOf(boom),
+				Body: &wasm.FunctionBody{},
+			},
+		}
+		m.Export = &wasm.SectionExports{
+			Entries: map[string]wasm.ExportEntry{
+				"boom": {FieldStr: "boom", Kind: wasm.ExternalFunction, Index: 0},
+			},
+		}
+		return m, nil
+	}
+}
+
+func newTrapVM(t *testing.T, boom func(*Process, int32) int32) *VM {
+	compiled, err := CompileModule(readTestModule(t, moduleTrap, boomImporter(boom)), nil)
+	if err != nil {
+		t.Fatalf("could not compile module: %v", err)
+	}
+	vm := newTestVM(t, compiled)
+	vm.RecoverPanic = false
+	return vm
+}
+
+func TestTrap(t *testing.T) {
+	// boom divides by zero in a nested execution that does not recover
+	// from panics, so the inner trap propagates through the host function.
+	var inner *VM
+	vm := newTrapVM(t, func(proc *Process, x int32) int32 {
+		inner.ExecCode(3, uint64(x))
+		return 0
+	})
+	inner = newTrapVM(t, nil)
+	vm.RecoverPanic = true
+
+	for _, tc := range []struct {
+		fn, arg   uint64
+		code      TrapCode
+		cause     error
+		backtrace []TraceFrame
+	}{
+		{1, wasmPageSize, TrapMemoryOutOfBounds, ErrOutOfBoundsMemoryAccess, []TraceFrame{
+			{Func: 2, Name: "load", Offset: 2},
+			{Func: 1, Name: "outer", Offset: 2},
+		}},
+		{3, 0, TrapIntegerDivideByZero, ErrIntegerDivideByZero, []TraceFrame{
+			{Func: 3, Name: "div", Offset: 4},
+		}},
+		{4, 0, TrapHostFunction, ErrIntegerDivideByZero, []TraceFrame{
+			{Func: 0, Name: "env.boom", Offset: -1},
+			{Func: 4, Name: "callhost", Offset: 2},
+		}},
+	} {
+		_, err := vm.ExecCode(int64(tc.fn), tc.arg)
+		var trap *Trap
+		if !errors.As(err, &trap) {
+			t.Fatalf("function %d: got error %v, want a trap", tc.fn, err)
+		}
+		if trap.Code != tc.code {
+			t.Errorf("function %d: got trap code %v, want %v", tc.fn, trap.Code, tc.code)
+		}
+		if !errors.Is(err, tc.cause) {
+			t.Errorf("function %d: trap %v is not caused by %v", tc.fn, err, tc.cause)
+		}
+		if !reflect.DeepEqual(trap.Backtrace, tc.backtrace) {
+			t.Errorf("function %d: got backtrace\n%v\nwant\n%v", tc.fn, trap.Backtrace, tc.backtrace)
+		}
+		if trap.Func != tc.backtrace[0].Func || trap.Offset != tc.backtrace[0].Offset {
+			t.Errorf("function %d: trap location is function %d offset %d", tc.fn, trap.Func, trap.Offset)
+		}
+	}
+
+	_, err := vm.ExecCode(4, 0)
+	var trap *Trap
+	if !errors.As(err, &trap) || !errors.As(trap.Err, &trap) || trap.Code != TrapIntegerDivideByZero || trap.Func != 3 {
+		t.Errorf("trap of the nested execution is not preserved: %#v", trap)
+	}
+}
+
+func TestTrapWithoutRecoverPanic(t *testing.T) {
+	vm := newTrapVM(t, nil)
+	defer func() {
+		trap, ok := recover().(*Trap)
+		if !ok || trap.Code != TrapIntegerDivideByZero {
+			t.Errorf("got panic %v, want a trap", trap)
+		}
+	}()
+	vm.ExecCode(3, 0)
+}
+
+func TestTrapWithoutBacktrace(t *testing.T) {
+	for _, test := range []struct {
+		trap *Trap
+		want string
+	}{
+		{&Trap{}, "internal error"},
+		{&Trap{Code: TrapOutOfGas, Err: ErrGasExhausted}, ErrGasExhausted.Error()},
+	} {
+		if got := test.trap.Error(); got != test.want {
+			t.Errorf("got %q, want %q", got, test.want)
+		}
+		_ = test.trap.String()
+	}
+}
+
+func TestTrapBacktraceLimit(t *testing.T) {
+	vm := newTestVM(t, compileTestModule(t, moduleDepth, nil))
+	vm.FrameLimit = 200
+	_, err := vm.ExecCode(0, 1000)
+	var trap *Trap
+	if !errors.As(err, &trap) || trap.Code != TrapCallStackExhausted {
+		t.Fatalf("got error %v, want a call stack trap", err)
+	}
+	// The trap is raised by the call from the innermost of 201 frames.
+	if len(trap.Backtrace) != maxBacktrace || trap.Elided != 201-maxBacktrace {
+		t.Errorf("backtrace has %d frames and %d elided, want %d and %d",
+			len(trap.Backtrace), trap.Elided, maxBacktrace, 201-maxBacktrace)
+	}
+	if !strings.Contains(trap.String(), fmt.Sprintf("... %d frames elided", trap.Elided)) {
+		t.Errorf("trap description does not mention the elided frames:\n%s", trap)
+	}
+}
+
+func TestTrapNamesShared(t *testing.T) {
+	compiled, err := CompileModule(readTestModule(t, moduleTrap, boomImporter(nil)), nil)
+	if err != nil {
+		t.Fatalf("could not compile module: %v", err)
+	}
+	first, second := newTestVM(t, compiled), newTestVM(t, compiled)
+	if first.names != second.names {
+		t.Fatal("VMs of the same module do not share function names")
+	}
+	if _, err := first.ExecCode(1, wasmPageSize); err == nil {
+		t.Fatal("out of bounds load did not trap")
+	}
+	if got := second.names.names; !reflect.DeepEqual(got, []string{"env.boom", "outer", "load", "div", "callhost"}) {
+		t.Errorf("decoded names %q", got)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ontio/wagon/wasm"
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+func TestTruncSatOps(t *testing.T) {
+	for _, tc := range []struct {
+		sub  uint32
+		arg  uint64
+		want uint64
+	}{
+		{ops.I32TruncSatSF32, f32Bits(-1.5), 0xffffffff},
+		{ops.I32TruncSatSF32, f32Bits(2147483648), math.MaxInt32},
+		{ops.I32TruncSatSF32, f32Bits(float32(math.Inf(-1))), 0x80000000},
+		{ops.I32TruncSatSF32, nan32, 0},
+		{ops.I32TruncSatUF32, f32Bits(-1), 0},
+		{ops.I32TruncSatUF32, f32Bits(3e9), 3000000000},
+		{ops.I32TruncSatSF64, f64Bits(-2147483649), 0x80000000},
+		{ops.I32TruncSatUF64, f64Bits(1e10), math.MaxUint32},
+		{ops.I32TruncSatUF64, negNaN64, 0},
+		{ops.I64TruncSatSF32, f32Bits(float32(math.Inf(1))), math.MaxInt64},
+		{ops.I64TruncSatUF32, f32Bits(-0.9), 0},
+		{ops.I64TruncSatSF64, f64Bits(-1e19), 1 << 63},
+		{ops.I64TruncSatSF64, f64Bits(-1.5), math.MaxUint64},
+		{ops.I64TruncSatUF64, f64Bits(1e20), math.MaxUint64},
+		{ops.I64TruncSatUF64, f64Bits(18446744073709549568), 18446744073709549568},
+	} {
+		op, err := ops.NewPrefixed(ops.MiscPrefix, tc.sub)
+		if err != nil {
+			t.Fatalf("could not lookup operator 0xfc 0x%x: %v", tc.sub, err)
+		}
+		for _, mode := range floatModes {
+			t.Run(fmt.Sprintf("%s/%v%#x", mode, op.Name, tc.arg), func(t *testing.T) {
+				vm := &VM{FloatMode: mode}
+				vm.ctx.stack = make([]uint64, 0, 1)
+				vm.newFuncTable()
+				vm.pushUint64(tc.arg)
+				vm.prefixedFuncTable[ops.MiscPrefix][tc.sub]()
+				got := vm.popUint64()
+				if op.Returns == wasm.ValueTypeI32 {
+					got = uint64(uint32(got))
+				}
+				if got != tc.want {
+					t.Fatalf("got=%#x, want=%#x", got, tc.want)
+				}
+			})
+		}
+	}
+}
+
+// moduleTruncSat exports a function (f64) i32 returning i32.trunc_sat_f64_s
+// applied to its argument.
+var moduleTruncSat = moduleBytes(
+	section(0x01, 0x01, 0x60, 0x01, 0x7c, 0x01, 0x7f),
+	section(0x03, 0x01, 0x00),
+	section(0x0a, 0x01, 0x06, 0x00, 0x20, 0x00, 0xfc, 0x02, 0x0b),
+)
+
+func TestTruncSatModule(t *testing.T) {
+	want := ops.DisabledPrefixedOpcodeError{Prefix: ops.MiscPrefix, Sub: ops.I32TruncSatSF64}
+	if _, err := CompileModule(readTestModule(t, moduleTruncSat, nil), nil); err != want {
+		t.Fatalf("CompileModule: got error %v, want %v", err, want)
+	}
+
+	vm := newTestVM(t, compileTestModuleWithFeatures(t, moduleTruncSat, nil, ops.FeatureNonTrappingFloatToInt))
+	for _, tc := range []struct {
+		arg  float64
+		want uint32
+	}{
+		{-7.9, 0xfffffff9},
+		{1e10, math.MaxInt32},
+		{math.NaN(), 0},
+	} {
+		res, err := vm.ExecCode(0, f64Bits(tc.arg))
+		if err != nil {
+			t.Fatalf("%v: %v", tc.arg, err)
+		}
+		if res != tc.want {
+			t.Errorf("i32.trunc_sat_f64_s(%v) = %v, want %v", tc.arg, res, tc.want)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package exec
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/ontio/wagon/wasm"
+	"github.com/ontio/wagon/wasm/leb128"
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// section encodes a module section with the given id and payload.
+func section(id byte, payload ...byte) []byte {
+	buf := new(bytes.Buffer)
+	buf.WriteByte(id)
+	leb128.WriteVarUint32(buf, uint32(len(payload)))
+	buf.Write(payload)
+	return buf.Bytes()
+}
+
+// moduleBytes encodes a module made of the given sections.
+func moduleBytes(sections ...[]byte) []byte {
+	b := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	for _, s := range sections {
+		b = append(b, s...)
+	}
+	return b
+}
+
+// readTestModule decodes the module raw, resolving its imports with
+// importer.
+func readTestModule(t testing.TB, raw []byte, importer wasm.ResolveFunc) *wasm.Module {
+	t.Helper()
+	m, err := wasm.ReadModule(bytes.NewReader(raw), importer)
+	if err != nil {
+		t.Fatalf("could not read module: %v", err)
+	}
+	return m
+}
+
+// compileTestModule decodes the module raw, which has no imports, and
+// compiles it with schedule.
+func compileTestModule(t testing.TB, raw []byte, schedule *GasSchedule) *CompiledModule {
+	t.Helper()
+	return compileTestModuleWithFeatures(t, raw, schedule, 0)
+}
+
+// compileTestModuleWithFeatures is like compileTestModule, but also enables
+// the given post-MVP features.
+func compileTestModuleWithFeatures(t testing.TB, raw []byte, schedule *GasSchedule, features ops.Features) *CompiledModule {
+	t.Helper()
+	compiled, err := CompileModuleWithFeatures(readTestModule(t, raw, nil), schedule, features)
+	if err != nil {
+		t.Fatalf("could not compile module: %v", err)
+	}
+	return compiled
+}
+
+// testGas returns gas counters with the given step budget, an unlimited
+// gas budget and a gas factor of 1, so that the gas charged equals the
+// steps charged.
+func testGas(execStep uint64) *Gas {
+	gasLimit := uint64(math.MaxUint64)
+	return &Gas{GasPrice: 1, GasLimit: &gasLimit, GasFactor: 1, ExecStep: &execStep}
+}
+
+// newTestVM instantiates compiled with unlimited memory, gas and call
+// budgets. Errors are returned rather than panicking.
+func newTestVM(t testing.TB, compiled *CompiledModule) *VM {
+	t.Helper()
+	vm, err := NewVMWithCompiled(compiled, math.MaxUint64)
+	if err != nil {
+		t.Fatalf("could not instantiate vm: %v", err)
+	}
+	vm.ExecMetrics = testGas(math.MaxUint64)
+	vm.CallStackDepth = math.MaxUint32
+	vm.RecoverPanic = true
+	return vm
+}
+
+// mustExec executes the function fn of vm with args and returns its result,
+// failing the test if the execution fails.
+func mustExec(t testing.TB, vm *VM, fn int64, args ...uint64) interface{} {
+	t.Helper()
+	res, err := vm.ExecCode(fn, args...)
+	if err != nil {
+		t.Fatalf("function %d%v: %v", fn, args, err)
+	}
+	return res
+}
+
+// checkTrap fails the test unless err is a trap with the given code.
+func checkTrap(t testing.TB, err error, code TrapCode) {
+	t.Helper()
+	var trap *Trap
+	if !errors.As(err, &trap) || trap.Code != code {
+		t.Fatalf("got error %v, want trap %q", err, code)
+	}
+}
@@ -25,6 +25,30 @@ var (
 	// ErrInvalidArgumentCount is returned by (*VM).ExecCode when an invalid
 	// number of arguments to the WebAssembly function are passed to it.
 	ErrInvalidArgumentCount = errors.New("exec: invalid number of arguments to function")
+	// ErrExecStepExhausted is returned by (*VM).CheckExecLimit when the
+	// execution exceeds ExecMetrics.ExecStep.
+	ErrExecStepExhausted = errors.New("exec step exhausted")
+	// ErrGasExhausted is returned by (*VM).CheckExecLimit when the execution
+	// exceeds ExecMetrics.GasLimit.
+	ErrGasExhausted = errors.New("gas exhausted")
+	// ErrPaused is returned by (*VM).ExecCode and (*VM).Resume when a host
+	// function paused the execution by calling Process.Pause.
+	ErrPaused = errors.New("exec: execution paused")
+	// ErrNotSuspended is returned by (*VM).Resume when the VM holds no
+	// suspended execution.
+	ErrNotSuspended = errors.New("exec: no suspended execution")
+	// ErrMultiValueDisabled is returned by CompileModuleWithFeatures when
+	// the module has a signature with several results or a block whose
+	// signature is a type index, and ops.FeatureMultiValue is not enabled.
+	ErrMultiValueDisabled = errors.New("exec: multiple results require the multi-value feature")
+	// ErrInvalidStartFunction is returned by CompileModuleWithPolicy when
+	// the start function of a module is not a WebAssembly function without
+	// parameters and results.
+	ErrInvalidStartFunction = errors.New("exec: invalid start function")
+	// ErrStartPending is returned by NewVMWithCompiled and NewVMWithGas
+	// when the module has a start function, which is only run by
+	// NewVMWithLimits and NewVMWithPolicy.
+	ErrStartPending = errors.New("exec: start function has not been run")
 )
 
 // InvalidReturnTypeError is returned by (*VM).ExecCode when the module
@@ -49,6 +73,7 @@ type context struct {
 	code    []byte
 	pc      int64
 	curFunc int64
+	base    int // offset of the frame in the arena of the VM
 }
 
 type Gas struct {
@@ -63,12 +88,22 @@ type Gas struct {
 type VM struct {
 	ctx context
 
-	module  *wasm.Module
-	globals []uint64
-	memory  []byte
-	funcs   []function
+	module      *wasm.Module
+	globals     []uint64
+	globalSlots []uint32 // see CompiledModule
+	memory      []byte
+	funcs       []function
+
+	tables    [][]wasm.TableEntry // shared with the module until ownTables is set
+	ownTables bool                // whether tables was copied by a table operator
+	data      [][]byte            // data segments, nil once dropped
+	elems     [][]wasm.TableEntry // element segments, nil once dropped
+	externs   []interface{}       // values referred to by externref values
 
 	funcTable [256]func()
+	// Implementations of prefixed operators, mapped by prefix byte and
+	// indexed by sub-opcode.
+	prefixedFuncTable map[byte][]func()
 
 	// RecoverPanic controls whether the `ExecCode` method
 	// recovers from a panic and returns it as an error
@@ -79,15 +114,42 @@ type VM struct {
 
 	abort bool // Flag for host functions to terminate execution
 
+	frames         []context       // Frames of the callers of the current function
+	arena          []uint64        // Locals and operand stacks of all frames
+	tailArgs       []uint64        // Arguments of a tail call while the frame is replaced
+	entry          int64           // Index of the function ExecCode was called with
+	suspended      bool            // Whether the execution can be resumed
+	unpaidGas      uint64          // Gas the suspended execution has not paid for yet
+	limitErr       error           // Set by charge when the executing instruction ran out of gas
+	paused         bool            // Flag for host functions to pause execution
+	pendingResults int             // Number of host function results Resume expects
+	done           <-chan struct{} // Closed when the execution must be canceled
+	doneErr        func() error    // Reason for the cancellation
+
 	//add for ontology gas limit
 	ExecMetrics *Gas
 
 	HostData interface{}
+	proc     *Process // Process passed to host functions
 
 	//memory limitation
 	MemoryLimitation uint64
 	//call stack depth
 	CallStackDepth uint32
+	// FrameLimit is the maximum number of nested calls, whatever the
+	// CallStackDepth. If zero, DefaultFrameLimit is used.
+	FrameLimit uint32
+	// MaxArenaSlots, if non-zero, is the maximum number of slots of the
+	// arena holding the locals and operand stacks of the frames. A call
+	// whose frame does not fit traps with TrapCallStackExhausted.
+	MaxArenaSlots uint64
+
+	gas   *GasSchedule
+	names *funcNames // Names of the functions, for the backtraces of traps
+
+	// FloatMode selects how floating-point operators are evaluated.
+	// The zero value, FloatSoft, gives bit-identical results on every host.
+	FloatMode FloatMode
 }
 
 // As per the WebAssembly spec: https://github.com/WebAssembly/design/blob/27ac254c854994103c24834a994be16f74f54186/Semantics.md#linear-memory
@@ -98,13 +160,47 @@ var endianess = binary.LittleEndian
 type CompiledModule struct {
 	RawModule *wasm.Module
 	globals   []uint64
-	memory    []byte
-	funcs     []function
+	// globalSlots holds the index of the first slot of each global in
+	// globals, or is nil if every global takes a single slot.
+	globalSlots []uint32
+	memory      []byte
+	funcs       []function
+	gas         *GasSchedule
+	names       *funcNames
+}
+
+// CompileModule compiles module, charging gas for its instructions according
+// to schedule. If schedule is nil, DefaultGasSchedule is used. Only MVP
+// operators and signatures are accepted.
+func CompileModule(module *wasm.Module, schedule *GasSchedule) (*CompiledModule, error) {
+	return CompileModuleWithFeatures(module, schedule, 0)
+}
+
+// CompileModuleWithFeatures is like CompileModule, but also accepts the
+// operators and signatures of the post-MVP proposals enabled in features. A
+// module using any other operator is rejected with an
+// ops.DisabledOpcodeError, or an ops.DisabledPrefixedOpcodeError for
+// prefixed operators.
+func CompileModuleWithFeatures(module *wasm.Module, schedule *GasSchedule, features ops.Features) (*CompiledModule, error) {
+	return CompileModuleWithPolicy(module, schedule, features, wasm.Policy{})
 }
 
-func CompileModule(module *wasm.Module) (*CompiledModule, error) {
+// CompileModuleWithPolicy is like CompileModuleWithFeatures, but the module
+// must comply with policy instead of the zero wasm.Policy. If policy allows
+// a start function, it is run by NewVMWithLimits.
+func CompileModuleWithPolicy(module *wasm.Module, schedule *GasSchedule, features ops.Features, policy wasm.Policy) (*CompiledModule, error) {
 	var compiled CompiledModule
 
+	if err := policy.Check(module); err != nil {
+		return nil, err
+	}
+
+	if schedule == nil {
+		schedule = DefaultGasSchedule()
+	}
+	compiled.gas = schedule
+	compiled.names = &funcNames{}
+
 	if module.Memory != nil && len(module.Memory.Entries) != 0 {
 		if len(module.Memory.Entries) > 1 {
 			return nil, ErrMultipleLinearMemories
@@ -115,8 +211,28 @@ func CompileModule(module *wasm.Module) (*CompiledModule, error) {
 		copy(compiled.memory, module.LinearMemoryIndexSpace[0])
 	}
 
+	if !features.Has(ops.FeatureMultiValue) && module.Types != nil {
+		for _, sig := range module.Types.Entries {
+			if len(sig.ReturnTypes) > 1 {
+				return nil, ErrMultiValueDisabled
+			}
+		}
+	}
+
 	compiled.funcs = make([]function, len(module.FunctionIndexSpace))
-	compiled.globals = make([]uint64, len(module.GlobalIndexSpace))
+	globalTypes := make([]wasm.ValueType, len(module.GlobalIndexSpace))
+	for i, global := range module.GlobalIndexSpace {
+		globalTypes[i] = global.Type.Type
+	}
+	compiled.globals = make([]uint64, disasm.Slots(globalTypes...))
+	if len(compiled.globals) != len(globalTypes) {
+		compiled.globalSlots = make([]uint32, len(globalTypes))
+		slot := uint32(0)
+		for i, t := range globalTypes {
+			compiled.globalSlots[i] = slot
+			slot += uint32(disasm.Slots(t))
+		}
+	}
 	compiled.RawModule = module
 
 	nNatives := 0
@@ -127,6 +243,15 @@ func CompileModule(module *wasm.Module) (*CompiledModule, error) {
 		// in the spec. See the "host functions"
 		// section of:
 		// https://webassembly.github.io/spec/core/exec/modules.html#allocation
+		if fn.IsHost() && fn.Host.Type() == hostFunctionType {
+			compiled.funcs[i] = hostFunction{
+				fn:      fn.Host.Interface().(HostFunction),
+				params:  disasm.Slots(fn.Sig.ParamTypes...),
+				results: disasm.Slots(fn.Sig.ReturnTypes...),
+			}
+			nNatives++
+			continue
+		}
 		if fn.IsHost() {
 			compiled.funcs[i] = goFunction{
 				typ: fn.Host.Type(),
@@ -140,20 +265,28 @@ func CompileModule(module *wasm.Module) (*CompiledModule, error) {
 		if err != nil {
 			return nil, err
 		}
-
-		totalLocalVars := 0
-		totalLocalVars += len(fn.Sig.ParamTypes)
-		for _, entry := range fn.Body.Locals {
-			totalLocalVars += int(entry.Count)
+		for _, instr := range disassembly.Code {
+			if err := instr.Op.CheckFeatures(features); err != nil {
+				return nil, err
+			}
+			if instr.Op.Code == ops.Block || instr.Op.Code == ops.Loop || instr.Op.Code == ops.If {
+				if _, ok := instr.Immediates[0].(wasm.BlockTypeIndex); ok && !features.Has(ops.FeatureMultiValue) {
+					return nil, ErrMultiValueDisabled
+				}
+			}
 		}
-		code, table := compile.Compile(disassembly.Code)
+
+		locals := disasm.LocalTypes(fn)
+		code, table, instrs, offsets := compile.Compile(disassembly.Code, schedule.cost, locals, globalTypes)
 		compiled.funcs[i] = compiledFunction{
 			code:           code,
 			branchTables:   table,
+			instrs:         instrs,
+			offsets:        offsets,
 			maxDepth:       disassembly.MaxDepth,
-			totalLocalVars: totalLocalVars,
-			args:           len(fn.Sig.ParamTypes),
-			returns:        len(fn.Sig.ReturnTypes) != 0,
+			totalLocalVars: disasm.Slots(locals...),
+			args:           disasm.Slots(fn.Sig.ParamTypes...),
+			results:        disasm.Slots(fn.Sig.ReturnTypes...),
 		}
 	}
 
@@ -162,30 +295,117 @@ func CompileModule(module *wasm.Module) (*CompiledModule, error) {
 		if err != nil {
 			return nil, err
 		}
+		if compiled.globalSlots != nil {
+			i = int(compiled.globalSlots[i])
+		}
 		switch v := val.(type) {
 		case int32:
 			compiled.globals[i] = uint64(v)
 		case int64:
 			compiled.globals[i] = uint64(v)
-			//case float32:
-			//	compiled.globals[i] = uint64(math.Float32bits(v))
-			//case float64:
-			//	compiled.globals[i] = uint64(math.Float64bits(v))
+		case float32:
+			compiled.globals[i] = uint64(math.Float32bits(v))
+		case float64:
+			compiled.globals[i] = uint64(math.Float64bits(v))
+		case wasm.TableEntry:
+			compiled.globals[i] = refValue(v)
+		case [16]byte:
+			compiled.globals[i] = endianess.Uint64(v[:8])
+			compiled.globals[i+1] = endianess.Uint64(v[8:])
 		}
 	}
 
 	if module.Start != nil {
-		//_, err := compiled.ExecCode(int64(module.Start.Index))
-		//if err != nil {
-		//	return nil, err
-		//}
-		return nil, errors.New("start entry is not supported in smart contract")
+		index := int(module.Start.Index)
+		if index >= len(compiled.funcs) {
+			return nil, ErrInvalidStartFunction
+		}
+		sig := module.FunctionIndexSpace[index].Sig
+		if _, ok := compiled.funcs[index].(compiledFunction); !ok || len(sig.ParamTypes) != 0 || len(sig.ReturnTypes) != 0 {
+			return nil, ErrInvalidStartFunction
+		}
 	}
 
 	return &compiled, nil
 }
 
+// NewVMWithCompiled creates a new VM from a compiled module. The initial
+// linear memory is not charged for; use NewVMWithGas to pay for it. Modules
+// with a start function are rejected with ErrStartPending, see
+// NewVMWithLimits.
 func NewVMWithCompiled(module *CompiledModule, memLimit uint64) (*VM, error) {
+	if module.RawModule.Start != nil {
+		return nil, ErrStartPending
+	}
+	return newVM(module, memLimit)
+}
+
+// NewVMWithGas creates a new VM from a compiled module and charges metrics
+// for the pages of its initial linear memory, at the MemoryPageCost of the
+// schedule the module was compiled with. It fails if metrics cannot pay for
+// them. The returned VM uses metrics as its ExecMetrics. Like
+// NewVMWithCompiled, it rejects modules with a start function.
+func NewVMWithGas(module *CompiledModule, memLimit uint64, metrics *Gas) (*VM, error) {
+	vm, err := NewVMWithCompiled(module, memLimit)
+	if err != nil {
+		return nil, err
+	}
+	if err := vm.chargeInitialMemory(metrics); err != nil {
+		return nil, err
+	}
+	return vm, nil
+}
+
+// NewVMWithLimits is like NewVMWithGas, but the executions of the returned
+// VM are also bounded by callStackDepth and frameLimit, which are set as its
+// CallStackDepth and FrameLimit, and it completes the instantiation by
+// running the start function of the module, if any, under those limits.
+// metrics must not be nil. If the start function fails, the error is
+// returned as a *Trap.
+func NewVMWithLimits(module *CompiledModule, memLimit uint64, metrics *Gas, callStackDepth, frameLimit uint32) (*VM, error) {
+	vm, err := newVM(module, memLimit)
+	if err != nil {
+		return nil, err
+	}
+	if err := vm.chargeInitialMemory(metrics); err != nil {
+		return nil, err
+	}
+	vm.CallStackDepth = callStackDepth
+	vm.FrameLimit = frameLimit
+	if start := vm.module.Start; start != nil {
+		vm.RecoverPanic = true
+		_, err := vm.ExecCode(int64(start.Index))
+		vm.RecoverPanic = false
+		if err != nil {
+			return nil, err
+		}
+	}
+	return vm, nil
+}
+
+// NewVMWithPolicy compiles module under policy and creates a VM from it
+// with NewVMWithLimits, running the start function if policy allows one.
+func NewVMWithPolicy(module *wasm.Module, memLimit uint64, policy wasm.Policy, metrics *Gas, callStackDepth, frameLimit uint32) (*VM, error) {
+	compiled, err := CompileModuleWithPolicy(module, nil, 0, policy)
+	if err != nil {
+		return nil, err
+	}
+	return NewVMWithLimits(compiled, memLimit, metrics, callStackDepth, frameLimit)
+}
+
+// chargeInitialMemory sets metrics as the ExecMetrics of vm and charges it
+// for the pages of the initial linear memory.
+func (vm *VM) chargeInitialMemory(metrics *Gas) error {
+	vm.ExecMetrics = metrics
+	if cost := vm.memoryPagesCost(uint64(len(vm.memory) / wasmPageSize)); cost != 0 {
+		if err := vm.CheckExecLimit(cost); err != nil {
+			return fmt.Errorf("exec: reach the Exec limit %w", err)
+		}
+	}
+	return nil
+}
+
+func newVM(module *CompiledModule, memLimit uint64) (*VM, error) {
 	var vm VM
 
 	memsize := len(module.memory)
@@ -195,20 +415,30 @@ func NewVMWithCompiled(module *CompiledModule, memLimit uint64) (*VM, error) {
 	vm.MemoryLimitation = memLimit
 	vm.memory = make([]byte, memsize)
 	copy(vm.memory, module.memory)
+	vm.gas = module.gas
+	vm.names = module.names
 
 	vm.funcs = module.funcs
-	vm.globals = make([]uint64, len(module.RawModule.GlobalIndexSpace))
+	vm.globals = make([]uint64, len(module.globals))
 	copy(vm.globals, module.globals)
+	vm.globalSlots = module.globalSlots
 	vm.newFuncTable()
 	vm.module = module.RawModule
+	vm.tables = vm.module.TableIndexSpace
+	vm.data = dataSegments(vm.module)
+	elems, err := elemSegments(vm.module)
+	if err != nil {
+		return nil, err
+	}
+	vm.elems = elems
 
 	return &vm, nil
 }
 
-// NewVM creates a new VM from a given module. If the module defines a
-// start function, it will be executed.
+// NewVM creates a new VM from a given module. Modules with a start function
+// are rejected, see NewVMWithPolicy.
 func NewVM(module *wasm.Module, memLimit uint64) (*VM, error) {
-	compiled, err := CompileModule(module)
+	compiled, err := CompileModule(module, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -230,7 +460,15 @@ func (vm *VM) GetExportIndex(name string) (uint32, bool) {
 	return 0, false
 }
 
+// GetGlobal returns the value of the global of the given index. Only the low
+// 64 bits of v128 globals are returned.
 func (vm *VM) GetGlobal(index uint32) (uint64, bool) {
+	if vm.globalSlots != nil {
+		if int64(index) >= int64(len(vm.globalSlots)) {
+			return 0, false
+		}
+		index = vm.globalSlots[index]
+	}
 	if int64(index) >= int64(len(vm.globals)) {
 		return 0, false
 	}
@@ -342,87 +580,225 @@ func (vm *VM) pushFloat32(f float32) {
 // ExecCode calls the function with the given index and arguments.
 // fnIndex should be a valid index into the function index space of
 // the VM's module.
+//
+// The return value is nil for a function without results, the value of
+// the result for a function with one result, or a []interface{} holding the
+// values of the results otherwise. Values of type i32, i64, f32 and f64 are
+// returned as uint32, uint64, float32 and float64, and values of type v128
+// as [16]byte.
+//
+// A v128 argument is passed as two consecutive values in args, the low 64
+// bits first.
+//
+// If the execution runs out of gas, or a host function calls
+// Process.Pause, the VM is left suspended and the execution can be
+// continued with Resume. Calling ExecCode on a suspended VM discards the
+// suspended execution.
 func (vm *VM) ExecCode(fnIndex int64, args ...uint64) (rtrn interface{}, err error) {
 	// If used as a library, client code should set vm.RecoverPanic to true
 	// in order to have an error returned.
 	if vm.RecoverPanic {
-		defer func() {
-			if r := recover(); r != nil {
-				switch e := r.(type) {
-				case error:
-					err = e
-				default:
-					err = fmt.Errorf("exec: %v", e)
-				}
-			}
-		}()
+		defer vm.recoverPanic(&err)
 	}
 	if int(fnIndex) > len(vm.funcs) {
 		return nil, InvalidFunctionIndexError(fnIndex)
 	}
-	if len(vm.module.GetFunction(int(fnIndex)).Sig.ParamTypes) != len(args) {
+	if disasm.Slots(vm.module.GetFunction(int(fnIndex)).Sig.ParamTypes...) != len(args) {
 		return nil, ErrInvalidArgumentCount
 	}
 	compiled, ok := vm.funcs[fnIndex].(compiledFunction)
 	if !ok {
 		panic(fmt.Sprintf("exec: function at index %d is not a compiled function", fnIndex))
 	}
+	vm.unwind()
 
-	depth := compiled.maxDepth + 1
-	if cap(vm.ctx.stack) < depth {
-		vm.ctx.stack = make([]uint64, 0, depth)
-	} else {
-		vm.ctx.stack = vm.ctx.stack[:0]
+	vm.ctx = vm.newContext(compiled, fnIndex, 0)
+	vm.entry = fnIndex
+	copy(vm.ctx.locals, args)
+
+	return vm.run()
+}
+
+// Resume continues the execution of a suspended VM from the instruction at
+// which it stopped. If the execution was paused by a host function, results
+// are the values returned by that host function; otherwise results must be
+// empty. The return value is that of the function ExecCode was originally
+// called with.
+func (vm *VM) Resume(results ...uint64) (rtrn interface{}, err error) {
+	if vm.RecoverPanic {
+		defer vm.recoverPanic(&err)
+	}
+	if !vm.suspended {
+		return nil, ErrNotSuspended
 	}
+	if len(results) != vm.pendingResults {
+		return nil, ErrInvalidArgumentCount
+	}
+	if err := vm.payUnpaidGas(); err != nil {
+		return nil, err
+	}
+	for _, v := range results {
+		vm.pushUint64(v)
+	}
+	vm.suspended = false
+	vm.pendingResults = 0
 
-	vm.ctx.locals = make([]uint64, compiled.totalLocalVars)
-	vm.ctx.pc = 0
-	vm.ctx.code = compiled.code
-	vm.ctx.curFunc = fnIndex
+	return vm.run()
+}
+
+// Suspended reports whether the VM holds an execution that can be continued
+// with Resume.
+func (vm *VM) Suspended() bool {
+	return vm.suspended
+}
 
-	for i, arg := range args {
-		vm.ctx.locals[i] = arg
+func (vm *VM) recoverPanic(err *error) {
+	if r := recover(); r != nil {
+		switch e := r.(type) {
+		case error:
+			*err = e
+		default:
+			*err = fmt.Errorf("exec: %v", e)
+		}
 	}
+}
 
-	res, err := vm.execCode(compiled)
+// run executes the current frame until the function ExecCode was called
+// with returns, and converts its return value. Traps are turned into panics
+// of *Trap values.
+func (vm *VM) run() (rtrn interface{}, err error) {
+	defer func() {
+		if !vm.suspended {
+			vm.unwind()
+		}
+	}()
+	defer func() {
+		if r := recover(); r != nil {
+			panic(vm.newTrap(r))
+		}
+	}()
+
+	res, err := vm.execCode()
+	if vm.suspended && err == nil {
+		return nil, ErrPaused
+	}
 	if err != nil {
-		return nil, fmt.Errorf("exec:%v", err)
-	}
-	if compiled.returns {
-		rtrnType := vm.module.GetFunction(int(fnIndex)).Sig.ReturnTypes[0]
-		switch rtrnType {
-		case wasm.ValueTypeI32:
-			rtrn = uint32(res)
-		case wasm.ValueTypeI64:
-			rtrn = uint64(res)
-		//case wasm.ValueTypeF32:
-		//	rtrn = math.Float32frombits(uint32(res))
-		//case wasm.ValueTypeF64:
-		//	rtrn = math.Float64frombits(res)
-		default:
-			return nil, InvalidReturnTypeError(rtrnType)
+		return nil, err
+	}
+	fn := vm.module.GetFunction(int(vm.entry))
+	if len(res) != disasm.Slots(fn.Sig.ReturnTypes...) {
+		// The execution was terminated by a host function.
+		res = make([]uint64, disasm.Slots(fn.Sig.ReturnTypes...))
+	}
+	rtrns := make([]interface{}, len(fn.Sig.ReturnTypes))
+	for i, t := range fn.Sig.ReturnTypes {
+		if rtrns[i], err = returnValue(t, res); err != nil {
+			return nil, err
 		}
+		res = res[disasm.Slots(t):]
+	}
+	switch len(rtrns) {
+	case 0:
+		return nil, nil
+	case 1:
+		return rtrns[0], nil
+	}
+	return rtrns, nil
+}
+
+// returnValue converts the raw value of type t returned by a function, held
+// by the first slots of res.
+func returnValue(t wasm.ValueType, res []uint64) (interface{}, error) {
+	v := res[0]
+	switch t {
+	case wasm.ValueTypeI32:
+		return uint32(v), nil
+	case wasm.ValueTypeI64:
+		return uint64(v), nil
+	case wasm.ValueTypeF32:
+		return math.Float32frombits(uint32(v)), nil
+	case wasm.ValueTypeF64:
+		return math.Float64frombits(v), nil
+	case wasm.ValueTypeFuncRef, wasm.ValueTypeExternRef:
+		return v, nil
+	case wasm.ValueTypeV128:
+		var b [16]byte
+		endianess.PutUint64(b[:8], v)
+		endianess.PutUint64(b[8:], res[1])
+		return b, nil
 	}
+	return nil, InvalidReturnTypeError(t)
+}
+
+// unwind drops the frames of an unfinished execution, releasing the call
+// stack depth they hold.
+func (vm *VM) unwind() {
+	vm.CallStackDepth += uint32(len(vm.frames))
+	vm.frames = vm.frames[:0]
+	vm.suspended = false
+	vm.paused = false
+	vm.pendingResults = 0
+	vm.unpaidGas = 0
+	vm.limitErr = nil
+}
 
-	return rtrn, nil
+// compiledFunction returns the compiled function at the given index.
+func (vm *VM) compiledFunction(index int64) compiledFunction {
+	cf, ok := vm.funcs[index].(compiledFunction)
+	if !ok {
+		panic(fmt.Sprintf("exec: function at index %d is not a compiled function", index))
+	}
+	return cf
 }
 
-func (vm *VM) execCode(compiled compiledFunction) (uint64, error) {
-outer:
-	for int(vm.ctx.pc) < len(vm.ctx.code) && !vm.abort {
-		op := vm.ctx.code[vm.ctx.pc]
+// execCode runs the interpreter loop until the outermost frame returns, the
+// execution is terminated by a host function, or it is suspended.
+func (vm *VM) execCode() ([]uint64, error) {
+	for !vm.abort {
+		if int(vm.ctx.pc) >= len(vm.ctx.code) {
+			if rtrn, done := vm.ret(); done {
+				return rtrn, nil
+			}
+			continue
+		}
+		start := vm.ctx.pc
+		op := vm.ctx.code[start]
 		vm.ctx.pc++
 		switch op {
 		case ops.Return:
-			break outer
+			if rtrn, done := vm.ret(); done {
+				return rtrn, nil
+			}
+			continue
 		case compile.OpGasCounter:
+			if vm.done != nil {
+				if err := vm.interrupt(); err != nil {
+					vm.ctx.pc--
+					return nil, err
+				}
+			}
 			costs := vm.fetchUint64()
-			err := vm.CheckExecLimit(costs)
+			unpaid, err := vm.checkExecLimit(costs)
 			if err != nil {
-				return 0, fmt.Errorf("exec: reach the Exec limit %s", err)
+				trap := vm.newTrap(fmt.Errorf("exec: reach the Exec limit %w", err))
+				if unpaid == 0 {
+					// No gas was charged: rewind to the gas counter,
+					// so that resuming charges for this sequence.
+					vm.ctx.pc -= 9
+				}
+				vm.unpaidGas = unpaid
+				vm.suspended = true
+				return nil, trap
 			}
 		case compile.OpJmp:
-			vm.ctx.pc = vm.fetchInt64()
+			target := vm.fetchInt64()
+			backward := target < vm.ctx.pc
+			vm.ctx.pc = target
+			if backward && vm.done != nil {
+				if err := vm.interrupt(); err != nil {
+					return nil, err
+				}
+			}
 			continue
 		case compile.OpJmpZ:
 			target := vm.fetchInt64()
@@ -432,27 +808,23 @@ outer:
 			}
 		case compile.OpJmpNz:
 			target := vm.fetchInt64()
-			preserveTop := vm.fetchBool()
+			preserve := vm.fetchInt64()
 			discard := vm.fetchInt64()
 			if vm.popUint32() != 0 {
+				backward := target < vm.ctx.pc
 				vm.ctx.pc = target
-				var top uint64
-				if preserveTop {
-					top = vm.ctx.stack[len(vm.ctx.stack)-1]
-				}
-				vm.ctx.stack = vm.ctx.stack[:len(vm.ctx.stack)-int(discard)]
-				if preserveTop {
-					vm.pushUint64(top)
+				vm.discard(discard, preserve)
+				if backward && vm.done != nil {
+					if err := vm.interrupt(); err != nil {
+						return nil, err
+					}
 				}
 				continue
 			}
 		case ops.BrTable:
 			index := vm.fetchInt64()
 			label := vm.popInt32()
-			cf, ok := vm.funcs[vm.ctx.curFunc].(compiledFunction)
-			if !ok {
-				panic(fmt.Sprintf("exec: function at index %d is not a compiled function", vm.ctx.curFunc))
-			}
+			cf := vm.compiledFunction(vm.ctx.curFunc)
 			table := cf.branchTables[index]
 			var target compile.Target
 			if label >= 0 && label < int32(len(table.Targets)) {
@@ -462,16 +834,18 @@ outer:
 			}
 
 			if target.Return {
-				break outer
+				if rtrn, done := vm.ret(); done {
+					return rtrn, nil
+				}
+				continue
 			}
+			backward := target.Addr < vm.ctx.pc
 			vm.ctx.pc = target.Addr
-			var top uint64
-			if target.PreserveTop {
-				top = vm.ctx.stack[len(vm.ctx.stack)-1]
-			}
-			vm.ctx.stack = vm.ctx.stack[:len(vm.ctx.stack)-int(target.Discard)]
-			if target.PreserveTop {
-				vm.pushUint64(top)
+			vm.discard(target.Discard, target.Preserve)
+			if backward && vm.done != nil {
+				if err := vm.interrupt(); err != nil {
+					return nil, err
+				}
 			}
 			continue
 		case compile.OpDiscard:
@@ -482,31 +856,85 @@ outer:
 			place := vm.fetchInt64()
 			vm.ctx.stack = vm.ctx.stack[:len(vm.ctx.stack)-int(place)]
 			vm.pushUint64(top)
+		case compile.OpDiscardPreserve:
+			preserve := vm.fetchInt64()
+			place := vm.fetchInt64()
+			vm.discard(place, preserve)
 		default:
 			vm.funcTable[op]()
+			if vm.paused {
+				vm.paused = false
+				vm.suspended = true
+				return nil, nil
+			}
+			if err := vm.limitErr; err != nil {
+				trap := vm.newTrap(fmt.Errorf("exec: reach the Exec limit %w", err))
+				vm.limitErr = nil
+				if vm.unpaidGas == 0 {
+					vm.ctx.pc = start
+				}
+				vm.suspended = true
+				return nil, trap
+			}
 		}
 	}
 
-	if compiled.returns && !vm.abort {
-		return vm.ctx.stack[len(vm.ctx.stack)-1], nil
+	return nil, nil
+}
+
+// ret returns from the current frame. If it is the outermost frame, done is
+// true and rtrn holds its return values, which remain valid until the next
+// execution; otherwise the return values are pushed on the stack of the
+// caller, whose frame becomes the current one.
+func (vm *VM) ret() (rtrn []uint64, done bool) {
+	results := vm.compiledFunction(vm.ctx.curFunc).results
+	rtrn = vm.ctx.stack[len(vm.ctx.stack)-results:]
+	if len(vm.frames) == 0 {
+		return rtrn, true
 	}
-	return 0, nil
+	vm.ctx = vm.frames[len(vm.frames)-1]
+	vm.frames = vm.frames[:len(vm.frames)-1]
+	vm.CallStackDepth++
+	for _, v := range rtrn {
+		vm.pushUint64(v)
+	}
+	return nil, false
+}
+
+// discard removes n values from the stack, below its top preserve values.
+func (vm *VM) discard(n, preserve int64) {
+	stack := vm.ctx.stack
+	copy(stack[int64(len(stack))-n:], stack[int64(len(stack))-preserve:])
+	vm.ctx.stack = stack[:int64(len(stack))-n+preserve]
 }
 
-//check gas
+// CheckExecLimit charges costs to the step and gas budgets of the VM. If the
+// step budget is too small, it is set to zero and nothing else is charged.
+// If the gas budget is too small, it is set to zero.
 func (vm *VM) CheckExecLimit(costs uint64) error {
+	_, err := vm.checkExecLimit(costs)
+	return err
+}
+
+// checkExecLimit implements CheckExecLimit. When the gas budget is
+// exhausted, unpaid is the gas it could not cover.
+func (vm *VM) checkExecLimit(costs uint64) (unpaid uint64, err error) {
 	if *vm.ExecMetrics.ExecStep < costs {
 		*vm.ExecMetrics.ExecStep = 0
-		return errors.New("exec step exhausted")
+		return 0, ErrExecStepExhausted
 	} else {
 		*vm.ExecMetrics.ExecStep -= costs
 	}
 
+	if costs > math.MaxUint64-vm.ExecMetrics.LocalGasCounter {
+		*vm.ExecMetrics.GasLimit = 0
+		return math.MaxUint64, ErrGasExhausted
+	}
 	vm.ExecMetrics.LocalGasCounter += costs
 	normalizationGasLimit := vm.ExecMetrics.LocalGasCounter / vm.ExecMetrics.GasFactor
 
 	if normalizationGasLimit == 0 {
-		return nil
+		return 0, nil
 	}
 
 	vm.ExecMetrics.LocalGasCounter = vm.ExecMetrics.LocalGasCounter % vm.ExecMetrics.GasFactor
@@ -514,19 +942,44 @@ func (vm *VM) CheckExecLimit(costs uint64) error {
 	if *vm.ExecMetrics.GasLimit >= normalizationGasLimit {
 		*vm.ExecMetrics.GasLimit -= normalizationGasLimit
 	} else {
+		unpaid = normalizationGasLimit - *vm.ExecMetrics.GasLimit
 		*vm.ExecMetrics.GasLimit = 0
-		return errors.New("gas exhausted")
+		return unpaid, ErrGasExhausted
 	}
 
-	return nil
+	return 0, nil
 }
 
-func (vm *VM) checkCallStackDepth() {
-	if vm.CallStackDepth <= 0 {
-		panic(ErrCallStackDepthExceed)
+// charge charges costs for the instruction being executed, on top of the
+// cost charged by its gas counter. It returns false if the instruction must
+// not be executed, because the step budget is exhausted. In both cases
+// execCode then suspends the VM: before the instruction if it was not
+// executed, so that resuming charges for it again, and after it if only the
+// gas budget was exhausted, so that resuming first pays the unpaid gas.
+func (vm *VM) charge(costs uint64) bool {
+	unpaid, err := vm.checkExecLimit(costs)
+	if err != nil {
+		vm.limitErr = err
+		vm.unpaidGas = unpaid
 	}
-	vm.CallStackDepth--
+	return err == nil || unpaid != 0
+}
 
+// payUnpaidGas pays the gas left unpaid by the charge that suspended the
+// execution. If the gas budget still does not cover it, the budget is set to
+// zero and the rest stays unpaid.
+func (vm *VM) payUnpaidGas() error {
+	if vm.unpaidGas == 0 {
+		return nil
+	}
+	if *vm.ExecMetrics.GasLimit < vm.unpaidGas {
+		vm.unpaidGas -= *vm.ExecMetrics.GasLimit
+		*vm.ExecMetrics.GasLimit = 0
+		return vm.newTrap(fmt.Errorf("exec: reach the Exec limit %w", ErrGasExhausted))
+	}
+	*vm.ExecMetrics.GasLimit -= vm.unpaidGas
+	vm.unpaidGas = 0
+	return nil
 }
 
 // Process is a proxy passed to host functions in order to access
@@ -540,6 +993,14 @@ func NewProcess(vm *VM) *Process {
 	return &Process{vm: vm}
 }
 
+// process returns the Process passed to the host functions called by vm.
+func (vm *VM) process() *Process {
+	if vm.proc == nil {
+		vm.proc = NewProcess(vm)
+	}
+	return vm.proc
+}
+
 // ReadAt implements the ReaderAt interface: it copies into p
 // the content of memory at offset off.
 func (proc *Process) ReadAt(p []byte, off int64) (int, error) {
@@ -594,6 +1055,14 @@ func (proc *Process) Terminate() {
 	proc.vm.abort = true
 }
 
+// Pause suspends the execution of the current module once the calling host
+// function returns. The values returned by the host function are discarded;
+// the execution is continued by (*VM).Resume, which is given the results of
+// the host function instead.
+func (proc *Process) Pause() {
+	proc.vm.paused = true
+}
+
 func (proc *Process) HostData() interface{} {
 	return proc.vm.HostData
 }
@@ -0,0 +1,848 @@
+;; Test the conversion operators between the integer and floating point
+;; types.
+
+(module
+  (func (export "i64.extend_i32_s") (param $x i32) (result i64) (i64.extend_i32_s (local.get $x)))
+  (func (export "i64.extend_i32_u") (param $x i32) (result i64) (i64.extend_i32_u (local.get $x)))
+  (func (export "i32.wrap_i64") (param $x i64) (result i32) (i32.wrap_i64 (local.get $x)))
+  (func (export "i32.trunc_f32_s") (param $x f32) (result i32) (i32.trunc_f32_s (local.get $x)))
+  (func (export "i32.trunc_f32_u") (param $x f32) (result i32) (i32.trunc_f32_u (local.get $x)))
+  (func (export "i32.trunc_f64_s") (param $x f64) (result i32) (i32.trunc_f64_s (local.get $x)))
+  (func (export "i32.trunc_f64_u") (param $x f64) (result i32) (i32.trunc_f64_u (local.get $x)))
+  (func (export "i64.trunc_f32_s") (param $x f32) (result i64) (i64.trunc_f32_s (local.get $x)))
+  (func (export "i64.trunc_f32_u") (param $x f32) (result i64) (i64.trunc_f32_u (local.get $x)))
+  (func (export "i64.trunc_f64_s") (param $x f64) (result i64) (i64.trunc_f64_s (local.get $x)))
+  (func (export "i64.trunc_f64_u") (param $x f64) (result i64) (i64.trunc_f64_u (local.get $x)))
+  (func (export "f32.convert_i32_s") (param $x i32) (result f32) (f32.convert_i32_s (local.get $x)))
+  (func (export "f32.convert_i32_u") (param $x i32) (result f32) (f32.convert_i32_u (local.get $x)))
+  (func (export "f32.convert_i64_s") (param $x i64) (result f32) (f32.convert_i64_s (local.get $x)))
+  (func (export "f32.convert_i64_u") (param $x i64) (result f32) (f32.convert_i64_u (local.get $x)))
+  (func (export "f64.convert_i32_s") (param $x i32) (result f64) (f64.convert_i32_s (local.get $x)))
+  (func (export "f64.convert_i32_u") (param $x i32) (result f64) (f64.convert_i32_u (local.get $x)))
+  (func (export "f64.convert_i64_s") (param $x i64) (result f64) (f64.convert_i64_s (local.get $x)))
+  (func (export "f64.convert_i64_u") (param $x i64) (result f64) (f64.convert_i64_u (local.get $x)))
+  (func (export "f64.promote_f32") (param $x f32) (result f64) (f64.promote_f32 (local.get $x)))
+  (func (export "f32.demote_f64") (param $x f64) (result f32) (f32.demote_f64 (local.get $x)))
+  (func (export "f32.reinterpret_i32") (param $x i32) (result f32) (f32.reinterpret_i32 (local.get $x)))
+  (func (export "i32.reinterpret_f32") (param $x f32) (result i32) (i32.reinterpret_f32 (local.get $x)))
+  (func (export "f64.reinterpret_i64") (param $x i64) (result f64) (f64.reinterpret_i64 (local.get $x)))
+  (func (export "i64.reinterpret_f64") (param $x f64) (result i64) (i64.reinterpret_f64 (local.get $x)))
+)
+
+(assert_return (invoke "i64.extend_i32_s" (i32.const 0)) (i64.const 0))
+(assert_return (invoke "i64.extend_i32_s" (i32.const 1)) (i64.const 1))
+(assert_return (invoke "i64.extend_i32_s" (i32.const -1)) (i64.const -1))
+(assert_return (invoke "i64.extend_i32_s" (i32.const 10000)) (i64.const 10000))
+(assert_return (invoke "i64.extend_i32_s" (i32.const -10000)) (i64.const -10000))
+(assert_return (invoke "i64.extend_i32_s" (i32.const 2147483647)) (i64.const 2147483647))
+(assert_return (invoke "i64.extend_i32_s" (i32.const -2147483648)) (i64.const -2147483648))
+(assert_return (invoke "i64.extend_i32_s" (i32.const 1234567890)) (i64.const 1234567890))
+(assert_return (invoke "i64.extend_i32_s" (i32.const 16777217)) (i64.const 16777217))
+(assert_return (invoke "i64.extend_i32_s" (i32.const -16777217)) (i64.const -16777217))
+(assert_return (invoke "i64.extend_i32_s" (i32.const 16777219)) (i64.const 16777219))
+(assert_return (invoke "i64.extend_i32_s" (i32.const 2147483520)) (i64.const 2147483520))
+(assert_return (invoke "i64.extend_i32_s" (i32.const 2147483584)) (i64.const 2147483584))
+(assert_return (invoke "i64.extend_i32_s" (i32.const -2147483457)) (i64.const -2147483457))
+
+(assert_return (invoke "i64.extend_i32_u" (i32.const 0)) (i64.const 0))
+(assert_return (invoke "i64.extend_i32_u" (i32.const 1)) (i64.const 1))
+(assert_return (invoke "i64.extend_i32_u" (i32.const -1)) (i64.const 4294967295))
+(assert_return (invoke "i64.extend_i32_u" (i32.const 10000)) (i64.const 10000))
+(assert_return (invoke "i64.extend_i32_u" (i32.const -10000)) (i64.const 4294957296))
+(assert_return (invoke "i64.extend_i32_u" (i32.const 2147483647)) (i64.const 2147483647))
+(assert_return (invoke "i64.extend_i32_u" (i32.const -2147483648)) (i64.const 2147483648))
+(assert_return (invoke "i64.extend_i32_u" (i32.const 1234567890)) (i64.const 1234567890))
+(assert_return (invoke "i64.extend_i32_u" (i32.const 16777217)) (i64.const 16777217))
+(assert_return (invoke "i64.extend_i32_u" (i32.const -16777217)) (i64.const 4278190079))
+(assert_return (invoke "i64.extend_i32_u" (i32.const 16777219)) (i64.const 16777219))
+(assert_return (invoke "i64.extend_i32_u" (i32.const 2147483520)) (i64.const 2147483520))
+(assert_return (invoke "i64.extend_i32_u" (i32.const 2147483584)) (i64.const 2147483584))
+(assert_return (invoke "i64.extend_i32_u" (i32.const -2147483457)) (i64.const 2147483839))
+
+(assert_return (invoke "i32.wrap_i64" (i64.const 0)) (i32.const 0))
+(assert_return (invoke "i32.wrap_i64" (i64.const 1)) (i32.const 1))
+(assert_return (invoke "i32.wrap_i64" (i64.const -1)) (i32.const -1))
+(assert_return (invoke "i32.wrap_i64" (i64.const 10000)) (i32.const 10000))
+(assert_return (invoke "i32.wrap_i64" (i64.const -10000)) (i32.const -10000))
+(assert_return (invoke "i32.wrap_i64" (i64.const 9223372036854775807)) (i32.const -1))
+(assert_return (invoke "i32.wrap_i64" (i64.const -9223372036854775808)) (i32.const 0))
+(assert_return (invoke "i32.wrap_i64" (i64.const -2147483649)) (i32.const 2147483647))
+(assert_return (invoke "i32.wrap_i64" (i64.const -4294967296)) (i32.const 0))
+(assert_return (invoke "i32.wrap_i64" (i64.const -4294967297)) (i32.const -1))
+(assert_return (invoke "i32.wrap_i64" (i64.const -4294967295)) (i32.const 1))
+(assert_return (invoke "i32.wrap_i64" (i64.const 4294967295)) (i32.const -1))
+(assert_return (invoke "i32.wrap_i64" (i64.const 4294967296)) (i32.const 0))
+(assert_return (invoke "i32.wrap_i64" (i64.const 4294967297)) (i32.const 1))
+(assert_return (invoke "i32.wrap_i64" (i64.const 1311768467463790320)) (i32.const -1698898192))
+(assert_return (invoke "i32.wrap_i64" (i64.const 314159265358979)) (i32.const -412474237))
+(assert_return (invoke "i32.wrap_i64" (i64.const 16777217)) (i32.const 16777217))
+(assert_return (invoke "i32.wrap_i64" (i64.const -16777217)) (i32.const -16777217))
+(assert_return (invoke "i32.wrap_i64" (i64.const 9007199254740993)) (i32.const 1))
+(assert_return (invoke "i32.wrap_i64" (i64.const -9007199254740993)) (i32.const -1))
+(assert_return (invoke "i32.wrap_i64" (i64.const 9007199791611905)) (i32.const 536870913))
+(assert_return (invoke "i32.wrap_i64" (i64.const 9223371212221054977)) (i32.const 1))
+(assert_return (invoke "i32.wrap_i64" (i64.const -9223371487098961919)) (i32.const 1))
+(assert_return (invoke "i32.wrap_i64" (i64.const -1649267441663)) (i32.const 1))
+(assert_return (invoke "i32.wrap_i64" (i64.const -9223371761976868863)) (i32.const 1))
+
+(assert_return (invoke "i32.trunc_f32_s" (f32.const 0x0p+0)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f32_s" (f32.const 0x1p+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f32_s" (f32.const 0x1.19999ap+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f32_s" (f32.const 0x1.8p+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f32_s" (f32.const 0x1.e66666p+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f32_s" (f32.const 0x1p+1)) (i32.const 2))
+(assert_return (invoke "i32.trunc_f32_s" (f32.const -0x1p+0)) (i32.const -1))
+(assert_return (invoke "i32.trunc_f32_s" (f32.const -0x1.19999ap+0)) (i32.const -1))
+(assert_return (invoke "i32.trunc_f32_s" (f32.const -0x1.8p+0)) (i32.const -1))
+(assert_return (invoke "i32.trunc_f32_s" (f32.const -0x1.e66666p+0)) (i32.const -1))
+(assert_return (invoke "i32.trunc_f32_s" (f32.const -0x1p+1)) (i32.const -2))
+(assert_return (invoke "i32.trunc_f32_s" (f32.const -0x1.ccccccp-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f32_s" (f32.const 0x1.ccccccp-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f32_s" (f32.const 0x1.fffffep+30)) (i32.const 2147483520))
+(assert_return (invoke "i32.trunc_f32_s" (f32.const -0x1p+31)) (i32.const -2147483648))
+(assert_trap (invoke "i32.trunc_f32_s" (f32.const 0x1p+31)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_s" (f32.const -0x1.000002p+31)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_s" (f32.const 0x1.fffffep+31)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_s" (f32.const 0x1p+32)) "integer overflow")
+(assert_return (invoke "i32.trunc_f32_s" (f32.const 0x1.7d784p+26)) (i32.const 100000000))
+(assert_trap (invoke "i32.trunc_f32_s" (f32.const 0x1.fffffep+62)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_s" (f32.const -0x1p+63)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_s" (f32.const 0x1p+63)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_s" (f32.const -0x1.000002p+63)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_s" (f32.const 0x1.fffffep+63)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_s" (f32.const 0x1p+64)) "integer overflow")
+(assert_return (invoke "i32.trunc_f32_s" (f32.const -0x0p+0)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f32_s" (f32.const 0x1p-149)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f32_s" (f32.const -0x1p-149)) (i32.const 0))
+(assert_trap (invoke "i32.trunc_f32_s" (f32.const 0x1.fffffep+127)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_s" (f32.const inf)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_s" (f32.const -inf)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_s" (f32.const nan)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_f32_s" (f32.const -nan)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_f32_s" (f32.const nan:0x200000)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_f32_s" (f32.const -nan:0x200000)) "invalid conversion to integer")
+
+(assert_return (invoke "i32.trunc_f32_u" (f32.const 0x0p+0)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f32_u" (f32.const 0x1p+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f32_u" (f32.const 0x1.19999ap+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f32_u" (f32.const 0x1.8p+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f32_u" (f32.const 0x1.e66666p+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f32_u" (f32.const 0x1p+1)) (i32.const 2))
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const -0x1p+0)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const -0x1.19999ap+0)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const -0x1.8p+0)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const -0x1.e66666p+0)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const -0x1p+1)) "integer overflow")
+(assert_return (invoke "i32.trunc_f32_u" (f32.const -0x1.ccccccp-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f32_u" (f32.const 0x1.ccccccp-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f32_u" (f32.const 0x1.fffffep+30)) (i32.const 2147483520))
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const -0x1p+31)) "integer overflow")
+(assert_return (invoke "i32.trunc_f32_u" (f32.const 0x1p+31)) (i32.const -2147483648))
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const -0x1.000002p+31)) "integer overflow")
+(assert_return (invoke "i32.trunc_f32_u" (f32.const 0x1.fffffep+31)) (i32.const -256))
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const 0x1p+32)) "integer overflow")
+(assert_return (invoke "i32.trunc_f32_u" (f32.const 0x1.7d784p+26)) (i32.const 100000000))
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const 0x1.fffffep+62)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const -0x1p+63)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const 0x1p+63)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const -0x1.000002p+63)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const 0x1.fffffep+63)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const 0x1p+64)) "integer overflow")
+(assert_return (invoke "i32.trunc_f32_u" (f32.const -0x0p+0)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f32_u" (f32.const 0x1p-149)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f32_u" (f32.const -0x1p-149)) (i32.const 0))
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const 0x1.fffffep+127)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const inf)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const -inf)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const nan)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const -nan)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const nan:0x200000)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_f32_u" (f32.const -nan:0x200000)) "invalid conversion to integer")
+
+(assert_return (invoke "i32.trunc_f64_s" (f64.const 0x0p+0)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const 0x1p+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const 0x1.199999999999ap+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const 0x1.8p+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const 0x1.e666666666666p+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const 0x1p+1)) (i32.const 2))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const -0x1p+0)) (i32.const -1))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const -0x1.199999999999ap+0)) (i32.const -1))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const -0x1.8p+0)) (i32.const -1))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const -0x1.e666666666666p+0)) (i32.const -1))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const -0x1p+1)) (i32.const -2))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const -0x1.ccccccccccccdp-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const 0x1.ccccccccccccdp-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const 0x1.fffffffcp+30)) (i32.const 2147483647))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const -0x1p+31)) (i32.const -2147483648))
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const 0x1p+31)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const -0x1.00000002p+31)) "integer overflow")
+(assert_return (invoke "i32.trunc_f64_s" (f64.const 0x1.ffffffff9999ap+30)) (i32.const 2147483647))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const -0x1.00000001ccccdp+31)) (i32.const -2147483648))
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const 0x1.fffffffep+31)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const 0x1p+32)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const 0x1.ffffffffccccdp+31)) "integer overflow")
+(assert_return (invoke "i32.trunc_f64_s" (f64.const -0x1.ffffffaa19c47p-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const 0x1.7d784p+26)) (i32.const 100000000))
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const 0x1.1c37937e08p+53)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const 0x1.93e5939a08ceap+99)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const 0x1.fffffffffffffp+62)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const -0x1p+63)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const 0x1p+63)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const -0x1.0000000000001p+63)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const 0x1.fffffffffffffp+63)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const 0x1p+64)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const 0x1.fffffep+127)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const 0x1.ffffffp+127)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const 0x1.ffffff0000001p+127)) "integer overflow")
+(assert_return (invoke "i32.trunc_f64_s" (f64.const 0x1p-149)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const 0x1p-150)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const 0x1.0000000000001p-150)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const 0x1.fffffep-127)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const 0x1p-126)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const 0x1.000001p+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const 0x1.0000010000001p+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const 0x1.000003p+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const -0x0p+0)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const 0x1p-1074)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_s" (f64.const -0x1p-1074)) (i32.const 0))
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const 0x1.fffffffffffffp+1023)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const inf)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const -inf)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const nan)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const -nan)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const nan:0x4000000000000)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const -nan:0x4000000000000)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_f64_s" (f64.const nan:0x8000000000001)) "invalid conversion to integer")
+
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x0p+0)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1p+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1.199999999999ap+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1.8p+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1.e666666666666p+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1p+1)) (i32.const 2))
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const -0x1p+0)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const -0x1.199999999999ap+0)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const -0x1.8p+0)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const -0x1.e666666666666p+0)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const -0x1p+1)) "integer overflow")
+(assert_return (invoke "i32.trunc_f64_u" (f64.const -0x1.ccccccccccccdp-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1.ccccccccccccdp-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1.fffffffcp+30)) (i32.const 2147483647))
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const -0x1p+31)) "integer overflow")
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1p+31)) (i32.const -2147483648))
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const -0x1.00000002p+31)) "integer overflow")
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1.ffffffff9999ap+30)) (i32.const 2147483647))
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const -0x1.00000001ccccdp+31)) "integer overflow")
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1.fffffffep+31)) (i32.const -1))
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const 0x1p+32)) "integer overflow")
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1.ffffffffccccdp+31)) (i32.const -1))
+(assert_return (invoke "i32.trunc_f64_u" (f64.const -0x1.ffffffaa19c47p-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1.7d784p+26)) (i32.const 100000000))
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const 0x1.1c37937e08p+53)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const 0x1.93e5939a08ceap+99)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const 0x1.fffffffffffffp+62)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const -0x1p+63)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const 0x1p+63)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const -0x1.0000000000001p+63)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const 0x1.fffffffffffffp+63)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const 0x1p+64)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const 0x1.fffffep+127)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const 0x1.ffffffp+127)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const 0x1.ffffff0000001p+127)) "integer overflow")
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1p-149)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1p-150)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1.0000000000001p-150)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1.fffffep-127)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1p-126)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1.000001p+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1.0000010000001p+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1.000003p+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_f64_u" (f64.const -0x0p+0)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_u" (f64.const 0x1p-1074)) (i32.const 0))
+(assert_return (invoke "i32.trunc_f64_u" (f64.const -0x1p-1074)) (i32.const 0))
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const 0x1.fffffffffffffp+1023)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const inf)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const -inf)) "integer overflow")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const nan)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const -nan)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const nan:0x4000000000000)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const -nan:0x4000000000000)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_f64_u" (f64.const nan:0x8000000000001)) "invalid conversion to integer")
+
+(assert_return (invoke "i64.trunc_f32_s" (f32.const 0x0p+0)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const 0x1p+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const 0x1.19999ap+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const 0x1.8p+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const 0x1.e66666p+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const 0x1p+1)) (i64.const 2))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const -0x1p+0)) (i64.const -1))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const -0x1.19999ap+0)) (i64.const -1))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const -0x1.8p+0)) (i64.const -1))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const -0x1.e66666p+0)) (i64.const -1))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const -0x1p+1)) (i64.const -2))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const -0x1.ccccccp-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const 0x1.ccccccp-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const 0x1.fffffep+30)) (i64.const 2147483520))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const -0x1p+31)) (i64.const -2147483648))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const 0x1p+31)) (i64.const 2147483648))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const -0x1.000002p+31)) (i64.const -2147483904))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const 0x1.fffffep+31)) (i64.const 4294967040))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const 0x1p+32)) (i64.const 4294967296))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const 0x1.7d784p+26)) (i64.const 100000000))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const 0x1.fffffep+62)) (i64.const 9223371487098961920))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const -0x1p+63)) (i64.const -9223372036854775808))
+(assert_trap (invoke "i64.trunc_f32_s" (f32.const 0x1p+63)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f32_s" (f32.const -0x1.000002p+63)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f32_s" (f32.const 0x1.fffffep+63)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f32_s" (f32.const 0x1p+64)) "integer overflow")
+(assert_return (invoke "i64.trunc_f32_s" (f32.const -0x0p+0)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const 0x1p-149)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f32_s" (f32.const -0x1p-149)) (i64.const 0))
+(assert_trap (invoke "i64.trunc_f32_s" (f32.const 0x1.fffffep+127)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f32_s" (f32.const inf)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f32_s" (f32.const -inf)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f32_s" (f32.const nan)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_f32_s" (f32.const -nan)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_f32_s" (f32.const nan:0x200000)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_f32_s" (f32.const -nan:0x200000)) "invalid conversion to integer")
+
+(assert_return (invoke "i64.trunc_f32_u" (f32.const 0x0p+0)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f32_u" (f32.const 0x1p+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f32_u" (f32.const 0x1.19999ap+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f32_u" (f32.const 0x1.8p+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f32_u" (f32.const 0x1.e66666p+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f32_u" (f32.const 0x1p+1)) (i64.const 2))
+(assert_trap (invoke "i64.trunc_f32_u" (f32.const -0x1p+0)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f32_u" (f32.const -0x1.19999ap+0)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f32_u" (f32.const -0x1.8p+0)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f32_u" (f32.const -0x1.e66666p+0)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f32_u" (f32.const -0x1p+1)) "integer overflow")
+(assert_return (invoke "i64.trunc_f32_u" (f32.const -0x1.ccccccp-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f32_u" (f32.const 0x1.ccccccp-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f32_u" (f32.const 0x1.fffffep+30)) (i64.const 2147483520))
+(assert_trap (invoke "i64.trunc_f32_u" (f32.const -0x1p+31)) "integer overflow")
+(assert_return (invoke "i64.trunc_f32_u" (f32.const 0x1p+31)) (i64.const 2147483648))
+(assert_trap (invoke "i64.trunc_f32_u" (f32.const -0x1.000002p+31)) "integer overflow")
+(assert_return (invoke "i64.trunc_f32_u" (f32.const 0x1.fffffep+31)) (i64.const 4294967040))
+(assert_return (invoke "i64.trunc_f32_u" (f32.const 0x1p+32)) (i64.const 4294967296))
+(assert_return (invoke "i64.trunc_f32_u" (f32.const 0x1.7d784p+26)) (i64.const 100000000))
+(assert_return (invoke "i64.trunc_f32_u" (f32.const 0x1.fffffep+62)) (i64.const 9223371487098961920))
+(assert_trap (invoke "i64.trunc_f32_u" (f32.const -0x1p+63)) "integer overflow")
+(assert_return (invoke "i64.trunc_f32_u" (f32.const 0x1p+63)) (i64.const -9223372036854775808))
+(assert_trap (invoke "i64.trunc_f32_u" (f32.const -0x1.000002p+63)) "integer overflow")
+(assert_return (invoke "i64.trunc_f32_u" (f32.const 0x1.fffffep+63)) (i64.const -1099511627776))
+(assert_trap (invoke "i64.trunc_f32_u" (f32.const 0x1p+64)) "integer overflow")
+(assert_return (invoke "i64.trunc_f32_u" (f32.const -0x0p+0)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f32_u" (f32.const 0x1p-149)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f32_u" (f32.const -0x1p-149)) (i64.const 0))
+(assert_trap (invoke "i64.trunc_f32_u" (f32.const 0x1.fffffep+127)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f32_u" (f32.const inf)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f32_u" (f32.const -inf)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f32_u" (f32.const nan)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_f32_u" (f32.const -nan)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_f32_u" (f32.const nan:0x200000)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_f32_u" (f32.const -nan:0x200000)) "invalid conversion to integer")
+
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x0p+0)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1p+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1.199999999999ap+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1.8p+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1.e666666666666p+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1p+1)) (i64.const 2))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const -0x1p+0)) (i64.const -1))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const -0x1.199999999999ap+0)) (i64.const -1))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const -0x1.8p+0)) (i64.const -1))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const -0x1.e666666666666p+0)) (i64.const -1))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const -0x1p+1)) (i64.const -2))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const -0x1.ccccccccccccdp-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1.ccccccccccccdp-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1.fffffffcp+30)) (i64.const 2147483647))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const -0x1p+31)) (i64.const -2147483648))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1p+31)) (i64.const 2147483648))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const -0x1.00000002p+31)) (i64.const -2147483649))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1.ffffffff9999ap+30)) (i64.const 2147483647))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const -0x1.00000001ccccdp+31)) (i64.const -2147483648))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1.fffffffep+31)) (i64.const 4294967295))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1p+32)) (i64.const 4294967296))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1.ffffffffccccdp+31)) (i64.const 4294967295))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const -0x1.ffffffaa19c47p-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1.7d784p+26)) (i64.const 100000000))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1.1c37937e08p+53)) (i64.const 10000000000000000))
+(assert_trap (invoke "i64.trunc_f64_s" (f64.const 0x1.93e5939a08ceap+99)) "integer overflow")
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1.fffffffffffffp+62)) (i64.const 9223372036854774784))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const -0x1p+63)) (i64.const -9223372036854775808))
+(assert_trap (invoke "i64.trunc_f64_s" (f64.const 0x1p+63)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f64_s" (f64.const -0x1.0000000000001p+63)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f64_s" (f64.const 0x1.fffffffffffffp+63)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f64_s" (f64.const 0x1p+64)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f64_s" (f64.const 0x1.fffffep+127)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f64_s" (f64.const 0x1.ffffffp+127)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f64_s" (f64.const 0x1.ffffff0000001p+127)) "integer overflow")
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1p-149)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1p-150)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1.0000000000001p-150)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1.fffffep-127)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1p-126)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1.000001p+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1.0000010000001p+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1.000003p+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const -0x0p+0)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const 0x1p-1074)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_s" (f64.const -0x1p-1074)) (i64.const 0))
+(assert_trap (invoke "i64.trunc_f64_s" (f64.const 0x1.fffffffffffffp+1023)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f64_s" (f64.const inf)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f64_s" (f64.const -inf)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f64_s" (f64.const nan)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_f64_s" (f64.const -nan)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_f64_s" (f64.const nan:0x4000000000000)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_f64_s" (f64.const -nan:0x4000000000000)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_f64_s" (f64.const nan:0x8000000000001)) "invalid conversion to integer")
+
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x0p+0)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1p+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1.199999999999ap+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1.8p+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1.e666666666666p+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1p+1)) (i64.const 2))
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const -0x1p+0)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const -0x1.199999999999ap+0)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const -0x1.8p+0)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const -0x1.e666666666666p+0)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const -0x1p+1)) "integer overflow")
+(assert_return (invoke "i64.trunc_f64_u" (f64.const -0x1.ccccccccccccdp-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1.ccccccccccccdp-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1.fffffffcp+30)) (i64.const 2147483647))
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const -0x1p+31)) "integer overflow")
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1p+31)) (i64.const 2147483648))
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const -0x1.00000002p+31)) "integer overflow")
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1.ffffffff9999ap+30)) (i64.const 2147483647))
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const -0x1.00000001ccccdp+31)) "integer overflow")
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1.fffffffep+31)) (i64.const 4294967295))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1p+32)) (i64.const 4294967296))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1.ffffffffccccdp+31)) (i64.const 4294967295))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const -0x1.ffffffaa19c47p-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1.7d784p+26)) (i64.const 100000000))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1.1c37937e08p+53)) (i64.const 10000000000000000))
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const 0x1.93e5939a08ceap+99)) "integer overflow")
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1.fffffffffffffp+62)) (i64.const 9223372036854774784))
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const -0x1p+63)) "integer overflow")
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1p+63)) (i64.const -9223372036854775808))
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const -0x1.0000000000001p+63)) "integer overflow")
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1.fffffffffffffp+63)) (i64.const -2048))
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const 0x1p+64)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const 0x1.fffffep+127)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const 0x1.ffffffp+127)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const 0x1.ffffff0000001p+127)) "integer overflow")
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1p-149)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1p-150)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1.0000000000001p-150)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1.fffffep-127)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1p-126)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1.000001p+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1.0000010000001p+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1.000003p+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const -0x0p+0)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const 0x1p-1074)) (i64.const 0))
+(assert_return (invoke "i64.trunc_f64_u" (f64.const -0x1p-1074)) (i64.const 0))
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const 0x1.fffffffffffffp+1023)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const inf)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const -inf)) "integer overflow")
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const nan)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const -nan)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const nan:0x4000000000000)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const -nan:0x4000000000000)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_f64_u" (f64.const nan:0x8000000000001)) "invalid conversion to integer")
+
+(assert_return (invoke "f32.convert_i32_s" (i32.const 0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.convert_i32_s" (i32.const 1)) (f32.const 0x1p+0))
+(assert_return (invoke "f32.convert_i32_s" (i32.const -1)) (f32.const -0x1p+0))
+(assert_return (invoke "f32.convert_i32_s" (i32.const 10000)) (f32.const 0x1.388p+13))
+(assert_return (invoke "f32.convert_i32_s" (i32.const -10000)) (f32.const -0x1.388p+13))
+(assert_return (invoke "f32.convert_i32_s" (i32.const 2147483647)) (f32.const 0x1p+31))
+(assert_return (invoke "f32.convert_i32_s" (i32.const -2147483648)) (f32.const -0x1p+31))
+(assert_return (invoke "f32.convert_i32_s" (i32.const 1234567890)) (f32.const 0x1.26580cp+30))
+(assert_return (invoke "f32.convert_i32_s" (i32.const 16777217)) (f32.const 0x1p+24))
+(assert_return (invoke "f32.convert_i32_s" (i32.const -16777217)) (f32.const -0x1p+24))
+(assert_return (invoke "f32.convert_i32_s" (i32.const 16777219)) (f32.const 0x1.000004p+24))
+(assert_return (invoke "f32.convert_i32_s" (i32.const 2147483520)) (f32.const 0x1.fffffep+30))
+(assert_return (invoke "f32.convert_i32_s" (i32.const 2147483584)) (f32.const 0x1p+31))
+(assert_return (invoke "f32.convert_i32_s" (i32.const -2147483457)) (f32.const -0x1.fffffep+30))
+
+(assert_return (invoke "f32.convert_i32_u" (i32.const 0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.convert_i32_u" (i32.const 1)) (f32.const 0x1p+0))
+(assert_return (invoke "f32.convert_i32_u" (i32.const -1)) (f32.const 0x1p+32))
+(assert_return (invoke "f32.convert_i32_u" (i32.const 10000)) (f32.const 0x1.388p+13))
+(assert_return (invoke "f32.convert_i32_u" (i32.const -10000)) (f32.const 0x1.ffffb2p+31))
+(assert_return (invoke "f32.convert_i32_u" (i32.const 2147483647)) (f32.const 0x1p+31))
+(assert_return (invoke "f32.convert_i32_u" (i32.const -2147483648)) (f32.const 0x1p+31))
+(assert_return (invoke "f32.convert_i32_u" (i32.const 1234567890)) (f32.const 0x1.26580cp+30))
+(assert_return (invoke "f32.convert_i32_u" (i32.const 16777217)) (f32.const 0x1p+24))
+(assert_return (invoke "f32.convert_i32_u" (i32.const -16777217)) (f32.const 0x1.fep+31))
+(assert_return (invoke "f32.convert_i32_u" (i32.const 16777219)) (f32.const 0x1.000004p+24))
+(assert_return (invoke "f32.convert_i32_u" (i32.const 2147483520)) (f32.const 0x1.fffffep+30))
+(assert_return (invoke "f32.convert_i32_u" (i32.const 2147483584)) (f32.const 0x1p+31))
+(assert_return (invoke "f32.convert_i32_u" (i32.const -2147483457)) (f32.const 0x1.000002p+31))
+
+(assert_return (invoke "f32.convert_i64_s" (i64.const 0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.convert_i64_s" (i64.const 1)) (f32.const 0x1p+0))
+(assert_return (invoke "f32.convert_i64_s" (i64.const -1)) (f32.const -0x1p+0))
+(assert_return (invoke "f32.convert_i64_s" (i64.const 10000)) (f32.const 0x1.388p+13))
+(assert_return (invoke "f32.convert_i64_s" (i64.const -10000)) (f32.const -0x1.388p+13))
+(assert_return (invoke "f32.convert_i64_s" (i64.const 9223372036854775807)) (f32.const 0x1p+63))
+(assert_return (invoke "f32.convert_i64_s" (i64.const -9223372036854775808)) (f32.const -0x1p+63))
+(assert_return (invoke "f32.convert_i64_s" (i64.const -2147483649)) (f32.const -0x1p+31))
+(assert_return (invoke "f32.convert_i64_s" (i64.const -4294967296)) (f32.const -0x1p+32))
+(assert_return (invoke "f32.convert_i64_s" (i64.const -4294967297)) (f32.const -0x1p+32))
+(assert_return (invoke "f32.convert_i64_s" (i64.const -4294967295)) (f32.const -0x1p+32))
+(assert_return (invoke "f32.convert_i64_s" (i64.const 4294967295)) (f32.const 0x1p+32))
+(assert_return (invoke "f32.convert_i64_s" (i64.const 4294967296)) (f32.const 0x1p+32))
+(assert_return (invoke "f32.convert_i64_s" (i64.const 4294967297)) (f32.const 0x1p+32))
+(assert_return (invoke "f32.convert_i64_s" (i64.const 1311768467463790320)) (f32.const 0x1.234568p+60))
+(assert_return (invoke "f32.convert_i64_s" (i64.const 314159265358979)) (f32.const 0x1.1db9e8p+48))
+(assert_return (invoke "f32.convert_i64_s" (i64.const 16777217)) (f32.const 0x1p+24))
+(assert_return (invoke "f32.convert_i64_s" (i64.const -16777217)) (f32.const -0x1p+24))
+(assert_return (invoke "f32.convert_i64_s" (i64.const 9007199254740993)) (f32.const 0x1p+53))
+(assert_return (invoke "f32.convert_i64_s" (i64.const -9007199254740993)) (f32.const -0x1p+53))
+(assert_return (invoke "f32.convert_i64_s" (i64.const 9007199791611905)) (f32.const 0x1.000002p+53))
+(assert_return (invoke "f32.convert_i64_s" (i64.const 9223371212221054977)) (f32.const 0x1.fffffep+62))
+(assert_return (invoke "f32.convert_i64_s" (i64.const -9223371487098961919)) (f32.const -0x1.fffffep+62))
+(assert_return (invoke "f32.convert_i64_s" (i64.const -1649267441663)) (f32.const -0x1.8p+40))
+(assert_return (invoke "f32.convert_i64_s" (i64.const -9223371761976868863)) (f32.const -0x1.fffffep+62))
+
+(assert_return (invoke "f32.convert_i64_u" (i64.const 0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.convert_i64_u" (i64.const 1)) (f32.const 0x1p+0))
+(assert_return (invoke "f32.convert_i64_u" (i64.const -1)) (f32.const 0x1p+64))
+(assert_return (invoke "f32.convert_i64_u" (i64.const 10000)) (f32.const 0x1.388p+13))
+(assert_return (invoke "f32.convert_i64_u" (i64.const -10000)) (f32.const 0x1p+64))
+(assert_return (invoke "f32.convert_i64_u" (i64.const 9223372036854775807)) (f32.const 0x1p+63))
+(assert_return (invoke "f32.convert_i64_u" (i64.const -9223372036854775808)) (f32.const 0x1p+63))
+(assert_return (invoke "f32.convert_i64_u" (i64.const -2147483649)) (f32.const 0x1p+64))
+(assert_return (invoke "f32.convert_i64_u" (i64.const -4294967296)) (f32.const 0x1p+64))
+(assert_return (invoke "f32.convert_i64_u" (i64.const -4294967297)) (f32.const 0x1p+64))
+(assert_return (invoke "f32.convert_i64_u" (i64.const -4294967295)) (f32.const 0x1p+64))
+(assert_return (invoke "f32.convert_i64_u" (i64.const 4294967295)) (f32.const 0x1p+32))
+(assert_return (invoke "f32.convert_i64_u" (i64.const 4294967296)) (f32.const 0x1p+32))
+(assert_return (invoke "f32.convert_i64_u" (i64.const 4294967297)) (f32.const 0x1p+32))
+(assert_return (invoke "f32.convert_i64_u" (i64.const 1311768467463790320)) (f32.const 0x1.234568p+60))
+(assert_return (invoke "f32.convert_i64_u" (i64.const 314159265358979)) (f32.const 0x1.1db9e8p+48))
+(assert_return (invoke "f32.convert_i64_u" (i64.const 16777217)) (f32.const 0x1p+24))
+(assert_return (invoke "f32.convert_i64_u" (i64.const -16777217)) (f32.const 0x1p+64))
+(assert_return (invoke "f32.convert_i64_u" (i64.const 9007199254740993)) (f32.const 0x1p+53))
+(assert_return (invoke "f32.convert_i64_u" (i64.const -9007199254740993)) (f32.const 0x1.ffcp+63))
+(assert_return (invoke "f32.convert_i64_u" (i64.const 9007199791611905)) (f32.const 0x1.000002p+53))
+(assert_return (invoke "f32.convert_i64_u" (i64.const 9223371212221054977)) (f32.const 0x1.fffffep+62))
+(assert_return (invoke "f32.convert_i64_u" (i64.const -9223371487098961919)) (f32.const 0x1.000002p+63))
+(assert_return (invoke "f32.convert_i64_u" (i64.const -1649267441663)) (f32.const 0x1.fffffep+63))
+(assert_return (invoke "f32.convert_i64_u" (i64.const -9223371761976868863)) (f32.const 0x1p+63))
+
+(assert_return (invoke "f64.convert_i32_s" (i32.const 0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.convert_i32_s" (i32.const 1)) (f64.const 0x1p+0))
+(assert_return (invoke "f64.convert_i32_s" (i32.const -1)) (f64.const -0x1p+0))
+(assert_return (invoke "f64.convert_i32_s" (i32.const 10000)) (f64.const 0x1.388p+13))
+(assert_return (invoke "f64.convert_i32_s" (i32.const -10000)) (f64.const -0x1.388p+13))
+(assert_return (invoke "f64.convert_i32_s" (i32.const 2147483647)) (f64.const 0x1.fffffffcp+30))
+(assert_return (invoke "f64.convert_i32_s" (i32.const -2147483648)) (f64.const -0x1p+31))
+(assert_return (invoke "f64.convert_i32_s" (i32.const 1234567890)) (f64.const 0x1.26580b48p+30))
+(assert_return (invoke "f64.convert_i32_s" (i32.const 16777217)) (f64.const 0x1.000001p+24))
+(assert_return (invoke "f64.convert_i32_s" (i32.const -16777217)) (f64.const -0x1.000001p+24))
+(assert_return (invoke "f64.convert_i32_s" (i32.const 16777219)) (f64.const 0x1.000003p+24))
+(assert_return (invoke "f64.convert_i32_s" (i32.const 2147483520)) (f64.const 0x1.fffffep+30))
+(assert_return (invoke "f64.convert_i32_s" (i32.const 2147483584)) (f64.const 0x1.ffffffp+30))
+(assert_return (invoke "f64.convert_i32_s" (i32.const -2147483457)) (f64.const -0x1.fffffd04p+30))
+
+(assert_return (invoke "f64.convert_i32_u" (i32.const 0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.convert_i32_u" (i32.const 1)) (f64.const 0x1p+0))
+(assert_return (invoke "f64.convert_i32_u" (i32.const -1)) (f64.const 0x1.fffffffep+31))
+(assert_return (invoke "f64.convert_i32_u" (i32.const 10000)) (f64.const 0x1.388p+13))
+(assert_return (invoke "f64.convert_i32_u" (i32.const -10000)) (f64.const 0x1.ffffb1ep+31))
+(assert_return (invoke "f64.convert_i32_u" (i32.const 2147483647)) (f64.const 0x1.fffffffcp+30))
+(assert_return (invoke "f64.convert_i32_u" (i32.const -2147483648)) (f64.const 0x1p+31))
+(assert_return (invoke "f64.convert_i32_u" (i32.const 1234567890)) (f64.const 0x1.26580b48p+30))
+(assert_return (invoke "f64.convert_i32_u" (i32.const 16777217)) (f64.const 0x1.000001p+24))
+(assert_return (invoke "f64.convert_i32_u" (i32.const -16777217)) (f64.const 0x1.fdfffffep+31))
+(assert_return (invoke "f64.convert_i32_u" (i32.const 16777219)) (f64.const 0x1.000003p+24))
+(assert_return (invoke "f64.convert_i32_u" (i32.const 2147483520)) (f64.const 0x1.fffffep+30))
+(assert_return (invoke "f64.convert_i32_u" (i32.const 2147483584)) (f64.const 0x1.ffffffp+30))
+(assert_return (invoke "f64.convert_i32_u" (i32.const -2147483457)) (f64.const 0x1.0000017ep+31))
+
+(assert_return (invoke "f64.convert_i64_s" (i64.const 0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.convert_i64_s" (i64.const 1)) (f64.const 0x1p+0))
+(assert_return (invoke "f64.convert_i64_s" (i64.const -1)) (f64.const -0x1p+0))
+(assert_return (invoke "f64.convert_i64_s" (i64.const 10000)) (f64.const 0x1.388p+13))
+(assert_return (invoke "f64.convert_i64_s" (i64.const -10000)) (f64.const -0x1.388p+13))
+(assert_return (invoke "f64.convert_i64_s" (i64.const 9223372036854775807)) (f64.const 0x1p+63))
+(assert_return (invoke "f64.convert_i64_s" (i64.const -9223372036854775808)) (f64.const -0x1p+63))
+(assert_return (invoke "f64.convert_i64_s" (i64.const -2147483649)) (f64.const -0x1.00000002p+31))
+(assert_return (invoke "f64.convert_i64_s" (i64.const -4294967296)) (f64.const -0x1p+32))
+(assert_return (invoke "f64.convert_i64_s" (i64.const -4294967297)) (f64.const -0x1.00000001p+32))
+(assert_return (invoke "f64.convert_i64_s" (i64.const -4294967295)) (f64.const -0x1.fffffffep+31))
+(assert_return (invoke "f64.convert_i64_s" (i64.const 4294967295)) (f64.const 0x1.fffffffep+31))
+(assert_return (invoke "f64.convert_i64_s" (i64.const 4294967296)) (f64.const 0x1p+32))
+(assert_return (invoke "f64.convert_i64_s" (i64.const 4294967297)) (f64.const 0x1.00000001p+32))
+(assert_return (invoke "f64.convert_i64_s" (i64.const 1311768467463790320)) (f64.const 0x1.23456789abcdfp+60))
+(assert_return (invoke "f64.convert_i64_s" (i64.const 314159265358979)) (f64.const 0x1.1db9e76a2483p+48))
+(assert_return (invoke "f64.convert_i64_s" (i64.const 16777217)) (f64.const 0x1.000001p+24))
+(assert_return (invoke "f64.convert_i64_s" (i64.const -16777217)) (f64.const -0x1.000001p+24))
+(assert_return (invoke "f64.convert_i64_s" (i64.const 9007199254740993)) (f64.const 0x1p+53))
+(assert_return (invoke "f64.convert_i64_s" (i64.const -9007199254740993)) (f64.const -0x1p+53))
+(assert_return (invoke "f64.convert_i64_s" (i64.const 9007199791611905)) (f64.const 0x1.000001p+53))
+(assert_return (invoke "f64.convert_i64_s" (i64.const 9223371212221054977)) (f64.const 0x1.fffffdp+62))
+(assert_return (invoke "f64.convert_i64_s" (i64.const -9223371487098961919)) (f64.const -0x1.fffffep+62))
+(assert_return (invoke "f64.convert_i64_s" (i64.const -1649267441663)) (f64.const -0x1.7fffffffffp+40))
+(assert_return (invoke "f64.convert_i64_s" (i64.const -9223371761976868863)) (f64.const -0x1.ffffffp+62))
+
+(assert_return (invoke "f64.convert_i64_u" (i64.const 0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.convert_i64_u" (i64.const 1)) (f64.const 0x1p+0))
+(assert_return (invoke "f64.convert_i64_u" (i64.const -1)) (f64.const 0x1p+64))
+(assert_return (invoke "f64.convert_i64_u" (i64.const 10000)) (f64.const 0x1.388p+13))
+(assert_return (invoke "f64.convert_i64_u" (i64.const -10000)) (f64.const 0x1.ffffffffffffbp+63))
+(assert_return (invoke "f64.convert_i64_u" (i64.const 9223372036854775807)) (f64.const 0x1p+63))
+(assert_return (invoke "f64.convert_i64_u" (i64.const -9223372036854775808)) (f64.const 0x1p+63))
+(assert_return (invoke "f64.convert_i64_u" (i64.const -2147483649)) (f64.const 0x1.ffffffffp+63))
+(assert_return (invoke "f64.convert_i64_u" (i64.const -4294967296)) (f64.const 0x1.fffffffep+63))
+(assert_return (invoke "f64.convert_i64_u" (i64.const -4294967297)) (f64.const 0x1.fffffffep+63))
+(assert_return (invoke "f64.convert_i64_u" (i64.const -4294967295)) (f64.const 0x1.fffffffep+63))
+(assert_return (invoke "f64.convert_i64_u" (i64.const 4294967295)) (f64.const 0x1.fffffffep+31))
+(assert_return (invoke "f64.convert_i64_u" (i64.const 4294967296)) (f64.const 0x1p+32))
+(assert_return (invoke "f64.convert_i64_u" (i64.const 4294967297)) (f64.const 0x1.00000001p+32))
+(assert_return (invoke "f64.convert_i64_u" (i64.const 1311768467463790320)) (f64.const 0x1.23456789abcdfp+60))
+(assert_return (invoke "f64.convert_i64_u" (i64.const 314159265358979)) (f64.const 0x1.1db9e76a2483p+48))
+(assert_return (invoke "f64.convert_i64_u" (i64.const 16777217)) (f64.const 0x1.000001p+24))
+(assert_return (invoke "f64.convert_i64_u" (i64.const -16777217)) (f64.const 0x1.fffffffffep+63))
+(assert_return (invoke "f64.convert_i64_u" (i64.const 9007199254740993)) (f64.const 0x1p+53))
+(assert_return (invoke "f64.convert_i64_u" (i64.const -9007199254740993)) (f64.const 0x1.ffcp+63))
+(assert_return (invoke "f64.convert_i64_u" (i64.const 9007199791611905)) (f64.const 0x1.000001p+53))
+(assert_return (invoke "f64.convert_i64_u" (i64.const 9223371212221054977)) (f64.const 0x1.fffffdp+62))
+(assert_return (invoke "f64.convert_i64_u" (i64.const -9223371487098961919)) (f64.const 0x1.000001p+63))
+(assert_return (invoke "f64.convert_i64_u" (i64.const -1649267441663)) (f64.const 0x1.fffffdp+63))
+(assert_return (invoke "f64.convert_i64_u" (i64.const -9223371761976868863)) (f64.const 0x1.0000008p+63))
+
+(assert_return (invoke "f64.promote_f32" (f32.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1.19999ap+0)) (f64.const 0x1.19999ap+0))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1.8p+0)) (f64.const 0x1.8p+0))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1.e66666p+0)) (f64.const 0x1.e66666p+0))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1p+1)) (f64.const 0x1p+1))
+(assert_return (invoke "f64.promote_f32" (f32.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "f64.promote_f32" (f32.const -0x1.19999ap+0)) (f64.const -0x1.19999ap+0))
+(assert_return (invoke "f64.promote_f32" (f32.const -0x1.8p+0)) (f64.const -0x1.8p+0))
+(assert_return (invoke "f64.promote_f32" (f32.const -0x1.e66666p+0)) (f64.const -0x1.e66666p+0))
+(assert_return (invoke "f64.promote_f32" (f32.const -0x1p+1)) (f64.const -0x1p+1))
+(assert_return (invoke "f64.promote_f32" (f32.const -0x1.ccccccp-1)) (f64.const -0x1.ccccccp-1))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1.ccccccp-1)) (f64.const 0x1.ccccccp-1))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1.fffffep+30)) (f64.const 0x1.fffffep+30))
+(assert_return (invoke "f64.promote_f32" (f32.const -0x1p+31)) (f64.const -0x1p+31))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1p+31)) (f64.const 0x1p+31))
+(assert_return (invoke "f64.promote_f32" (f32.const -0x1.000002p+31)) (f64.const -0x1.000002p+31))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1.fffffep+31)) (f64.const 0x1.fffffep+31))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1p+32)) (f64.const 0x1p+32))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1.7d784p+26)) (f64.const 0x1.7d784p+26))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1.fffffep+62)) (f64.const 0x1.fffffep+62))
+(assert_return (invoke "f64.promote_f32" (f32.const -0x1p+63)) (f64.const -0x1p+63))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1p+63)) (f64.const 0x1p+63))
+(assert_return (invoke "f64.promote_f32" (f32.const -0x1.000002p+63)) (f64.const -0x1.000002p+63))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1.fffffep+63)) (f64.const 0x1.fffffep+63))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1p+64)) (f64.const 0x1p+64))
+(assert_return (invoke "f64.promote_f32" (f32.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1p-149)) (f64.const 0x1p-149))
+(assert_return (invoke "f64.promote_f32" (f32.const -0x1p-149)) (f64.const -0x1p-149))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1.fffffep+127)) (f64.const 0x1.fffffep+127))
+(assert_return (invoke "f64.promote_f32" (f32.const inf)) (f64.const inf))
+(assert_return (invoke "f64.promote_f32" (f32.const -inf)) (f64.const -inf))
+(assert_return (invoke "f64.promote_f32" (f32.const nan)) (f64.const nan:canonical))
+(assert_return (invoke "f64.promote_f32" (f32.const -nan)) (f64.const nan:canonical))
+(assert_return (invoke "f64.promote_f32" (f32.const nan:0x200000)) (f64.const nan:arithmetic))
+(assert_return (invoke "f64.promote_f32" (f32.const -nan:0x200000)) (f64.const nan:arithmetic))
+
+(assert_return (invoke "f32.demote_f64" (f64.const 0x0p+0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1p+0)) (f32.const 0x1p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.199999999999ap+0)) (f32.const 0x1.19999ap+0))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.8p+0)) (f32.const 0x1.8p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.e666666666666p+0)) (f32.const 0x1.e66666p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1p+1)) (f32.const 0x1p+1))
+(assert_return (invoke "f32.demote_f64" (f64.const -0x1p+0)) (f32.const -0x1p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const -0x1.199999999999ap+0)) (f32.const -0x1.19999ap+0))
+(assert_return (invoke "f32.demote_f64" (f64.const -0x1.8p+0)) (f32.const -0x1.8p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const -0x1.e666666666666p+0)) (f32.const -0x1.e66666p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const -0x1p+1)) (f32.const -0x1p+1))
+(assert_return (invoke "f32.demote_f64" (f64.const -0x1.ccccccccccccdp-1)) (f32.const -0x1.ccccccp-1))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.ccccccccccccdp-1)) (f32.const 0x1.ccccccp-1))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.fffffffcp+30)) (f32.const 0x1p+31))
+(assert_return (invoke "f32.demote_f64" (f64.const -0x1p+31)) (f32.const -0x1p+31))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1p+31)) (f32.const 0x1p+31))
+(assert_return (invoke "f32.demote_f64" (f64.const -0x1.00000002p+31)) (f32.const -0x1p+31))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.ffffffff9999ap+30)) (f32.const 0x1p+31))
+(assert_return (invoke "f32.demote_f64" (f64.const -0x1.00000001ccccdp+31)) (f32.const -0x1p+31))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.fffffffep+31)) (f32.const 0x1p+32))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1p+32)) (f32.const 0x1p+32))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.ffffffffccccdp+31)) (f32.const 0x1p+32))
+(assert_return (invoke "f32.demote_f64" (f64.const -0x1.ffffffaa19c47p-1)) (f32.const -0x1p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.7d784p+26)) (f32.const 0x1.7d784p+26))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.1c37937e08p+53)) (f32.const 0x1.1c3794p+53))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.93e5939a08ceap+99)) (f32.const 0x1.93e594p+99))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.fffffffffffffp+62)) (f32.const 0x1p+63))
+(assert_return (invoke "f32.demote_f64" (f64.const -0x1p+63)) (f32.const -0x1p+63))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1p+63)) (f32.const 0x1p+63))
+(assert_return (invoke "f32.demote_f64" (f64.const -0x1.0000000000001p+63)) (f32.const -0x1p+63))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.fffffffffffffp+63)) (f32.const 0x1p+64))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1p+64)) (f32.const 0x1p+64))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.fffffep+127)) (f32.const 0x1.fffffep+127))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.ffffffp+127)) (f32.const inf))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.ffffff0000001p+127)) (f32.const inf))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1p-149)) (f32.const 0x1p-149))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1p-150)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.0000000000001p-150)) (f32.const 0x1p-149))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.fffffep-127)) (f32.const 0x1p-126))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1p-126)) (f32.const 0x1p-126))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.000001p+0)) (f32.const 0x1p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.0000010000001p+0)) (f32.const 0x1.000002p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.000003p+0)) (f32.const 0x1.000004p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const -0x0p+0)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1p-1074)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const -0x1p-1074)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.fffffffffffffp+1023)) (f32.const inf))
+(assert_return (invoke "f32.demote_f64" (f64.const inf)) (f32.const inf))
+(assert_return (invoke "f32.demote_f64" (f64.const -inf)) (f32.const -inf))
+(assert_return (invoke "f32.demote_f64" (f64.const nan)) (f32.const nan:canonical))
+(assert_return (invoke "f32.demote_f64" (f64.const -nan)) (f32.const nan:canonical))
+(assert_return (invoke "f32.demote_f64" (f64.const nan:0x4000000000000)) (f32.const nan:arithmetic))
+(assert_return (invoke "f32.demote_f64" (f64.const -nan:0x4000000000000)) (f32.const nan:arithmetic))
+(assert_return (invoke "f32.demote_f64" (f64.const nan:0x8000000000001)) (f32.const nan:arithmetic))
+
+(assert_return (invoke "f32.reinterpret_i32" (i32.const 0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const 1)) (f32.const 0x1p-149))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const -1)) (f32.const -nan:0x7fffff))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const 10000)) (f32.const 0x1.388p-136))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const -10000)) (f32.const -nan:0x7fd8f0))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const 2147483647)) (f32.const nan:0x7fffff))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const -2147483648)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const 1234567890)) (f32.const 0x1.2c05a4p+20))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const 16777217)) (f32.const 0x1.000002p-125))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const -16777217)) (f32.const -0x1.fffffep+126))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const 16777219)) (f32.const 0x1.000006p-125))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const 2147483520)) (f32.const nan:0x7fff80))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const 2147483584)) (f32.const nan:0x7fffc0))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const -2147483457)) (f32.const -0x1.7ep-142))
+
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x0p+0)) (i32.const 0))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1p+0)) (i32.const 1065353216))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1.19999ap+0)) (i32.const 1066192077))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1.8p+0)) (i32.const 1069547520))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1.e66666p+0)) (i32.const 1072902963))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1p+1)) (i32.const 1073741824))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const -0x1p+0)) (i32.const -1082130432))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const -0x1.19999ap+0)) (i32.const -1081291571))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const -0x1.8p+0)) (i32.const -1077936128))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const -0x1.e66666p+0)) (i32.const -1074580685))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const -0x1p+1)) (i32.const -1073741824))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const -0x1.ccccccp-1)) (i32.const -1083808154))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1.ccccccp-1)) (i32.const 1063675494))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1.fffffep+30)) (i32.const 1325400063))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const -0x1p+31)) (i32.const -822083584))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1p+31)) (i32.const 1325400064))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const -0x1.000002p+31)) (i32.const -822083583))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1.fffffep+31)) (i32.const 1333788671))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1p+32)) (i32.const 1333788672))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1.7d784p+26)) (i32.const 1287568416))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1.fffffep+62)) (i32.const 1593835519))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const -0x1p+63)) (i32.const -553648128))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1p+63)) (i32.const 1593835520))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const -0x1.000002p+63)) (i32.const -553648127))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1.fffffep+63)) (i32.const 1602224127))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1p+64)) (i32.const 1602224128))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const -0x0p+0)) (i32.const -2147483648))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1p-149)) (i32.const 1))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const -0x1p-149)) (i32.const -2147483647))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1.fffffep+127)) (i32.const 2139095039))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const inf)) (i32.const 2139095040))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const -inf)) (i32.const -8388608))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const nan)) (i32.const 2143289344))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const -nan)) (i32.const -4194304))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const nan:0x200000)) (i32.const 2141192192))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const -nan:0x200000)) (i32.const -6291456))
+
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 1)) (f64.const 0x1p-1074))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const -1)) (f64.const -nan:0xfffffffffffff))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 10000)) (f64.const 0x1.388p-1061))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const -10000)) (f64.const -nan:0xfffffffffd8f0))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 9223372036854775807)) (f64.const nan:0xfffffffffffff))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const -9223372036854775808)) (f64.const -0x0p+0))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const -2147483649)) (f64.const -nan:0xfffff7fffffff))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const -4294967296)) (f64.const -nan:0xfffff00000000))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const -4294967297)) (f64.const -nan:0xffffeffffffff))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const -4294967295)) (f64.const -nan:0xfffff00000001))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 4294967295)) (f64.const 0x1.fffffffep-1043))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 4294967296)) (f64.const 0x1p-1042))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 4294967297)) (f64.const 0x1.00000001p-1042))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 1311768467463790320)) (f64.const 0x1.456789abcdefp-732))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 314159265358979)) (f64.const 0x1.1db9e76a2483p-1026))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 16777217)) (f64.const 0x1.000001p-1050))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const -16777217)) (f64.const -nan:0xffffffeffffff))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 9007199254740993)) (f64.const 0x1.0000000000001p-1021))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const -9007199254740993)) (f64.const -0x1.fffffffffffffp+1022))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 9007199791611905)) (f64.const 0x1.0000020000001p-1021))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 9223371212221054977)) (f64.const nan:0xfff4000000001))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const -9223371487098961919)) (f64.const -0x1.0000000002p-1035))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const -1649267441663)) (f64.const -nan:0xffe8000000001))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const -9223371761976868863)) (f64.const -0x1.0000000004p-1036))
+
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x0p+0)) (i64.const 0))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1p+0)) (i64.const 4607182418800017408))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.199999999999ap+0)) (i64.const 4607632778762754458))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.8p+0)) (i64.const 4609434218613702656))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.e666666666666p+0)) (i64.const 4611235658464650854))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1p+1)) (i64.const 4611686018427387904))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const -0x1p+0)) (i64.const -4616189618054758400))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const -0x1.199999999999ap+0)) (i64.const -4615739258092021350))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const -0x1.8p+0)) (i64.const -4613937818241073152))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const -0x1.e666666666666p+0)) (i64.const -4612136378390124954))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const -0x1p+1)) (i64.const -4611686018427387904))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const -0x1.ccccccccccccdp-1)) (i64.const -4617090337980232499))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.ccccccccccccdp-1)) (i64.const 4606281698874543309))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.fffffffcp+30)) (i64.const 4746794007244308480))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const -0x1p+31)) (i64.const -4476578029606273024))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1p+31)) (i64.const 4746794007248502784))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const -0x1.00000002p+31)) (i64.const -4476578029604175872))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.ffffffff9999ap+30)) (i64.const 4746794007248083354))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const -0x1.00000001ccccdp+31)) (i64.const -4476578029604385587))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.fffffffep+31)) (i64.const 4751297606873776128))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1p+32)) (i64.const 4751297606875873280))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.ffffffffccccdp+31)) (i64.const 4751297606875663565))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const -0x1.ffffffaa19c47p-1)) (i64.const -4616189618144830393))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.7d784p+26)) (i64.const 4726483295884279808))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.1c37937e08p+53)) (i64.const 4846369599423283200))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.93e5939a08ceap+99)) (i64.const 5055640609639927018))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.fffffffffffffp+62)) (i64.const 4890909195324358655))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const -0x1p+63)) (i64.const -4332462841530417152))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1p+63)) (i64.const 4890909195324358656))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const -0x1.0000000000001p+63)) (i64.const -4332462841530417151))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.fffffffffffffp+63)) (i64.const 4895412794951729151))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1p+64)) (i64.const 4895412794951729152))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.fffffep+127)) (i64.const 5183643170566569984))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.ffffffp+127)) (i64.const 5183643170835005440))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.ffffff0000001p+127)) (i64.const 5183643170835005441))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1p-149)) (i64.const 3936146074321813504))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1p-150)) (i64.const 3931642474694443008))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.0000000000001p-150)) (i64.const 3931642474694443009))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.fffffep-127)) (i64.const 4039728865214464000))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1p-126)) (i64.const 4039728865751334912))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.000001p+0)) (i64.const 4607182419068452864))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.0000010000001p+0)) (i64.const 4607182419068452865))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.000003p+0)) (i64.const 4607182419605323776))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const -0x0p+0)) (i64.const -9223372036854775808))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1p-1074)) (i64.const 1))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const -0x1p-1074)) (i64.const -9223372036854775807))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.fffffffffffffp+1023)) (i64.const 9218868437227405311))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const inf)) (i64.const 9218868437227405312))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const -inf)) (i64.const -4503599627370496))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const nan)) (i64.const 9221120237041090560))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const -nan)) (i64.const -2251799813685248))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const nan:0x4000000000000)) (i64.const 9219994337134247936))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const -nan:0x4000000000000)) (i64.const -3377699720527872))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const nan:0x8000000000001)) (i64.const 9221120237041090561))
+
+(assert_return (invoke "i32.reinterpret_f32" (f32.const nan)) (i32.const 2143289344))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const nan:0x200000)) (i32.const 2141192192))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const -nan)) (i32.const -4194304))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1p-149)) (i32.const 1))
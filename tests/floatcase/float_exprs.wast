@@ -0,0 +1,294 @@
+;; Test interesting floating-point "expressions". These tests contain code
+;; patterns which tempt the optimizer to apply algebraic simplifications
+;; which are not valid for floating point.
+
+(module
+  (memory 1)
+  (func (export "f32.no_fold_add_zero") (param $x f32) (result f32)
+    (f32.add (local.get $x) (f32.const 0.0)))
+  (func (export "f32.no_fold_zero_sub") (param $x f32) (result f32)
+    (f32.sub (f32.const 0.0) (local.get $x)))
+  (func (export "f32.no_fold_sub_zero") (param $x f32) (result f32)
+    (f32.sub (local.get $x) (f32.const 0.0)))
+  (func (export "f32.no_fold_mul_zero") (param $x f32) (result f32)
+    (f32.mul (local.get $x) (f32.const 0.0)))
+  (func (export "f32.no_fold_mul_one") (param $x f32) (result f32)
+    (f32.mul (local.get $x) (f32.const 1.0)))
+  (func (export "f32.no_fold_zero_div") (param $x f32) (result f32)
+    (f32.div (f32.const 0.0) (local.get $x)))
+  (func (export "f32.no_fold_div_one") (param $x f32) (result f32)
+    (f32.div (local.get $x) (f32.const 1.0)))
+  (func (export "f32.no_fold_div_neg1") (param $x f32) (result f32)
+    (f32.div (local.get $x) (f32.const -1.0)))
+  (func (export "f32.no_fold_neg0_sub") (param $x f32) (result f32)
+    (f32.sub (f32.const -0.0) (local.get $x)))
+  (func (export "f32.no_fold_neg1_mul") (param $x f32) (result f32)
+    (f32.mul (f32.const -1.0) (local.get $x)))
+  (func (export "f32.no_fold_eq_self") (param $x f32) (result i32)
+    (f32.eq (local.get $x) (local.get $x)))
+  (func (export "f32.no_fold_ne_self") (param $x f32) (result i32)
+    (f32.ne (local.get $x) (local.get $x)))
+  (func (export "f32.no_fold_sub_self") (param $x f32) (result f32)
+    (f32.sub (local.get $x) (local.get $x)))
+  (func (export "f32.no_fold_div_self") (param $x f32) (result f32)
+    (f32.div (local.get $x) (local.get $x)))
+  (func (export "f32.no_fold_div_3") (param $x f32) (result f32)
+    (f32.div (local.get $x) (f32.const 3.0)))
+  (func (export "f32.no_factor") (param $x f32) (param $y f32) (param $z f32) (result f32)
+    (f32.add (f32.mul (local.get $x) (local.get $z)) (f32.mul (local.get $y) (local.get $z))))
+  (func (export "f32.no_distribute") (param $x f32) (param $y f32) (param $z f32) (result f32)
+    (f32.mul (f32.add (local.get $x) (local.get $y)) (local.get $z)))
+  (func (export "f32.no_reassociate_add") (param $x f32) (param $y f32) (param $z f32) (result f32)
+    (f32.add (f32.add (local.get $x) (local.get $y)) (local.get $z)))
+  (func (export "f32.no_reassociate_mul") (param $x f32) (param $y f32) (param $z f32) (result f32)
+    (f32.mul (f32.mul (local.get $x) (local.get $y)) (local.get $z)))
+  (func (export "f32.no_fold_mul_div") (param $x f32) (param $y f32) (result f32)
+    (f32.div (f32.mul (local.get $x) (local.get $y)) (local.get $y)))
+  (func (export "f32.no_fold_div_mul") (param $x f32) (param $y f32) (result f32)
+    (f32.mul (f32.div (local.get $x) (local.get $y)) (local.get $y)))
+  (func (export "f32.no_fma") (param $x f32) (param $y f32) (param $z f32) (result f32)
+    (f32.add (f32.mul (local.get $x) (local.get $y)) (local.get $z)))
+  (func (export "f32.no_fold_add_neg") (param $x f32) (param $y f32) (result f32)
+    (f32.add (f32.neg (local.get $x)) (local.get $y)))
+  (func (export "f32.no_fold_lt_select") (param $x f32) (param $y f32) (result f32)
+    (select (local.get $x) (local.get $y) (f32.lt (local.get $x) (local.get $y))))
+  (func (export "f32.no_fold_ge_select") (param $x f32) (param $y f32) (result f32)
+    (select (local.get $x) (local.get $y) (f32.ge (local.get $x) (local.get $y))))
+  (func (export "f32.no_fold_min_self") (param $x f32) (result f32)
+    (f32.min (local.get $x) (local.get $x)))
+  (func (export "f32.no_fold_abs_neg") (param $x f32) (result f32)
+    (f32.abs (f32.neg (local.get $x))))
+  (func (export "f32.no_fold_sqrt_square") (param $x f32) (result f32)
+    (f32.sqrt (f32.mul (local.get $x) (local.get $x))))
+  (func (export "f32.no_fold_div_sqrt") (param $x f32) (param $y f32) (result f32)
+    (f32.div (f32.sqrt (local.get $x)) (f32.sqrt (local.get $y))))
+  (func (export "f64.no_fold_add_zero") (param $x f64) (result f64)
+    (f64.add (local.get $x) (f64.const 0.0)))
+  (func (export "f64.no_fold_zero_sub") (param $x f64) (result f64)
+    (f64.sub (f64.const 0.0) (local.get $x)))
+  (func (export "f64.no_fold_sub_zero") (param $x f64) (result f64)
+    (f64.sub (local.get $x) (f64.const 0.0)))
+  (func (export "f64.no_fold_mul_zero") (param $x f64) (result f64)
+    (f64.mul (local.get $x) (f64.const 0.0)))
+  (func (export "f64.no_fold_mul_one") (param $x f64) (result f64)
+    (f64.mul (local.get $x) (f64.const 1.0)))
+  (func (export "f64.no_fold_zero_div") (param $x f64) (result f64)
+    (f64.div (f64.const 0.0) (local.get $x)))
+  (func (export "f64.no_fold_div_one") (param $x f64) (result f64)
+    (f64.div (local.get $x) (f64.const 1.0)))
+  (func (export "f64.no_fold_div_neg1") (param $x f64) (result f64)
+    (f64.div (local.get $x) (f64.const -1.0)))
+  (func (export "f64.no_fold_neg0_sub") (param $x f64) (result f64)
+    (f64.sub (f64.const -0.0) (local.get $x)))
+  (func (export "f64.no_fold_neg1_mul") (param $x f64) (result f64)
+    (f64.mul (f64.const -1.0) (local.get $x)))
+  (func (export "f64.no_fold_eq_self") (param $x f64) (result i32)
+    (f64.eq (local.get $x) (local.get $x)))
+  (func (export "f64.no_fold_ne_self") (param $x f64) (result i32)
+    (f64.ne (local.get $x) (local.get $x)))
+  (func (export "f64.no_fold_sub_self") (param $x f64) (result f64)
+    (f64.sub (local.get $x) (local.get $x)))
+  (func (export "f64.no_fold_div_self") (param $x f64) (result f64)
+    (f64.div (local.get $x) (local.get $x)))
+  (func (export "f64.no_fold_div_3") (param $x f64) (result f64)
+    (f64.div (local.get $x) (f64.const 3.0)))
+  (func (export "f64.no_factor") (param $x f64) (param $y f64) (param $z f64) (result f64)
+    (f64.add (f64.mul (local.get $x) (local.get $z)) (f64.mul (local.get $y) (local.get $z))))
+  (func (export "f64.no_distribute") (param $x f64) (param $y f64) (param $z f64) (result f64)
+    (f64.mul (f64.add (local.get $x) (local.get $y)) (local.get $z)))
+  (func (export "f64.no_reassociate_add") (param $x f64) (param $y f64) (param $z f64) (result f64)
+    (f64.add (f64.add (local.get $x) (local.get $y)) (local.get $z)))
+  (func (export "f64.no_reassociate_mul") (param $x f64) (param $y f64) (param $z f64) (result f64)
+    (f64.mul (f64.mul (local.get $x) (local.get $y)) (local.get $z)))
+  (func (export "f64.no_fold_mul_div") (param $x f64) (param $y f64) (result f64)
+    (f64.div (f64.mul (local.get $x) (local.get $y)) (local.get $y)))
+  (func (export "f64.no_fold_div_mul") (param $x f64) (param $y f64) (result f64)
+    (f64.mul (f64.div (local.get $x) (local.get $y)) (local.get $y)))
+  (func (export "f64.no_fma") (param $x f64) (param $y f64) (param $z f64) (result f64)
+    (f64.add (f64.mul (local.get $x) (local.get $y)) (local.get $z)))
+  (func (export "f64.no_fold_add_neg") (param $x f64) (param $y f64) (result f64)
+    (f64.add (f64.neg (local.get $x)) (local.get $y)))
+  (func (export "f64.no_fold_lt_select") (param $x f64) (param $y f64) (result f64)
+    (select (local.get $x) (local.get $y) (f64.lt (local.get $x) (local.get $y))))
+  (func (export "f64.no_fold_ge_select") (param $x f64) (param $y f64) (result f64)
+    (select (local.get $x) (local.get $y) (f64.ge (local.get $x) (local.get $y))))
+  (func (export "f64.no_fold_min_self") (param $x f64) (result f64)
+    (f64.min (local.get $x) (local.get $x)))
+  (func (export "f64.no_fold_abs_neg") (param $x f64) (result f64)
+    (f64.abs (f64.neg (local.get $x))))
+  (func (export "f64.no_fold_sqrt_square") (param $x f64) (result f64)
+    (f64.sqrt (f64.mul (local.get $x) (local.get $x))))
+  (func (export "f64.no_fold_div_sqrt") (param $x f64) (param $y f64) (result f64)
+    (f64.div (f64.sqrt (local.get $x)) (f64.sqrt (local.get $y))))
+  (func (export "no_fold_promote_demote") (param $x f32) (result f32) (f32.demote_f64 (f64.promote_f32 (local.get $x))))
+  (func (export "no_fold_demote_promote") (param $x f64) (result f64) (f64.promote_f32 (f32.demote_f64 (local.get $x))))
+  (func (export "f32.store_load") (param $x f32) (result f32) (f32.store (i32.const 0) (local.get $x)) (f32.load (i32.const 0)))
+  (func (export "f64.store_load") (param $x f64) (result f64) (f64.store (i32.const 0) (local.get $x)) (f64.load (i32.const 0)))
+  (func (export "f32.store_i32_load") (param $x f32) (result i32) (f32.store (i32.const 0) (local.get $x)) (i32.load (i32.const 0)))
+)
+
+(assert_return (invoke "f32.no_fold_add_zero" (f32.const -0x0p+0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.no_fold_add_zero" (f32.const nan:0x200000)) (f32.const nan:arithmetic))
+(assert_return (invoke "f32.no_fold_zero_sub" (f32.const 0x0p+0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.no_fold_zero_sub" (f32.const nan:0x200000)) (f32.const nan:arithmetic))
+(assert_return (invoke "f32.no_fold_sub_zero" (f32.const nan:0x200000)) (f32.const nan:arithmetic))
+(assert_return (invoke "f32.no_fold_mul_zero" (f32.const -0x0p+0)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.no_fold_mul_zero" (f32.const -0x1p+0)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.no_fold_mul_zero" (f32.const -0x1p+1)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.no_fold_mul_zero" (f32.const inf)) (f32.const nan:canonical))
+(assert_return (invoke "f32.no_fold_mul_zero" (f32.const nan)) (f32.const nan:canonical))
+(assert_return (invoke "f32.no_fold_mul_zero" (f32.const nan:0x200000)) (f32.const nan:arithmetic))
+(assert_return (invoke "f32.no_fold_mul_one" (f32.const nan:0x200000)) (f32.const nan:arithmetic))
+(assert_return (invoke "f32.no_fold_zero_div" (f32.const 0x0p+0)) (f32.const nan:canonical))
+(assert_return (invoke "f32.no_fold_zero_div" (f32.const -0x0p+0)) (f32.const nan:canonical))
+(assert_return (invoke "f32.no_fold_zero_div" (f32.const nan)) (f32.const nan:canonical))
+(assert_return (invoke "f32.no_fold_zero_div" (f32.const nan:0x200000)) (f32.const nan:arithmetic))
+(assert_return (invoke "f32.no_fold_div_one" (f32.const nan:0x200000)) (f32.const nan:arithmetic))
+(assert_return (invoke "f32.no_fold_div_neg1" (f32.const nan:0x200000)) (f32.const nan:arithmetic))
+(assert_return (invoke "f32.no_fold_neg0_sub" (f32.const nan:0x200000)) (f32.const nan:arithmetic))
+(assert_return (invoke "f32.no_fold_neg1_mul" (f32.const nan:0x200000)) (f32.const nan:arithmetic))
+(assert_return (invoke "f32.no_fold_eq_self" (f32.const nan)) (i32.const 0))
+(assert_return (invoke "f32.no_fold_ne_self" (f32.const nan)) (i32.const 1))
+(assert_return (invoke "f32.no_fold_sub_self" (f32.const inf)) (f32.const nan:canonical))
+(assert_return (invoke "f32.no_fold_sub_self" (f32.const nan)) (f32.const nan:canonical))
+(assert_return (invoke "f32.no_fold_div_self" (f32.const inf)) (f32.const nan:canonical))
+(assert_return (invoke "f32.no_fold_div_self" (f32.const nan)) (f32.const nan:canonical))
+(assert_return (invoke "f32.no_fold_div_self" (f32.const 0x0p+0)) (f32.const nan:canonical))
+(assert_return (invoke "f32.no_fold_div_self" (f32.const -0x0p+0)) (f32.const nan:canonical))
+(assert_return (invoke "f32.no_fold_div_3" (f32.const -0x1.359c26p+50)) (f32.const -0x1.9cd032p+48))
+(assert_return (invoke "f32.no_fold_div_3" (f32.const -0x1.e45646p+93)) (f32.const -0x1.42e42ep+92))
+(assert_return (invoke "f32.no_fold_div_3" (f32.const 0x1.2e8a5ep-52)) (f32.const 0x1.936328p-54))
+(assert_return (invoke "f32.no_factor" (f32.const -0x1.4e2352p+40) (f32.const -0x1.842e2cp+49) (f32.const 0x1.eea602p+59)) (f32.const -0x1.77a7dp+109))
+(assert_return (invoke "f32.no_factor" (f32.const 0x1.5p+0) (f32.const 0x1.2p-23) (f32.const 0x1.8p+24)) (f32.const 0x1.f80004p+24))
+(assert_return (invoke "f32.no_distribute" (f32.const -0x1.4e2352p+40) (f32.const -0x1.842e2cp+49) (f32.const 0x1.eea602p+59)) (f32.const -0x1.77a7d2p+109))
+(assert_return (invoke "f32.no_distribute" (f32.const 0x1.5p+0) (f32.const 0x1.2p-23) (f32.const 0x1.8p+24)) (f32.const 0x1.f80004p+24))
+(assert_return (invoke "f32.no_reassociate_add" (f32.const 0x1.7d784p+26) (f32.const 0x1p+0) (f32.const -0x1.7d784p+26)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.no_reassociate_add" (f32.const 0x1.5af1d8p+66) (f32.const -0x1.5af1d8p+66) (f32.const 0x1p+0)) (f32.const 0x1p+0))
+(assert_return (invoke "f32.no_reassociate_add" (f32.const 0x1p+53) (f32.const 0x1p+0) (f32.const 0x1p+0)) (f32.const 0x1p+53))
+(assert_return (invoke "f32.no_reassociate_mul" (f32.const 0x1.93e594p+99) (f32.const 0x1.93e594p+99) (f32.const 0x1.4484cp-100)) (f32.const inf))
+(assert_return (invoke "f32.no_reassociate_mul" (f32.const 0x1.4484cp-100) (f32.const 0x1.4484cp-100) (f32.const 0x1.93e594p+99)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.no_reassociate_mul" (f32.const 0x1.19999ap+0) (f32.const 0x1.4cccccp+0) (f32.const 0x1.b33334p+0)) (f32.const 0x1.372b02p+1))
+(assert_return (invoke "f32.no_fold_mul_div" (f32.const 0x1.19999ap+0) (f32.const 0x1.2ced32p+126)) (f32.const 0x1.19999ap+0))
+(assert_return (invoke "f32.no_fold_mul_div" (f32.const 0x1.19999ap+0) (f32.const inf)) (f32.const nan:canonical))
+(assert_return (invoke "f32.no_fold_mul_div" (f32.const 0x1p-100) (f32.const 0x1p-100)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.no_fold_mul_div" (f32.const 0x1.99999ap-4) (f32.const 0x1.8p+1)) (f32.const 0x1.99999ap-4))
+(assert_return (invoke "f32.no_fold_div_mul" (f32.const 0x1p-126) (f32.const 0x1p+30)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.no_fold_div_mul" (f32.const 0x1p+0) (f32.const 0x1.88p+5)) (f32.const 0x1p+0))
+(assert_return (invoke "f32.no_fold_div_mul" (f32.const 0x0p+0) (f32.const 0x1p+60)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.no_fma" (f32.const 0x1.000002p+0) (f32.const 0x1.000002p+0) (f32.const -0x1.000004p+0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.no_fma" (f32.const 0x1p+0) (f32.const 0x1p+0) (f32.const -0x1p+0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.no_fma" (f32.const 0x1.99999ap-4) (f32.const 0x1.4p+3) (f32.const -0x1p+0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.no_fold_add_neg" (f32.const 0x0p+0) (f32.const 0x0p+0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.no_fold_add_neg" (f32.const inf) (f32.const inf)) (f32.const nan:canonical))
+(assert_return (invoke "f32.no_fold_lt_select" (f32.const 0x0p+0) (f32.const nan)) (f32.const nan))
+(assert_return (invoke "f32.no_fold_lt_select" (f32.const nan) (f32.const 0x0p+0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.no_fold_lt_select" (f32.const 0x0p+0) (f32.const -0x0p+0)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.no_fold_lt_select" (f32.const -0x0p+0) (f32.const 0x0p+0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.no_fold_ge_select" (f32.const 0x0p+0) (f32.const nan)) (f32.const nan))
+(assert_return (invoke "f32.no_fold_ge_select" (f32.const nan) (f32.const 0x0p+0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.no_fold_ge_select" (f32.const 0x0p+0) (f32.const -0x0p+0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.no_fold_ge_select" (f32.const -0x0p+0) (f32.const 0x0p+0)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.no_fold_min_self" (f32.const nan:0x200000)) (f32.const nan:arithmetic))
+(assert_return (invoke "f32.no_fold_abs_neg" (f32.const nan)) (f32.const nan))
+(assert_return (invoke "f32.no_fold_abs_neg" (f32.const -nan)) (f32.const nan))
+(assert_return (invoke "f32.no_fold_sqrt_square" (f32.const -0x1.88ac18p-64)) (f32.const 0x1.88ac18p-64))
+(assert_return (invoke "f32.no_fold_sqrt_square" (f32.const 0x1.4484cp-100)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.no_fold_sqrt_square" (f32.const 0x1.93e594p+99)) (f32.const inf))
+(assert_return (invoke "f32.no_fold_sqrt_square" (f32.const 0x0p+0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.no_fold_sqrt_square" (f32.const inf)) (f32.const inf))
+(assert_return (invoke "f32.no_fold_div_sqrt" (f32.const 0x1p+1) (f32.const 0x1.8p+1)) (f32.const 0x1.a20bd8p-1))
+(assert_return (invoke "f32.no_fold_div_sqrt" (f32.const 0x1.5f2c0ep-14) (f32.const 0x1.d3f7c8p+97)) (f32.const 0x1.399f4ep-56))
+
+(assert_return (invoke "f64.no_fold_add_zero" (f64.const -0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.no_fold_add_zero" (f64.const nan:0x4000000000000)) (f64.const nan:arithmetic))
+(assert_return (invoke "f64.no_fold_zero_sub" (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.no_fold_zero_sub" (f64.const nan:0x4000000000000)) (f64.const nan:arithmetic))
+(assert_return (invoke "f64.no_fold_sub_zero" (f64.const nan:0x4000000000000)) (f64.const nan:arithmetic))
+(assert_return (invoke "f64.no_fold_mul_zero" (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "f64.no_fold_mul_zero" (f64.const -0x1p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "f64.no_fold_mul_zero" (f64.const -0x1p+1)) (f64.const -0x0p+0))
+(assert_return (invoke "f64.no_fold_mul_zero" (f64.const inf)) (f64.const nan:canonical))
+(assert_return (invoke "f64.no_fold_mul_zero" (f64.const nan)) (f64.const nan:canonical))
+(assert_return (invoke "f64.no_fold_mul_zero" (f64.const nan:0x4000000000000)) (f64.const nan:arithmetic))
+(assert_return (invoke "f64.no_fold_mul_one" (f64.const nan:0x4000000000000)) (f64.const nan:arithmetic))
+(assert_return (invoke "f64.no_fold_zero_div" (f64.const 0x0p+0)) (f64.const nan:canonical))
+(assert_return (invoke "f64.no_fold_zero_div" (f64.const -0x0p+0)) (f64.const nan:canonical))
+(assert_return (invoke "f64.no_fold_zero_div" (f64.const nan)) (f64.const nan:canonical))
+(assert_return (invoke "f64.no_fold_zero_div" (f64.const nan:0x4000000000000)) (f64.const nan:arithmetic))
+(assert_return (invoke "f64.no_fold_div_one" (f64.const nan:0x4000000000000)) (f64.const nan:arithmetic))
+(assert_return (invoke "f64.no_fold_div_neg1" (f64.const nan:0x4000000000000)) (f64.const nan:arithmetic))
+(assert_return (invoke "f64.no_fold_neg0_sub" (f64.const nan:0x4000000000000)) (f64.const nan:arithmetic))
+(assert_return (invoke "f64.no_fold_neg1_mul" (f64.const nan:0x4000000000000)) (f64.const nan:arithmetic))
+(assert_return (invoke "f64.no_fold_eq_self" (f64.const nan)) (i32.const 0))
+(assert_return (invoke "f64.no_fold_ne_self" (f64.const nan)) (i32.const 1))
+(assert_return (invoke "f64.no_fold_sub_self" (f64.const inf)) (f64.const nan:canonical))
+(assert_return (invoke "f64.no_fold_sub_self" (f64.const nan)) (f64.const nan:canonical))
+(assert_return (invoke "f64.no_fold_div_self" (f64.const inf)) (f64.const nan:canonical))
+(assert_return (invoke "f64.no_fold_div_self" (f64.const nan)) (f64.const nan:canonical))
+(assert_return (invoke "f64.no_fold_div_self" (f64.const 0x0p+0)) (f64.const nan:canonical))
+(assert_return (invoke "f64.no_fold_div_self" (f64.const -0x0p+0)) (f64.const nan:canonical))
+(assert_return (invoke "f64.no_fold_div_3" (f64.const -0x1.359c26p+50)) (f64.const -0x1.9cd032aaaaaabp+48))
+(assert_return (invoke "f64.no_fold_div_3" (f64.const -0x1.e45646p+93)) (f64.const -0x1.42e42eaaaaaabp+92))
+(assert_return (invoke "f64.no_fold_div_3" (f64.const 0x1.2e8a5ep-52)) (f64.const 0x1.936328p-54))
+(assert_return (invoke "f64.no_factor" (f64.const -0x1.4e2352p+40) (f64.const -0x1.842e2cp+49) (f64.const 0x1.eea602p+59)) (f64.const -0x1.77a7d125e208bp+109))
+(assert_return (invoke "f64.no_factor" (f64.const 0x1.5p+0) (f64.const 0x1.2p-23) (f64.const 0x1.8p+24)) (f64.const 0x1.f800036p+24))
+(assert_return (invoke "f64.no_distribute" (f64.const -0x1.4e2352p+40) (f64.const -0x1.842e2cp+49) (f64.const 0x1.eea602p+59)) (f64.const -0x1.77a7d125e208bp+109))
+(assert_return (invoke "f64.no_distribute" (f64.const 0x1.5p+0) (f64.const 0x1.2p-23) (f64.const 0x1.8p+24)) (f64.const 0x1.f800036p+24))
+(assert_return (invoke "f64.no_reassociate_add" (f64.const 0x1.7d784p+26) (f64.const 0x1p+0) (f64.const -0x1.7d784p+26)) (f64.const 0x1p+0))
+(assert_return (invoke "f64.no_reassociate_add" (f64.const 0x1.5af1d78b58c4p+66) (f64.const -0x1.5af1d78b58c4p+66) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "f64.no_reassociate_add" (f64.const 0x1p+53) (f64.const 0x1p+0) (f64.const 0x1p+0)) (f64.const 0x1p+53))
+(assert_return (invoke "f64.no_reassociate_mul" (f64.const 0x1.93e5939a08ceap+99) (f64.const 0x1.93e5939a08ceap+99) (f64.const 0x1.4484bfeebc2ap-100)) (f64.const 0x1.93e5939a08cebp+99))
+(assert_return (invoke "f64.no_reassociate_mul" (f64.const 0x1.4484bfeebc2ap-100) (f64.const 0x1.4484bfeebc2ap-100) (f64.const 0x1.93e5939a08ceap+99)) (f64.const 0x1.4484bfeebc2ap-100))
+(assert_return (invoke "f64.no_reassociate_mul" (f64.const 0x1.199999999999ap+0) (f64.const 0x1.4cccccccccccdp+0) (f64.const 0x1.b333333333333p+0)) (f64.const 0x1.372b020c49ba6p+1))
+(assert_return (invoke "f64.no_fold_mul_div" (f64.const 0x1.199999999999ap+0) (f64.const 0x1.2ced32a16a1b1p+126)) (f64.const 0x1.199999999999ap+0))
+(assert_return (invoke "f64.no_fold_mul_div" (f64.const 0x1.199999999999ap+0) (f64.const 0x1.1ccf385ebc8ap+1023)) (f64.const 0x1.199999999999ap+0))
+(assert_return (invoke "f64.no_fold_mul_div" (f64.const 0x1p-100) (f64.const 0x1p-100)) (f64.const 0x1p-100))
+(assert_return (invoke "f64.no_fold_mul_div" (f64.const 0x1.999999999999ap-4) (f64.const 0x1.8p+1)) (f64.const 0x1.999999999999bp-4))
+(assert_return (invoke "f64.no_fold_div_mul" (f64.const 0x1p-126) (f64.const 0x1p+30)) (f64.const 0x1p-126))
+(assert_return (invoke "f64.no_fold_div_mul" (f64.const 0x1p+0) (f64.const 0x1.88p+5)) (f64.const 0x1.fffffffffffffp-1))
+(assert_return (invoke "f64.no_fold_div_mul" (f64.const 0x1p-1022) (f64.const 0x1p+60)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.no_fma" (f64.const 0x1.000002p+0) (f64.const 0x1.000002p+0) (f64.const -0x1.000004p+0)) (f64.const 0x1p-46))
+(assert_return (invoke "f64.no_fma" (f64.const 0x1.0000000000001p+0) (f64.const 0x1.0000000000001p+0) (f64.const -0x1.0000000000002p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.no_fma" (f64.const 0x1.999999999999ap-4) (f64.const 0x1.4p+3) (f64.const -0x1p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.no_fold_add_neg" (f64.const 0x0p+0) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.no_fold_add_neg" (f64.const inf) (f64.const inf)) (f64.const nan:canonical))
+(assert_return (invoke "f64.no_fold_lt_select" (f64.const 0x0p+0) (f64.const nan)) (f64.const nan))
+(assert_return (invoke "f64.no_fold_lt_select" (f64.const nan) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.no_fold_lt_select" (f64.const 0x0p+0) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "f64.no_fold_lt_select" (f64.const -0x0p+0) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.no_fold_ge_select" (f64.const 0x0p+0) (f64.const nan)) (f64.const nan))
+(assert_return (invoke "f64.no_fold_ge_select" (f64.const nan) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.no_fold_ge_select" (f64.const 0x0p+0) (f64.const -0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.no_fold_ge_select" (f64.const -0x0p+0) (f64.const 0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "f64.no_fold_min_self" (f64.const nan:0x4000000000000)) (f64.const nan:arithmetic))
+(assert_return (invoke "f64.no_fold_abs_neg" (f64.const nan)) (f64.const nan))
+(assert_return (invoke "f64.no_fold_abs_neg" (f64.const -nan)) (f64.const nan))
+(assert_return (invoke "f64.no_fold_sqrt_square" (f64.const -0x1.88ac18p-64)) (f64.const 0x1.88ac18p-64))
+(assert_return (invoke "f64.no_fold_sqrt_square" (f64.const 0x1.4484bfeebc2ap-100)) (f64.const 0x1.4484bfeebc2ap-100))
+(assert_return (invoke "f64.no_fold_sqrt_square" (f64.const 0x1.93e5939a08ceap+99)) (f64.const 0x1.93e5939a08ceap+99))
+(assert_return (invoke "f64.no_fold_sqrt_square" (f64.const 0x1.87e92154ef7acp-665)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.no_fold_sqrt_square" (f64.const 0x1.4e718d7d7625ap+664)) (f64.const inf))
+(assert_return (invoke "f64.no_fold_div_sqrt" (f64.const 0x1p+1) (f64.const 0x1.8p+1)) (f64.const 0x1.a20bd700c2c3fp-1))
+(assert_return (invoke "f64.no_fold_div_sqrt" (f64.const 0x1.5f2c0ep-14) (f64.const 0x1.d3f7c8p+97)) (f64.const 0x1.399f4eba23813p-56))
+
+(assert_return (invoke "no_fold_promote_demote" (f32.const 0x1p+0)) (f32.const 0x1p+0))
+(assert_return (invoke "no_fold_promote_demote" (f32.const nan:0x200000)) (f32.const nan:arithmetic))
+(assert_return (invoke "no_fold_promote_demote" (f32.const 0x1p-149)) (f32.const 0x1p-149))
+(assert_return (invoke "no_fold_promote_demote" (f32.const 0x1.fffffep+127)) (f32.const 0x1.fffffep+127))
+(assert_return (invoke "no_fold_demote_promote" (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "no_fold_demote_promote" (f64.const 0x1.0000000000001p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "no_fold_demote_promote" (f64.const nan:0x4000000000000)) (f64.const nan:arithmetic))
+(assert_return (invoke "no_fold_demote_promote" (f64.const 0x1.16c262777579cp-133)) (f64.const 0x1.16c2p-133))
+(assert_return (invoke "no_fold_demote_promote" (f64.const 0x1.7e43c8800759cp+996)) (f64.const inf))
+(assert_return (invoke "f32.store_load" (f32.const nan:0x200000)) (f32.const nan:0x200000))
+(assert_return (invoke "f32.store_i32_load" (f32.const nan:0x200000)) (i32.const 2141192192))
+(assert_return (invoke "f32.store_load" (f32.const -nan:0x400001)) (f32.const -nan:0x400001))
+(assert_return (invoke "f32.store_i32_load" (f32.const -nan:0x400001)) (i32.const -4194303))
+(assert_return (invoke "f32.store_load" (f32.const nan:0x1)) (f32.const nan:0x1))
+(assert_return (invoke "f32.store_i32_load" (f32.const nan:0x1)) (i32.const 2139095041))
+(assert_return (invoke "f32.store_load" (f32.const 0x1.8p+0)) (f32.const 0x1.8p+0))
+(assert_return (invoke "f32.store_i32_load" (f32.const 0x1.8p+0)) (i32.const 1069547520))
+(assert_return (invoke "f64.store_load" (f64.const nan:0x4000000000000)) (f64.const nan:0x4000000000000))
+(assert_return (invoke "f64.store_load" (f64.const -nan:0x8000000000001)) (f64.const -nan:0x8000000000001))
+(assert_return (invoke "f64.store_load" (f64.const nan:0x1)) (f64.const nan:0x1))
+(assert_return (invoke "f64.store_load" (f64.const 0x1.8p+0)) (f64.const 0x1.8p+0))
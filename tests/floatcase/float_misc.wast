@@ -0,0 +1,372 @@
+;; Platform-independent checks of floating point operators on assorted
+;; values, which do not fit in the other float test suites.
+
+(module
+  (func (export "f32.add") (param $x f32) (param $y f32) (result f32) (f32.add (local.get $x) (local.get $y)))
+  (func (export "f32.sub") (param $x f32) (param $y f32) (result f32) (f32.sub (local.get $x) (local.get $y)))
+  (func (export "f32.mul") (param $x f32) (param $y f32) (result f32) (f32.mul (local.get $x) (local.get $y)))
+  (func (export "f32.div") (param $x f32) (param $y f32) (result f32) (f32.div (local.get $x) (local.get $y)))
+  (func (export "f32.min") (param $x f32) (param $y f32) (result f32) (f32.min (local.get $x) (local.get $y)))
+  (func (export "f32.max") (param $x f32) (param $y f32) (result f32) (f32.max (local.get $x) (local.get $y)))
+  (func (export "f32.copysign") (param $x f32) (param $y f32) (result f32) (f32.copysign (local.get $x) (local.get $y)))
+  (func (export "f32.sqrt") (param $x f32) (result f32) (f32.sqrt (local.get $x)))
+  (func (export "f32.abs") (param $x f32) (result f32) (f32.abs (local.get $x)))
+  (func (export "f32.neg") (param $x f32) (result f32) (f32.neg (local.get $x)))
+  (func (export "f32.ceil") (param $x f32) (result f32) (f32.ceil (local.get $x)))
+  (func (export "f32.floor") (param $x f32) (result f32) (f32.floor (local.get $x)))
+  (func (export "f32.trunc") (param $x f32) (result f32) (f32.trunc (local.get $x)))
+  (func (export "f32.nearest") (param $x f32) (result f32) (f32.nearest (local.get $x)))
+  (func (export "f64.add") (param $x f64) (param $y f64) (result f64) (f64.add (local.get $x) (local.get $y)))
+  (func (export "f64.sub") (param $x f64) (param $y f64) (result f64) (f64.sub (local.get $x) (local.get $y)))
+  (func (export "f64.mul") (param $x f64) (param $y f64) (result f64) (f64.mul (local.get $x) (local.get $y)))
+  (func (export "f64.div") (param $x f64) (param $y f64) (result f64) (f64.div (local.get $x) (local.get $y)))
+  (func (export "f64.min") (param $x f64) (param $y f64) (result f64) (f64.min (local.get $x) (local.get $y)))
+  (func (export "f64.max") (param $x f64) (param $y f64) (result f64) (f64.max (local.get $x) (local.get $y)))
+  (func (export "f64.copysign") (param $x f64) (param $y f64) (result f64) (f64.copysign (local.get $x) (local.get $y)))
+  (func (export "f64.sqrt") (param $x f64) (result f64) (f64.sqrt (local.get $x)))
+  (func (export "f64.abs") (param $x f64) (result f64) (f64.abs (local.get $x)))
+  (func (export "f64.neg") (param $x f64) (result f64) (f64.neg (local.get $x)))
+  (func (export "f64.ceil") (param $x f64) (result f64) (f64.ceil (local.get $x)))
+  (func (export "f64.floor") (param $x f64) (result f64) (f64.floor (local.get $x)))
+  (func (export "f64.trunc") (param $x f64) (result f64) (f64.trunc (local.get $x)))
+  (func (export "f64.nearest") (param $x f64) (result f64) (f64.nearest (local.get $x)))
+)
+
+(assert_return (invoke "f32.add" (f32.const 0x1.1f9adep+0) (f32.const 0x1.0f782cp-33)) (f32.const 0x1.1f9adep+0))
+(assert_return (invoke "f32.add" (f32.const 0x1.c6bf52p+49) (f32.const 0x1.203afap-50)) (f32.const 0x1.c6bf52p+49))
+(assert_return (invoke "f32.add" (f32.const 0x1.99999ap-4) (f32.const 0x1.99999ap-3)) (f32.const 0x1.333334p-2))
+(assert_return (invoke "f32.add" (f32.const 0x1.5af1d8p+66) (f32.const 0x1p+0)) (f32.const 0x1.5af1d8p+66))
+(assert_return (invoke "f32.add" (f32.const -0x1.5af1d8p+66) (f32.const 0x1.5af1d8p+66)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.add" (f32.const 0x1.000002p+0) (f32.const 0x1.5798eep-27)) (f32.const 0x1.000002p+0))
+(assert_return (invoke "f32.sub" (f32.const 0x1.5af1d8p+66) (f32.const 0x1.79ca1p-67)) (f32.const 0x1.5af1d8p+66))
+(assert_return (invoke "f32.sub" (f32.const 0x1.000002p+0) (f32.const 0x1p+0)) (f32.const 0x1p-23))
+(assert_return (invoke "f32.sub" (f32.const 0x1.333334p-2) (f32.const 0x1.99999ap-4)) (f32.const 0x1.99999cp-3))
+(assert_return (invoke "f32.sub" (f32.const 0x1p+0) (f32.const 0x1p+0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.mul" (f32.const 0x1.c6bf52p+49) (f32.const 0x1.c6bf52p+49)) (f32.const 0x1.93e592p+99))
+(assert_return (invoke "f32.mul" (f32.const 0x1.5af1d8p+66) (f32.const 0x1.5af1d8p+66)) (f32.const inf))
+(assert_return (invoke "f32.mul" (f32.const inf) (f32.const inf)) (f32.const inf))
+(assert_return (invoke "f32.mul" (f32.const 0x1.4484cp-100) (f32.const 0x1.4484cp-100)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.mul" (f32.const 0x0p+0) (f32.const 0x0p+0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.mul" (f32.const 0x1.19999ap+0) (f32.const 0x1.19999ap+0)) (f32.const 0x1.35c29p+0))
+(assert_return (invoke "f32.mul" (f32.const -0x1p-1) (f32.const 0x1p-149)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.mul" (f32.const 0x1.fffffep-1) (f32.const 0x1.fffffep-1)) (f32.const 0x1.fffffcp-1))
+(assert_return (invoke "f32.div" (f32.const 0x1p+0) (f32.const 0x1.8p+1)) (f32.const 0x1.555556p-2))
+(assert_return (invoke "f32.div" (f32.const 0x1p+1) (f32.const 0x1.8p+1)) (f32.const 0x1.555556p-1))
+(assert_return (invoke "f32.div" (f32.const 0x1.93e594p+99) (f32.const 0x1.4484cp-100)) (f32.const inf))
+(assert_return (invoke "f32.div" (f32.const 0x1.4484cp-100) (f32.const 0x1.93e594p+99)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.div" (f32.const inf) (f32.const 0x0p+0)) (f32.const inf))
+(assert_return (invoke "f32.div" (f32.const -0x1p+0) (f32.const 0x0p+0)) (f32.const -inf))
+(assert_return (invoke "f32.div" (f32.const 0x1p+0) (f64.const 0x0p+0)) (f32.const inf))
+(assert_return (invoke "f32.div" (f32.const 0x1p+2) (f32.const 0x1.8p+1)) (f32.const 0x1.555556p+0))
+(assert_return (invoke "f32.div" (f32.const 0x1p-126) (f32.const 0x1.8p+1)) (f32.const 0x1.555558p-128))
+(assert_return (invoke "f32.sqrt" (f32.const 0x1p+1)) (f32.const 0x1.6a09e6p+0))
+(assert_return (invoke "f32.sqrt" (f32.const 0x1.8p+1)) (f32.const 0x1.bb67aep+0))
+(assert_return (invoke "f32.sqrt" (f32.const 0x1p+2)) (f32.const 0x1p+1))
+(assert_return (invoke "f32.sqrt" (f32.const 0x1p-1)) (f32.const 0x1.6a09e6p-1))
+(assert_return (invoke "f32.sqrt" (f32.const 0x1.16c2p-133)) (f32.const 0x1.79c9cep-67))
+(assert_return (invoke "f32.sqrt" (f32.const 0x0p+0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.sqrt" (f32.const 0x1.2ced32p+126)) (f32.const 0x1.158e46p+63))
+(assert_return (invoke "f32.sqrt" (f32.const inf)) (f32.const inf))
+(assert_return (invoke "f32.sqrt" (f32.const 0x1.fffffep+127)) (f32.const 0x1.fffffep+63))
+(assert_return (invoke "f32.sqrt" (f32.const -0x1p+0)) (f32.const nan:canonical))
+(assert_return (invoke "f32.sqrt" (f32.const -0x1p-149)) (f32.const nan:canonical))
+(assert_return (invoke "f32.sqrt" (f32.const 0x1.000002p+0)) (f32.const 0x1p+0))
+(assert_return (invoke "f32.sqrt" (f32.const 0x1.fffffcp-1)) (f32.const 0x1.fffffep-1))
+(assert_return (invoke "f32.nearest" (f32.const 0x1p-1)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.trunc" (f32.const 0x1p-1)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.ceil" (f32.const 0x1p-1)) (f32.const 0x1p+0))
+(assert_return (invoke "f32.floor" (f32.const 0x1p-1)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.nearest" (f32.const -0x1p-1)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.trunc" (f32.const -0x1p-1)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.ceil" (f32.const -0x1p-1)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.floor" (f32.const -0x1p-1)) (f32.const -0x1p+0))
+(assert_return (invoke "f32.nearest" (f32.const 0x1.8p+0)) (f32.const 0x1p+1))
+(assert_return (invoke "f32.trunc" (f32.const 0x1.8p+0)) (f32.const 0x1p+0))
+(assert_return (invoke "f32.ceil" (f32.const 0x1.8p+0)) (f32.const 0x1p+1))
+(assert_return (invoke "f32.floor" (f32.const 0x1.8p+0)) (f32.const 0x1p+0))
+(assert_return (invoke "f32.nearest" (f32.const -0x1.8p+0)) (f32.const -0x1p+1))
+(assert_return (invoke "f32.trunc" (f32.const -0x1.8p+0)) (f32.const -0x1p+0))
+(assert_return (invoke "f32.ceil" (f32.const -0x1.8p+0)) (f32.const -0x1p+0))
+(assert_return (invoke "f32.floor" (f32.const -0x1.8p+0)) (f32.const -0x1p+1))
+(assert_return (invoke "f32.nearest" (f32.const 0x1.4p+1)) (f32.const 0x1p+1))
+(assert_return (invoke "f32.trunc" (f32.const 0x1.4p+1)) (f32.const 0x1p+1))
+(assert_return (invoke "f32.ceil" (f32.const 0x1.4p+1)) (f32.const 0x1.8p+1))
+(assert_return (invoke "f32.floor" (f32.const 0x1.4p+1)) (f32.const 0x1p+1))
+(assert_return (invoke "f32.nearest" (f32.const -0x1.4p+1)) (f32.const -0x1p+1))
+(assert_return (invoke "f32.trunc" (f32.const -0x1.4p+1)) (f32.const -0x1p+1))
+(assert_return (invoke "f32.ceil" (f32.const -0x1.4p+1)) (f32.const -0x1p+1))
+(assert_return (invoke "f32.floor" (f32.const -0x1.4p+1)) (f32.const -0x1.8p+1))
+(assert_return (invoke "f32.nearest" (f32.const 0x1.cp+1)) (f32.const 0x1p+2))
+(assert_return (invoke "f32.trunc" (f32.const 0x1.cp+1)) (f32.const 0x1.8p+1))
+(assert_return (invoke "f32.ceil" (f32.const 0x1.cp+1)) (f32.const 0x1p+2))
+(assert_return (invoke "f32.floor" (f32.const 0x1.cp+1)) (f32.const 0x1.8p+1))
+(assert_return (invoke "f32.nearest" (f32.const -0x1.cp+1)) (f32.const -0x1p+2))
+(assert_return (invoke "f32.trunc" (f32.const -0x1.cp+1)) (f32.const -0x1.8p+1))
+(assert_return (invoke "f32.ceil" (f32.const -0x1.cp+1)) (f32.const -0x1.8p+1))
+(assert_return (invoke "f32.floor" (f32.const -0x1.cp+1)) (f32.const -0x1p+2))
+(assert_return (invoke "f32.nearest" (f32.const 0x1.2p+2)) (f32.const 0x1p+2))
+(assert_return (invoke "f32.trunc" (f32.const 0x1.2p+2)) (f32.const 0x1p+2))
+(assert_return (invoke "f32.ceil" (f32.const 0x1.2p+2)) (f32.const 0x1.4p+2))
+(assert_return (invoke "f32.floor" (f32.const 0x1.2p+2)) (f32.const 0x1p+2))
+(assert_return (invoke "f32.nearest" (f32.const -0x1.2p+2)) (f32.const -0x1p+2))
+(assert_return (invoke "f32.trunc" (f32.const -0x1.2p+2)) (f32.const -0x1p+2))
+(assert_return (invoke "f32.ceil" (f32.const -0x1.2p+2)) (f32.const -0x1p+2))
+(assert_return (invoke "f32.floor" (f32.const -0x1.2p+2)) (f32.const -0x1.4p+2))
+(assert_return (invoke "f32.nearest" (f32.const 0x1.fffffep-2)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.trunc" (f32.const 0x1.fffffep-2)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.ceil" (f32.const 0x1.fffffep-2)) (f32.const 0x1p+0))
+(assert_return (invoke "f32.floor" (f32.const 0x1.fffffep-2)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.nearest" (f32.const -0x1.fffffep-2)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.trunc" (f32.const -0x1.fffffep-2)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.ceil" (f32.const -0x1.fffffep-2)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.floor" (f32.const -0x1.fffffep-2)) (f32.const -0x1p+0))
+(assert_return (invoke "f32.nearest" (f32.const 0x1.fffffep+22)) (f32.const 0x1p+23))
+(assert_return (invoke "f32.trunc" (f32.const 0x1.fffffep+22)) (f32.const 0x1.fffffcp+22))
+(assert_return (invoke "f32.ceil" (f32.const 0x1.fffffep+22)) (f32.const 0x1p+23))
+(assert_return (invoke "f32.floor" (f32.const 0x1.fffffep+22)) (f32.const 0x1.fffffcp+22))
+(assert_return (invoke "f32.nearest" (f32.const -0x1.fffffep+22)) (f32.const -0x1p+23))
+(assert_return (invoke "f32.trunc" (f32.const -0x1.fffffep+22)) (f32.const -0x1.fffffcp+22))
+(assert_return (invoke "f32.ceil" (f32.const -0x1.fffffep+22)) (f32.const -0x1.fffffcp+22))
+(assert_return (invoke "f32.floor" (f32.const -0x1.fffffep+22)) (f32.const -0x1p+23))
+(assert_return (invoke "f32.nearest" (f32.const 0x1p+23)) (f32.const 0x1p+23))
+(assert_return (invoke "f32.trunc" (f32.const 0x1p+23)) (f32.const 0x1p+23))
+(assert_return (invoke "f32.ceil" (f32.const 0x1p+23)) (f32.const 0x1p+23))
+(assert_return (invoke "f32.floor" (f32.const 0x1p+23)) (f32.const 0x1p+23))
+(assert_return (invoke "f32.nearest" (f32.const 0x1.000002p+23)) (f32.const 0x1.000002p+23))
+(assert_return (invoke "f32.trunc" (f32.const 0x1.000002p+23)) (f32.const 0x1.000002p+23))
+(assert_return (invoke "f32.ceil" (f32.const 0x1.000002p+23)) (f32.const 0x1.000002p+23))
+(assert_return (invoke "f32.floor" (f32.const 0x1.000002p+23)) (f32.const 0x1.000002p+23))
+(assert_return (invoke "f32.nearest" (f32.const 0x1p+52)) (f32.const 0x1p+52))
+(assert_return (invoke "f32.trunc" (f32.const 0x1p+52)) (f32.const 0x1p+52))
+(assert_return (invoke "f32.ceil" (f32.const 0x1p+52)) (f32.const 0x1p+52))
+(assert_return (invoke "f32.floor" (f32.const 0x1p+52)) (f32.const 0x1p+52))
+(assert_return (invoke "f32.nearest" (f32.const 0x1p+52)) (f32.const 0x1p+52))
+(assert_return (invoke "f32.trunc" (f32.const 0x1p+52)) (f32.const 0x1p+52))
+(assert_return (invoke "f32.ceil" (f32.const 0x1p+52)) (f32.const 0x1p+52))
+(assert_return (invoke "f32.floor" (f32.const 0x1p+52)) (f32.const 0x1p+52))
+(assert_return (invoke "f32.nearest" (f32.const 0x1p+53)) (f32.const 0x1p+53))
+(assert_return (invoke "f32.trunc" (f32.const 0x1p+53)) (f32.const 0x1p+53))
+(assert_return (invoke "f32.ceil" (f32.const 0x1p+53)) (f32.const 0x1p+53))
+(assert_return (invoke "f32.floor" (f32.const 0x1p+53)) (f32.const 0x1p+53))
+(assert_return (invoke "f32.nearest" (f32.const 0x1.4484cp-100)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.trunc" (f32.const 0x1.4484cp-100)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.ceil" (f32.const 0x1.4484cp-100)) (f32.const 0x1p+0))
+(assert_return (invoke "f32.floor" (f32.const 0x1.4484cp-100)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.nearest" (f32.const -0x1.4484cp-100)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.trunc" (f32.const -0x1.4484cp-100)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.ceil" (f32.const -0x1.4484cp-100)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.floor" (f32.const -0x1.4484cp-100)) (f32.const -0x1p+0))
+(assert_return (invoke "f32.nearest" (f32.const 0x1.93e594p+99)) (f32.const 0x1.93e594p+99))
+(assert_return (invoke "f32.trunc" (f32.const 0x1.93e594p+99)) (f32.const 0x1.93e594p+99))
+(assert_return (invoke "f32.ceil" (f32.const 0x1.93e594p+99)) (f32.const 0x1.93e594p+99))
+(assert_return (invoke "f32.floor" (f32.const 0x1.93e594p+99)) (f32.const 0x1.93e594p+99))
+(assert_return (invoke "f32.nearest" (f32.const -0x1.93e594p+99)) (f32.const -0x1.93e594p+99))
+(assert_return (invoke "f32.trunc" (f32.const -0x1.93e594p+99)) (f32.const -0x1.93e594p+99))
+(assert_return (invoke "f32.ceil" (f32.const -0x1.93e594p+99)) (f32.const -0x1.93e594p+99))
+(assert_return (invoke "f32.floor" (f32.const -0x1.93e594p+99)) (f32.const -0x1.93e594p+99))
+(assert_return (invoke "f32.min" (f32.const 0x0p+0) (f32.const -0x0p+0)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.min" (f32.const -0x0p+0) (f32.const 0x0p+0)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.max" (f32.const 0x0p+0) (f32.const -0x0p+0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.max" (f32.const -0x0p+0) (f32.const 0x0p+0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.min" (f32.const 0x1p+0) (f32.const nan:0x200000)) (f32.const nan:arithmetic))
+(assert_return (invoke "f32.max" (f32.const nan:0x200000) (f32.const -0x1p+0)) (f32.const nan:arithmetic))
+(assert_return (invoke "f32.abs" (f32.const nan:0x200000)) (f32.const nan:0x200000))
+(assert_return (invoke "f32.neg" (f32.const nan:0x200000)) (f32.const -nan:0x200000))
+(assert_return (invoke "f32.copysign" (f32.const nan:0x200000) (f32.const 0x1p+0)) (f32.const nan:0x200000))
+(assert_return (invoke "f32.copysign" (f32.const nan:0x200000) (f32.const -0x1p+0)) (f32.const -nan:0x200000))
+(assert_return (invoke "f32.copysign" (f32.const 0x1p+1) (f32.const nan:0x200000)) (f32.const 0x1p+1))
+(assert_return (invoke "f32.abs" (f32.const -nan:0x200000)) (f32.const nan:0x200000))
+(assert_return (invoke "f32.neg" (f32.const -nan:0x200000)) (f32.const nan:0x200000))
+(assert_return (invoke "f32.copysign" (f32.const -nan:0x200000) (f32.const 0x1p+0)) (f32.const nan:0x200000))
+(assert_return (invoke "f32.copysign" (f32.const -nan:0x200000) (f32.const -0x1p+0)) (f32.const -nan:0x200000))
+(assert_return (invoke "f32.copysign" (f32.const 0x1p+1) (f32.const -nan:0x200000)) (f32.const -0x1p+1))
+(assert_return (invoke "f32.abs" (f32.const nan)) (f32.const nan))
+(assert_return (invoke "f32.neg" (f32.const nan)) (f32.const -nan))
+(assert_return (invoke "f32.copysign" (f32.const nan) (f32.const 0x1p+0)) (f32.const nan))
+(assert_return (invoke "f32.copysign" (f32.const nan) (f32.const -0x1p+0)) (f32.const -nan))
+(assert_return (invoke "f32.copysign" (f32.const 0x1p+1) (f32.const nan)) (f32.const 0x1p+1))
+(assert_return (invoke "f32.abs" (f32.const -nan)) (f32.const nan))
+(assert_return (invoke "f32.neg" (f32.const -nan)) (f32.const nan))
+(assert_return (invoke "f32.copysign" (f32.const -nan) (f32.const 0x1p+0)) (f32.const nan))
+(assert_return (invoke "f32.copysign" (f32.const -nan) (f32.const -0x1p+0)) (f32.const -nan))
+(assert_return (invoke "f32.copysign" (f32.const 0x1p+1) (f32.const -nan)) (f32.const -0x1p+1))
+(assert_return (invoke "f32.abs" (f32.const nan:0xf1e2)) (f32.const nan:0xf1e2))
+(assert_return (invoke "f32.neg" (f32.const nan:0xf1e2)) (f32.const -nan:0xf1e2))
+(assert_return (invoke "f32.copysign" (f32.const nan:0xf1e2) (f32.const 0x1p+0)) (f32.const nan:0xf1e2))
+(assert_return (invoke "f32.copysign" (f32.const nan:0xf1e2) (f32.const -0x1p+0)) (f32.const -nan:0xf1e2))
+(assert_return (invoke "f32.copysign" (f32.const 0x1p+1) (f32.const nan:0xf1e2)) (f32.const 0x1p+1))
+(assert_return (invoke "f32.abs" (f32.const -nan:0xf1e2)) (f32.const nan:0xf1e2))
+(assert_return (invoke "f32.neg" (f32.const -nan:0xf1e2)) (f32.const nan:0xf1e2))
+(assert_return (invoke "f32.copysign" (f32.const -nan:0xf1e2) (f32.const 0x1p+0)) (f32.const nan:0xf1e2))
+(assert_return (invoke "f32.copysign" (f32.const -nan:0xf1e2) (f32.const -0x1p+0)) (f32.const -nan:0xf1e2))
+(assert_return (invoke "f32.copysign" (f32.const 0x1p+1) (f32.const -nan:0xf1e2)) (f32.const -0x1p+1))
+
+(assert_return (invoke "f64.add" (f64.const 0x1.1f9add3739636p+0) (f64.const 0x1.0f782bf7cc83fp-33)) (f64.const 0x1.1f9add37c11f7p+0))
+(assert_return (invoke "f64.add" (f64.const 0x1.c6bf52634p+49) (f64.const 0x1.203af9ee75616p-50)) (f64.const 0x1.c6bf52634p+49))
+(assert_return (invoke "f64.add" (f64.const 0x1.999999999999ap-4) (f64.const 0x1.999999999999ap-3)) (f64.const 0x1.3333333333334p-2))
+(assert_return (invoke "f64.add" (f64.const 0x1.5af1d78b58c4p+66) (f64.const 0x1p+0)) (f64.const 0x1.5af1d78b58c4p+66))
+(assert_return (invoke "f64.add" (f64.const -0x1.5af1d78b58c4p+66) (f64.const 0x1.5af1d78b58c4p+66)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.add" (f64.const 0x1.00000101b2b2ap+0) (f64.const 0x1.5798ee2308c3ap-27)) (f64.const 0x1.0000012ca5d06p+0))
+(assert_return (invoke "f64.sub" (f64.const 0x1.5af1d78b58c4p+66) (f64.const 0x1.79ca10c924223p-67)) (f64.const 0x1.5af1d78b58c4p+66))
+(assert_return (invoke "f64.sub" (f64.const 0x1.000001ad7f29bp+0) (f64.const 0x1p+0)) (f64.const 0x1.ad7f29bp-24))
+(assert_return (invoke "f64.sub" (f64.const 0x1.3333333333333p-2) (f64.const 0x1.999999999999ap-4)) (f64.const 0x1.9999999999999p-3))
+(assert_return (invoke "f64.sub" (f64.const 0x1p+0) (f64.const 0x1p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.mul" (f64.const 0x1.c6bf52634p+49) (f64.const 0x1.c6bf52634p+49)) (f64.const 0x1.93e5939a08ceap+99))
+(assert_return (invoke "f64.mul" (f64.const 0x1.5af1d78b58c4p+66) (f64.const 0x1.5af1d78b58c4p+66)) (f64.const 0x1.d6329f1c35ca5p+132))
+(assert_return (invoke "f64.mul" (f64.const 0x1.4e718d7d7625ap+664) (f64.const 0x1.4e718d7d7625ap+664)) (f64.const inf))
+(assert_return (invoke "f64.mul" (f64.const 0x1.4484bfeebc2ap-100) (f64.const 0x1.4484bfeebc2ap-100)) (f64.const 0x1.9b604aaaca627p-200))
+(assert_return (invoke "f64.mul" (f64.const 0x1.56e1fc2f8f359p-997) (f64.const 0x1.56e1fc2f8f359p-997)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.mul" (f64.const 0x1.199999999999ap+0) (f64.const 0x1.199999999999ap+0)) (f64.const 0x1.35c28f5c28f5dp+0))
+(assert_return (invoke "f64.mul" (f64.const -0x1p-1) (f64.const 0x1.6d601ad376ab9p-150)) (f64.const -0x1.6d601ad376ab9p-151))
+(assert_return (invoke "f64.mul" (f64.const 0x1.fffffep-1) (f64.const 0x1.fffffep-1)) (f64.const 0x1.fffffc000002p-1))
+(assert_return (invoke "f64.div" (f64.const 0x1p+0) (f64.const 0x1.8p+1)) (f64.const 0x1.5555555555555p-2))
+(assert_return (invoke "f64.div" (f64.const 0x1p+1) (f64.const 0x1.8p+1)) (f64.const 0x1.5555555555555p-1))
+(assert_return (invoke "f64.div" (f64.const 0x1.93e5939a08ceap+99) (f64.const 0x1.4484bfeebc2ap-100)) (f64.const 0x1.3e9e4e4c2f344p+199))
+(assert_return (invoke "f64.div" (f64.const 0x1.4484bfeebc2ap-100) (f64.const 0x1.93e5939a08ceap+99)) (f64.const 0x1.9b604aaaca627p-200))
+(assert_return (invoke "f64.div" (f64.const 0x1.7e43c8800759cp+996) (f64.const 0x1.56e1fc2f8f359p-997)) (f64.const inf))
+(assert_return (invoke "f64.div" (f64.const -0x1p+0) (f64.const 0x0p+0)) (f64.const -inf))
+(assert_return (invoke "f64.div" (f64.const 0x1p+0) (f64.const 0x0p+0)) (f64.const inf))
+(assert_return (invoke "f64.div" (f64.const 0x1p+2) (f64.const 0x1.8p+1)) (f64.const 0x1.5555555555555p+0))
+(assert_return (invoke "f64.div" (f64.const 0x1p-126) (f64.const 0x1.8p+1)) (f64.const 0x1.5555555555555p-128))
+(assert_return (invoke "f64.sqrt" (f64.const 0x1p+1)) (f64.const 0x1.6a09e667f3bcdp+0))
+(assert_return (invoke "f64.sqrt" (f64.const 0x1.8p+1)) (f64.const 0x1.bb67ae8584caap+0))
+(assert_return (invoke "f64.sqrt" (f64.const 0x1p+2)) (f64.const 0x1p+1))
+(assert_return (invoke "f64.sqrt" (f64.const 0x1p-1)) (f64.const 0x1.6a09e667f3bcdp-1))
+(assert_return (invoke "f64.sqrt" (f64.const 0x1.16c262777579cp-133)) (f64.const 0x1.79ca10c924223p-67))
+(assert_return (invoke "f64.sqrt" (f64.const 0x1.2688b70e62bp-1030)) (f64.const 0x1.1297872d9cbaep-515))
+(assert_return (invoke "f64.sqrt" (f64.const 0x1.2ced32a16a1b1p+126)) (f64.const 0x1.158e460913dp+63))
+(assert_return (invoke "f64.sqrt" (f64.const 0x1.7e43c8800759cp+996)) (f64.const 0x1.38d352e5096afp+498))
+(assert_return (invoke "f64.sqrt" (f64.const 0x1.fffffep+127)) (f64.const 0x1.fffffeffffffcp+63))
+(assert_return (invoke "f64.sqrt" (f64.const -0x1p+0)) (f64.const nan:canonical))
+(assert_return (invoke "f64.sqrt" (f64.const -0x1p-149)) (f64.const nan:canonical))
+(assert_return (invoke "f64.sqrt" (f64.const 0x1.000002p+0)) (f64.const 0x1.000000ffffff8p+0))
+(assert_return (invoke "f64.sqrt" (f64.const 0x1.fffffcp-1)) (f64.const 0x1.fffffdffffffp-1))
+(assert_return (invoke "f64.nearest" (f64.const 0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.trunc" (f64.const 0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.ceil" (f64.const 0x1p-1)) (f64.const 0x1p+0))
+(assert_return (invoke "f64.floor" (f64.const 0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.nearest" (f64.const -0x1p-1)) (f64.const -0x0p+0))
+(assert_return (invoke "f64.trunc" (f64.const -0x1p-1)) (f64.const -0x0p+0))
+(assert_return (invoke "f64.ceil" (f64.const -0x1p-1)) (f64.const -0x0p+0))
+(assert_return (invoke "f64.floor" (f64.const -0x1p-1)) (f64.const -0x1p+0))
+(assert_return (invoke "f64.nearest" (f64.const 0x1.8p+0)) (f64.const 0x1p+1))
+(assert_return (invoke "f64.trunc" (f64.const 0x1.8p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "f64.ceil" (f64.const 0x1.8p+0)) (f64.const 0x1p+1))
+(assert_return (invoke "f64.floor" (f64.const 0x1.8p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "f64.nearest" (f64.const -0x1.8p+0)) (f64.const -0x1p+1))
+(assert_return (invoke "f64.trunc" (f64.const -0x1.8p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "f64.ceil" (f64.const -0x1.8p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "f64.floor" (f64.const -0x1.8p+0)) (f64.const -0x1p+1))
+(assert_return (invoke "f64.nearest" (f64.const 0x1.4p+1)) (f64.const 0x1p+1))
+(assert_return (invoke "f64.trunc" (f64.const 0x1.4p+1)) (f64.const 0x1p+1))
+(assert_return (invoke "f64.ceil" (f64.const 0x1.4p+1)) (f64.const 0x1.8p+1))
+(assert_return (invoke "f64.floor" (f64.const 0x1.4p+1)) (f64.const 0x1p+1))
+(assert_return (invoke "f64.nearest" (f64.const -0x1.4p+1)) (f64.const -0x1p+1))
+(assert_return (invoke "f64.trunc" (f64.const -0x1.4p+1)) (f64.const -0x1p+1))
+(assert_return (invoke "f64.ceil" (f64.const -0x1.4p+1)) (f64.const -0x1p+1))
+(assert_return (invoke "f64.floor" (f64.const -0x1.4p+1)) (f64.const -0x1.8p+1))
+(assert_return (invoke "f64.nearest" (f64.const 0x1.cp+1)) (f64.const 0x1p+2))
+(assert_return (invoke "f64.trunc" (f64.const 0x1.cp+1)) (f64.const 0x1.8p+1))
+(assert_return (invoke "f64.ceil" (f64.const 0x1.cp+1)) (f64.const 0x1p+2))
+(assert_return (invoke "f64.floor" (f64.const 0x1.cp+1)) (f64.const 0x1.8p+1))
+(assert_return (invoke "f64.nearest" (f64.const -0x1.cp+1)) (f64.const -0x1p+2))
+(assert_return (invoke "f64.trunc" (f64.const -0x1.cp+1)) (f64.const -0x1.8p+1))
+(assert_return (invoke "f64.ceil" (f64.const -0x1.cp+1)) (f64.const -0x1.8p+1))
+(assert_return (invoke "f64.floor" (f64.const -0x1.cp+1)) (f64.const -0x1p+2))
+(assert_return (invoke "f64.nearest" (f64.const 0x1.2p+2)) (f64.const 0x1p+2))
+(assert_return (invoke "f64.trunc" (f64.const 0x1.2p+2)) (f64.const 0x1p+2))
+(assert_return (invoke "f64.ceil" (f64.const 0x1.2p+2)) (f64.const 0x1.4p+2))
+(assert_return (invoke "f64.floor" (f64.const 0x1.2p+2)) (f64.const 0x1p+2))
+(assert_return (invoke "f64.nearest" (f64.const -0x1.2p+2)) (f64.const -0x1p+2))
+(assert_return (invoke "f64.trunc" (f64.const -0x1.2p+2)) (f64.const -0x1p+2))
+(assert_return (invoke "f64.ceil" (f64.const -0x1.2p+2)) (f64.const -0x1p+2))
+(assert_return (invoke "f64.floor" (f64.const -0x1.2p+2)) (f64.const -0x1.4p+2))
+(assert_return (invoke "f64.nearest" (f64.const 0x1.fffffdfc9a9adp-2)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.trunc" (f64.const 0x1.fffffdfc9a9adp-2)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.ceil" (f64.const 0x1.fffffdfc9a9adp-2)) (f64.const 0x1p+0))
+(assert_return (invoke "f64.floor" (f64.const 0x1.fffffdfc9a9adp-2)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.nearest" (f64.const -0x1.fffffdfc9a9adp-2)) (f64.const -0x0p+0))
+(assert_return (invoke "f64.trunc" (f64.const -0x1.fffffdfc9a9adp-2)) (f64.const -0x0p+0))
+(assert_return (invoke "f64.ceil" (f64.const -0x1.fffffdfc9a9adp-2)) (f64.const -0x0p+0))
+(assert_return (invoke "f64.floor" (f64.const -0x1.fffffdfc9a9adp-2)) (f64.const -0x1p+0))
+(assert_return (invoke "f64.nearest" (f64.const 0x1.fffffep+22)) (f64.const 0x1p+23))
+(assert_return (invoke "f64.trunc" (f64.const 0x1.fffffep+22)) (f64.const 0x1.fffffcp+22))
+(assert_return (invoke "f64.ceil" (f64.const 0x1.fffffep+22)) (f64.const 0x1p+23))
+(assert_return (invoke "f64.floor" (f64.const 0x1.fffffep+22)) (f64.const 0x1.fffffcp+22))
+(assert_return (invoke "f64.nearest" (f64.const -0x1.fffffep+22)) (f64.const -0x1p+23))
+(assert_return (invoke "f64.trunc" (f64.const -0x1.fffffep+22)) (f64.const -0x1.fffffcp+22))
+(assert_return (invoke "f64.ceil" (f64.const -0x1.fffffep+22)) (f64.const -0x1.fffffcp+22))
+(assert_return (invoke "f64.floor" (f64.const -0x1.fffffep+22)) (f64.const -0x1p+23))
+(assert_return (invoke "f64.nearest" (f64.const 0x1p+23)) (f64.const 0x1p+23))
+(assert_return (invoke "f64.trunc" (f64.const 0x1p+23)) (f64.const 0x1p+23))
+(assert_return (invoke "f64.ceil" (f64.const 0x1p+23)) (f64.const 0x1p+23))
+(assert_return (invoke "f64.floor" (f64.const 0x1p+23)) (f64.const 0x1p+23))
+(assert_return (invoke "f64.nearest" (f64.const 0x1.000002p+23)) (f64.const 0x1.000002p+23))
+(assert_return (invoke "f64.trunc" (f64.const 0x1.000002p+23)) (f64.const 0x1.000002p+23))
+(assert_return (invoke "f64.ceil" (f64.const 0x1.000002p+23)) (f64.const 0x1.000002p+23))
+(assert_return (invoke "f64.floor" (f64.const 0x1.000002p+23)) (f64.const 0x1.000002p+23))
+(assert_return (invoke "f64.nearest" (f64.const 0x1.fffffffffffffp+51)) (f64.const 0x1p+52))
+(assert_return (invoke "f64.trunc" (f64.const 0x1.fffffffffffffp+51)) (f64.const 0x1.ffffffffffffep+51))
+(assert_return (invoke "f64.ceil" (f64.const 0x1.fffffffffffffp+51)) (f64.const 0x1p+52))
+(assert_return (invoke "f64.floor" (f64.const 0x1.fffffffffffffp+51)) (f64.const 0x1.ffffffffffffep+51))
+(assert_return (invoke "f64.nearest" (f64.const 0x1p+52)) (f64.const 0x1p+52))
+(assert_return (invoke "f64.trunc" (f64.const 0x1p+52)) (f64.const 0x1p+52))
+(assert_return (invoke "f64.ceil" (f64.const 0x1p+52)) (f64.const 0x1p+52))
+(assert_return (invoke "f64.floor" (f64.const 0x1p+52)) (f64.const 0x1p+52))
+(assert_return (invoke "f64.nearest" (f64.const 0x1p+53)) (f64.const 0x1p+53))
+(assert_return (invoke "f64.trunc" (f64.const 0x1p+53)) (f64.const 0x1p+53))
+(assert_return (invoke "f64.ceil" (f64.const 0x1p+53)) (f64.const 0x1p+53))
+(assert_return (invoke "f64.floor" (f64.const 0x1p+53)) (f64.const 0x1p+53))
+(assert_return (invoke "f64.nearest" (f64.const 0x1.4484bfeebc2ap-100)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.trunc" (f64.const 0x1.4484bfeebc2ap-100)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.ceil" (f64.const 0x1.4484bfeebc2ap-100)) (f64.const 0x1p+0))
+(assert_return (invoke "f64.floor" (f64.const 0x1.4484bfeebc2ap-100)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.nearest" (f64.const -0x1.4484bfeebc2ap-100)) (f64.const -0x0p+0))
+(assert_return (invoke "f64.trunc" (f64.const -0x1.4484bfeebc2ap-100)) (f64.const -0x0p+0))
+(assert_return (invoke "f64.ceil" (f64.const -0x1.4484bfeebc2ap-100)) (f64.const -0x0p+0))
+(assert_return (invoke "f64.floor" (f64.const -0x1.4484bfeebc2ap-100)) (f64.const -0x1p+0))
+(assert_return (invoke "f64.nearest" (f64.const 0x1.93e5939a08ceap+99)) (f64.const 0x1.93e5939a08ceap+99))
+(assert_return (invoke "f64.trunc" (f64.const 0x1.93e5939a08ceap+99)) (f64.const 0x1.93e5939a08ceap+99))
+(assert_return (invoke "f64.ceil" (f64.const 0x1.93e5939a08ceap+99)) (f64.const 0x1.93e5939a08ceap+99))
+(assert_return (invoke "f64.floor" (f64.const 0x1.93e5939a08ceap+99)) (f64.const 0x1.93e5939a08ceap+99))
+(assert_return (invoke "f64.nearest" (f64.const -0x1.93e5939a08ceap+99)) (f64.const -0x1.93e5939a08ceap+99))
+(assert_return (invoke "f64.trunc" (f64.const -0x1.93e5939a08ceap+99)) (f64.const -0x1.93e5939a08ceap+99))
+(assert_return (invoke "f64.ceil" (f64.const -0x1.93e5939a08ceap+99)) (f64.const -0x1.93e5939a08ceap+99))
+(assert_return (invoke "f64.floor" (f64.const -0x1.93e5939a08ceap+99)) (f64.const -0x1.93e5939a08ceap+99))
+(assert_return (invoke "f64.min" (f64.const 0x0p+0) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "f64.min" (f64.const -0x0p+0) (f64.const 0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "f64.max" (f64.const 0x0p+0) (f64.const -0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.max" (f64.const -0x0p+0) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.min" (f64.const 0x1p+0) (f64.const nan:0x4000000000000)) (f64.const nan:arithmetic))
+(assert_return (invoke "f64.max" (f64.const nan:0x4000000000000) (f64.const -0x1p+0)) (f64.const nan:arithmetic))
+(assert_return (invoke "f64.abs" (f64.const nan:0x4000000000000)) (f64.const nan:0x4000000000000))
+(assert_return (invoke "f64.neg" (f64.const nan:0x4000000000000)) (f64.const -nan:0x4000000000000))
+(assert_return (invoke "f64.copysign" (f64.const nan:0x4000000000000) (f64.const 0x1p+0)) (f64.const nan:0x4000000000000))
+(assert_return (invoke "f64.copysign" (f64.const nan:0x4000000000000) (f64.const -0x1p+0)) (f64.const -nan:0x4000000000000))
+(assert_return (invoke "f64.copysign" (f64.const 0x1p+1) (f64.const nan:0x4000000000000)) (f64.const 0x1p+1))
+(assert_return (invoke "f64.abs" (f64.const -nan:0x4000000000000)) (f64.const nan:0x4000000000000))
+(assert_return (invoke "f64.neg" (f64.const -nan:0x4000000000000)) (f64.const nan:0x4000000000000))
+(assert_return (invoke "f64.copysign" (f64.const -nan:0x4000000000000) (f64.const 0x1p+0)) (f64.const nan:0x4000000000000))
+(assert_return (invoke "f64.copysign" (f64.const -nan:0x4000000000000) (f64.const -0x1p+0)) (f64.const -nan:0x4000000000000))
+(assert_return (invoke "f64.copysign" (f64.const 0x1p+1) (f64.const -nan:0x4000000000000)) (f64.const -0x1p+1))
+(assert_return (invoke "f64.abs" (f64.const nan)) (f64.const nan))
+(assert_return (invoke "f64.neg" (f64.const nan)) (f64.const -nan))
+(assert_return (invoke "f64.copysign" (f64.const nan) (f64.const 0x1p+0)) (f64.const nan))
+(assert_return (invoke "f64.copysign" (f64.const nan) (f64.const -0x1p+0)) (f64.const -nan))
+(assert_return (invoke "f64.copysign" (f64.const 0x1p+1) (f64.const nan)) (f64.const 0x1p+1))
+(assert_return (invoke "f64.abs" (f64.const -nan)) (f64.const nan))
+(assert_return (invoke "f64.neg" (f64.const -nan)) (f64.const nan))
+(assert_return (invoke "f64.copysign" (f64.const -nan) (f64.const 0x1p+0)) (f64.const nan))
+(assert_return (invoke "f64.copysign" (f64.const -nan) (f64.const -0x1p+0)) (f64.const -nan))
+(assert_return (invoke "f64.copysign" (f64.const 0x1p+1) (f64.const -nan)) (f64.const -0x1p+1))
+(assert_return (invoke "f64.abs" (f64.const nan:0xf1e27)) (f64.const nan:0xf1e27))
+(assert_return (invoke "f64.neg" (f64.const nan:0xf1e27)) (f64.const -nan:0xf1e27))
+(assert_return (invoke "f64.copysign" (f64.const nan:0xf1e27) (f64.const 0x1p+0)) (f64.const nan:0xf1e27))
+(assert_return (invoke "f64.copysign" (f64.const nan:0xf1e27) (f64.const -0x1p+0)) (f64.const -nan:0xf1e27))
+(assert_return (invoke "f64.copysign" (f64.const 0x1p+1) (f64.const nan:0xf1e27)) (f64.const 0x1p+1))
+(assert_return (invoke "f64.abs" (f64.const -nan:0xf1e27)) (f64.const nan:0xf1e27))
+(assert_return (invoke "f64.neg" (f64.const -nan:0xf1e27)) (f64.const nan:0xf1e27))
+(assert_return (invoke "f64.copysign" (f64.const -nan:0xf1e27) (f64.const 0x1p+0)) (f64.const nan:0xf1e27))
+(assert_return (invoke "f64.copysign" (f64.const -nan:0xf1e27) (f64.const -0x1p+0)) (f64.const -nan:0xf1e27))
+(assert_return (invoke "f64.copysign" (f64.const 0x1p+1) (f64.const -nan:0xf1e27)) (f64.const -0x1p+1))
+
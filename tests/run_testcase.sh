@@ -20,6 +20,12 @@ for file in ../spectestcase/*.wast ; do
     ${WAST2JOSN} ${file}
 done
 
+# The float suites live in the tree next to the testsuite submodule. Their
+# names are prefixed so they do not collide with the suites of the submodule.
+for file in ../floatcase/*.wast ; do
+    ${WAST2JOSN} ${file} -o float_$(basename ${file} .wast).json
+done
+
 for json in *.json ; do
     ./spec_test ${json}
 done
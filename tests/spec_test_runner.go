@@ -97,7 +97,7 @@ func (c *Config) Run(cfgPath string) {
 			if cmd.Name != "" {
 				namedVMs[cmd.Name] = vm
 			}
-		case "assert_return", "action":
+		case "assert_return", "action", "assert_return_canonical_nan", "assert_return_arithmetic_nan":
 			localVM := vm
 			if cmd.Action.Module != "" {
 				if target, ok := namedVMs[cmd.Action.Module]; ok {
@@ -128,18 +128,8 @@ func (c *Config) Run(cfgPath string) {
 					panic(err)
 				}
 				if len(cmd.Expected) != 0 {
-					var _exp uint64
-					fmt.Sscanf(cmd.Expected[0].Value, "%d", &_exp)
-					exp := int64(_exp)
-					var result int64
-					if cmd.Expected[0].Type == "i32" || cmd.Expected[0].Type == "f32" {
-						result = int64(ret.(uint32))
-						exp = int64(uint32(exp))
-					} else {
-						result = int64(ret.(uint64))
-					}
-					if result != exp {
-						panic(fmt.Errorf("l%d: %s, ret mismatch: got %d, expected %d", cmd.Line, cfgPath, result, exp))
+					if err := checkResult(cmd.Type, cmd.Expected[0], ret); err != nil {
+						panic(fmt.Errorf("l%d: %s, %v", cmd.Line, cfgPath, err))
 					}
 				}
 			case "get":
@@ -196,8 +186,7 @@ func (c *Config) Run(cfgPath string) {
 				panic(cmd.Action.Type)
 			}
 
-		case "assert_malformed", "assert_invalid", "assert_exhaustion", "assert_unlinkable",
-			"assert_return_canonical_nan", "assert_return_arithmetic_nan":
+		case "assert_malformed", "assert_invalid", "assert_exhaustion", "assert_unlinkable":
 			fmt.Printf("skipping %s\n", cmd.Type)
 		default:
 			panic(cmd.Type)
@@ -206,6 +195,68 @@ func (c *Config) Run(cfgPath string) {
 	}
 }
 
+// resultBits returns the raw bit pattern of a value returned by ExecCode.
+func resultBits(ret interface{}) uint64 {
+	switch v := ret.(type) {
+	case uint32:
+		return uint64(v)
+	case uint64:
+		return v
+	case float32:
+		return uint64(math.Float32bits(v))
+	case float64:
+		return math.Float64bits(v)
+	}
+	panic(fmt.Errorf("unexpected return value %v (%T)", ret, ret))
+}
+
+// checkResult compares the value returned by ExecCode with the expected one.
+// Older versions of wast2json report NaN expectations through the
+// assert_return_canonical_nan and assert_return_arithmetic_nan commands,
+// newer ones through the "nan:canonical" and "nan:arithmetic" values.
+func checkResult(cmdType string, expected ValueInfo, ret interface{}) error {
+	got := resultBits(ret)
+	is32 := expected.Type == "i32" || expected.Type == "f32"
+	if is32 {
+		got = uint64(uint32(got))
+	}
+
+	nan := expected.Value
+	switch cmdType {
+	case "assert_return_canonical_nan":
+		nan = "nan:canonical"
+	case "assert_return_arithmetic_nan":
+		nan = "nan:arithmetic"
+	}
+	switch nan {
+	case "nan:canonical", "nan:arithmetic":
+		// canonical NaNs have only the most significant payload bit set,
+		// arithmetic NaNs at least that bit; either sign is allowed.
+		quiet, mask := uint64(0x7ff8000000000000), uint64(0x7fffffffffffffff)
+		if is32 {
+			quiet, mask = 0x7fc00000, 0x7fffffff
+		}
+		ok := got&mask == quiet
+		if nan == "nan:arithmetic" {
+			ok = got&quiet == quiet
+		}
+		if !ok {
+			return fmt.Errorf("ret mismatch: got %#x, expected %s", got, nan)
+		}
+		return nil
+	}
+
+	var exp uint64
+	fmt.Sscanf(expected.Value, "%d", &exp)
+	if is32 {
+		exp = uint64(uint32(exp))
+	}
+	if got != exp {
+		return fmt.Errorf("ret mismatch: got %d, expected %d", got, exp)
+	}
+	return nil
+}
+
 func main() {
 	cfg := LoadConfigFromFile(os.Args[1])
 	cfg.Run(os.Args[1])
@@ -0,0 +1,120 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package validate
+
+import (
+	"github.com/ontio/wagon/wasm"
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// verifyMisc verifies an operator prefixed with ops.MiscPrefix. The operands
+// of the operators that are not polymorphic have already been checked.
+func (vm *mockVM) verifyMisc(op ops.Op, module *wasm.Module) error {
+	switch op.Sub {
+	case ops.MemoryInit, ops.DataDrop:
+		index, err := vm.fetchVarUint()
+		if err != nil {
+			return err
+		}
+		// The data section follows the code section, so the number
+		// of data segments is given by the data count section.
+		if module.DataCount == nil {
+			return NoSectionError(wasm.SectionIDDataCount)
+		}
+		if index >= module.DataCount.Count {
+			return InvalidDataIndexError(index)
+		}
+		if op.Sub == ops.MemoryInit {
+			return vm.fetchMemoryIndices(1)
+		}
+	case ops.MemoryCopy:
+		return vm.fetchMemoryIndices(2)
+	case ops.MemoryFill:
+		return vm.fetchMemoryIndices(1)
+	case ops.TableInit, ops.ElemDrop:
+		index, err := vm.fetchVarUint()
+		if err != nil {
+			return err
+		}
+		if module.Elements == nil || index >= uint32(len(module.Elements.Entries)) {
+			return InvalidElementIndexError(index)
+		}
+		if op.Sub == ops.TableInit {
+			table, err := vm.fetchTable(module)
+			if err != nil {
+				return err
+			}
+			if t := module.Elements.Entries[index].ElemType(); t != table.ElementType {
+				return InvalidTypeError{table.ElementType.ValueType(), t.ValueType()}
+			}
+		}
+	case ops.TableCopy:
+		dst, err := vm.fetchTable(module)
+		if err != nil {
+			return err
+		}
+		src, err := vm.fetchTable(module)
+		if err != nil {
+			return err
+		}
+		if src.ElementType != dst.ElementType {
+			return InvalidTypeError{dst.ElementType.ValueType(), src.ElementType.ValueType()}
+		}
+	case ops.TableSize:
+		_, err := vm.fetchTable(module)
+		return err
+	case ops.TableGrow, ops.TableFill:
+		table, err := vm.fetchTable(module)
+		if err != nil {
+			return err
+		}
+		t := table.ElementType.ValueType()
+		if op.Sub == ops.TableFill {
+			return vm.popOperands([]wasm.ValueType{wasm.ValueTypeI32, t, wasm.ValueTypeI32})
+		}
+		if err := vm.popOperands([]wasm.ValueType{t, wasm.ValueTypeI32}); err != nil {
+			return err
+		}
+		vm.pushOperand(wasm.ValueTypeI32)
+	}
+	return nil
+}
+
+// fetchMemoryIndices reads n reserved memory indices.
+func (vm *mockVM) fetchMemoryIndices(n int) error {
+	for i := 0; i < n; i++ {
+		memIndex, err := vm.fetchByte()
+		if err != nil {
+			return err
+		}
+		if memIndex != 0x00 {
+			return ErrMemoryIndex
+		}
+	}
+	return nil
+}
+
+// verifyBulkMemorySegments checks that module does not use the passive
+// segments and the data count section of the bulk memory proposal.
+func verifyBulkMemorySegments(module *wasm.Module) error {
+	if module.DataCount != nil {
+		return FeatureError(ops.FeatureBulkMemory)
+	}
+	if module.Data != nil {
+		for _, entry := range module.Data.Entries {
+			if entry.Passive {
+				return FeatureError(ops.FeatureBulkMemory)
+			}
+		}
+	}
+	if module.Elements != nil {
+		for _, entry := range module.Elements.Entries {
+			if entry.Passive {
+				return FeatureError(ops.FeatureBulkMemory)
+			}
+		}
+	}
+	return nil
+}
@@ -25,6 +25,65 @@ func (e Error) Error() string {
 var ErrStackUnderflow = errors.New("validate: stack underflow")
 var ErrLocalEntryCount = errors.New("validate: function local entry cout overflow")
 
+// ErrMemoryIndex is returned when the reserved memory index of an operator
+// is not zero.
+var ErrMemoryIndex = errors.New("validate: memory index must be 0")
+
+// ErrUnbalancedStack is returned when a block or a function body ends with
+// operands left on the stack besides its results.
+var ErrUnbalancedStack = errors.New("validate: operands left on the stack at the end of a block")
+
+// ErrUnterminatedBlock is returned when a function body ends before one of
+// its blocks.
+var ErrUnterminatedBlock = errors.New("validate: function body ends inside a block")
+
+// ErrIfWithoutElse is returned when an if without an else branch has
+// results that are not its parameters.
+var ErrIfWithoutElse = errors.New("validate: if without else must return its parameters")
+
+// ErrImmutableGlobal is returned when set_global refers to an immutable
+// global.
+var ErrImmutableGlobal = errors.New("validate: set_global on an immutable global")
+
+// ErrInvalidLimits is returned when the minimum size of a table or a
+// memory exceeds its maximum size.
+var ErrInvalidLimits = errors.New("validate: minimum size exceeds maximum size")
+
+// ErrMultipleMemories is returned when a module imports or defines more than
+// one linear memory.
+var ErrMultipleMemories = errors.New("validate: a module may have at most one memory")
+
+// ErrInvalidInitExpr is returned when an initializer expression is not a
+// single constant instruction, or reads a mutable global or a global that
+// is not imported.
+var ErrInvalidInitExpr = errors.New("validate: invalid initializer expression")
+
+// ErrInvalidStartFunction is returned when the start function takes
+// parameters or returns results.
+var ErrInvalidStartFunction = errors.New("validate: start function must take no parameters and return no results")
+
+// ErrFloatingPoint is returned by VerifyWasmCode when a module uses a
+// floating point operator, or a function with floating point parameters
+// or results.
+var ErrFloatingPoint = errors.New("validate: floating point operators and types are not allowed")
+
+// ErrMultiValue is returned by VerifyWasmCode when a module uses the
+// function types with several results or the blocks with parameters of
+// the multi-value proposal.
+var ErrMultiValue = errors.New("validate: multiple results and block parameters are not allowed")
+
+// ErrMutableGlobal is returned by VerifyWasmCode when a module imports or
+// exports a mutable global.
+var ErrMutableGlobal = errors.New("validate: mutable globals may not be imported or exported")
+
+// ErrSelectRef is returned when select without a type immediate is given
+// reference operands.
+var ErrSelectRef = errors.New("validate: select without a type immediate on reference operands")
+
+// ErrTailCallResults is returned when the callee of a tail call does not
+// return the results of the calling function.
+var ErrTailCallResults = errors.New("validate: tail call results do not match the function results")
+
 type InvalidImmediateError struct {
 	ImmType string
 	OpName  string
@@ -62,12 +121,59 @@ func (e InvalidTypeError) Error() string {
 	return fmt.Sprintf("invalid type, got: %v, wanted: %v", e.Got, e.Wanted)
 }
 
+// DeniedImportError is returned when a module imports a field denied by
+// its Policy.
+type DeniedImportError struct {
+	Module string
+	Field  string
+}
+
+func (e DeniedImportError) Error() string {
+	return fmt.Sprintf("import of %s.%s is not allowed", e.Module, e.Field)
+}
+
+// InvalidValueTypeError is returned when a signature, a global or a local
+// declaration has a type which is not a value type.
+type InvalidValueTypeError wasm.ValueType
+
+func (e InvalidValueTypeError) Error() string {
+	return fmt.Sprintf("invalid value type %#x", uint8(e))
+}
+
+type InvalidTypeIndexError uint32
+
+func (e InvalidTypeIndexError) Error() string {
+	return fmt.Sprintf("invalid type index %d", uint32(e))
+}
+
 type InvalidElementIndexError uint32
 
 func (e InvalidElementIndexError) Error() string {
 	return fmt.Sprintf("invalid element index %d", uint32(e))
 }
 
+type InvalidDataIndexError uint32
+
+func (e InvalidDataIndexError) Error() string {
+	return fmt.Sprintf("invalid data segment index %d", uint32(e))
+}
+
+// UndeclaredFunctionRefError is returned when ref.func refers to a function
+// that is neither exported nor referred to by an element segment or a global.
+type UndeclaredFunctionRefError uint32
+
+func (e UndeclaredFunctionRefError) Error() string {
+	return fmt.Sprintf("undeclared function reference %d", uint32(e))
+}
+
+// FeatureError is returned when a module uses a section, a segment or a
+// signature introduced by a proposal that is not enabled.
+type FeatureError ops.Features
+
+func (e FeatureError) Error() string {
+	return fmt.Sprintf("module requires the %v feature", ops.Features(e))
+}
+
 type NoSectionError wasm.SectionID
 
 func (e NoSectionError) Error() string {
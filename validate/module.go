@@ -0,0 +1,411 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package validate
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/ontio/wagon/wasm"
+	"github.com/ontio/wagon/wasm/leb128"
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// maxMemoryPages is the largest size of a linear memory, in pages, that
+// can be addressed with 32-bit offsets.
+const maxMemoryPages = 65536
+
+// codeLimits are the sizes allowed by VerifyWasmCode, which are those of
+// the validator run by VerifyWasmCodeFromRust.
+var codeLimits = wasm.Limits{
+	MemoryPages: maxMemoryPages,
+	TableSize:   10000000,
+	Locals:      50000,
+	Functions:   1000000,
+	Globals:     1000000,
+	BodySize:    128 * 1024,
+}
+
+// VerifyWasmCode decodes and verifies the module in code, which is
+// accepted if and only if VerifyWasmCodeFromRust accepts it. Besides being
+// valid with the MVP operators only, the module must not use floating
+// point operators, nor floating point parameters or results in the
+// signatures of its functions, nor import or export mutable globals.
+//
+// The imports of the module are not resolved. The error returned is the
+// first Diagnostic of VerifyWasmCodeAll.
+func VerifyWasmCode(code []byte) error {
+	if report := VerifyWasmCodeAll(code); len(report) != 0 {
+		return report[0]
+	}
+	return nil
+}
+
+// VerifyWasmCodeAll is like VerifyWasmCode, but returns all the errors
+// found in the module, or nil if it is valid. The verification of a
+// function body stops at its first error, and a module which cannot be
+// decoded has no other error.
+func VerifyWasmCodeAll(code []byte) Report {
+	decoded, err := wasm.DecodeModule(bytes.NewReader(code))
+	if err != nil {
+		return Report{{Function: -1, Offset: -1, Err: err}}
+	}
+	r := &reporter{module: decoded}
+	module := indexModule(r)
+	if module.Code != nil {
+		r.code = codeOffsets(module.Code)
+	}
+
+	if err := codeLimits.Check(module); err != nil {
+		r.add(limitSection(err), -1, err)
+	}
+	verifyModuleFields(r)
+	if err := verifyFeatures(module, 0); err != nil {
+		r.add(wasm.SectionIDCustom, -1, err)
+	}
+	for i, fn := range module.FunctionIndexSpace {
+		if fn.Body == nil {
+			continue
+		}
+		if vm, err := verifyBody(fn.Sig, fn.Body, module, 0, codeLimits, nil); err != nil {
+			r.addCode(i, vm.opStart, vm.op.Name, err)
+		}
+	}
+	wasmCodePolicy.check(r)
+	return r.report
+}
+
+// limitSection returns the section holding the size reported by the
+// OutsizeError err.
+func limitSection(err error) wasm.SectionID {
+	var outsize wasm.OutsizeError
+	if !errors.As(err, &outsize) {
+		return wasm.SectionIDCustom
+	}
+	switch outsize.ImmType {
+	case "Memory":
+		return wasm.SectionIDMemory
+	case "Table":
+		return wasm.SectionIDTable
+	case "Function":
+		return wasm.SectionIDFunction
+	case "Global":
+		return wasm.SectionIDGlobal
+	case "Data":
+		return wasm.SectionIDData
+	}
+	return wasm.SectionIDCode
+}
+
+// indexModule returns a copy of the decoded module of r whose function and
+// global index spaces hold its imports, whose bodies are nil, followed by
+// its definitions. The functions whose type is invalid are reported, and
+// have no body so that they are not verified.
+func indexModule(r *reporter) *wasm.Module {
+	module := *r.module
+	module.FunctionIndexSpace = nil
+	module.GlobalIndexSpace = nil
+	r.module = &module
+
+	sig := func(index uint32) (*wasm.FunctionSig, error) {
+		if module.Types == nil || int(index) >= len(module.Types.Entries) {
+			return &wasm.FunctionSig{}, InvalidTypeIndexError(index)
+		}
+		return &module.Types.Entries[index], nil
+	}
+	if module.Import != nil {
+		for _, entry := range module.Import.Entries {
+			switch t := entry.Type.(type) {
+			case wasm.FuncImport:
+				s, err := sig(t.Type)
+				if err != nil {
+					r.add(wasm.SectionIDImport, len(module.FunctionIndexSpace), err)
+				}
+				module.FunctionIndexSpace = append(module.FunctionIndexSpace, wasm.Function{Sig: s})
+			case wasm.GlobalVarImport:
+				module.GlobalIndexSpace = append(module.GlobalIndexSpace, wasm.GlobalEntry{Type: t.Type})
+			}
+		}
+	}
+	if module.Function != nil && len(module.Function.Types) != 0 && module.Code == nil {
+		r.add(wasm.SectionIDFunction, -1, NoSectionError(wasm.SectionIDCode))
+	}
+	if module.Function != nil {
+		for i, index := range module.Function.Types {
+			fn := wasm.Function{}
+			var err error
+			if fn.Sig, err = sig(index); err != nil {
+				r.add(wasm.SectionIDFunction, len(module.FunctionIndexSpace), err)
+			} else if module.Code != nil {
+				fn.Body = &module.Code.Bodies[i]
+			}
+			module.FunctionIndexSpace = append(module.FunctionIndexSpace, fn)
+		}
+	}
+	if module.Global != nil {
+		module.GlobalIndexSpace = append(module.GlobalIndexSpace, module.Global.Globals...)
+	}
+	return &module
+}
+
+// verifyModuleFields verifies the parts of the module of r other than the
+// function bodies: its value types, the limits of its tables and memories,
+// the initializer expressions of its globals and segments, and the indices
+// of its exports, start function and segments. The index spaces of the
+// module must hold its imports.
+func verifyModuleFields(r *reporter) {
+	module := r.module
+	checkValueTypes(module, r.add)
+	var memories int
+	checkMemory := func(lim wasm.ResizableLimits) error {
+		memories++
+		if memories > 1 {
+			return ErrMultipleMemories
+		}
+		if lim.Initial > maxMemoryPages || (lim.Flags&0x1 != 0 && lim.Maximum > maxMemoryPages) {
+			return wasm.OutsizeError{ImmType: "Memory", Size: uint64(lim.Initial), Max: maxMemoryPages}
+		}
+		return checkLimits(lim)
+	}
+	var importedGlobals int
+	if module.Import != nil {
+		for _, entry := range module.Import.Entries {
+			var err error
+			switch t := entry.Type.(type) {
+			case wasm.TableImport:
+				err = checkLimits(t.Type.Limits)
+			case wasm.MemoryImport:
+				err = checkMemory(t.Type.Limits)
+			case wasm.GlobalVarImport:
+				importedGlobals++
+			}
+			if err != nil {
+				r.add(wasm.SectionIDImport, -1, err)
+			}
+		}
+	}
+	if module.Table != nil {
+		for _, table := range module.Table.Entries {
+			if err := checkLimits(table.Limits); err != nil {
+				r.add(wasm.SectionIDTable, -1, err)
+			}
+		}
+	}
+	if module.Memory != nil {
+		for _, memory := range module.Memory.Entries {
+			if err := checkMemory(memory.Limits); err != nil {
+				r.add(wasm.SectionIDMemory, -1, err)
+			}
+		}
+	}
+
+	if module.Global != nil {
+		for _, global := range module.Global.Globals {
+			if err := checkInitExpr(module, global.Init, global.Type.Type, importedGlobals); err != nil {
+				r.add(wasm.SectionIDGlobal, -1, err)
+			}
+		}
+	}
+
+	if module.Export != nil {
+		for _, name := range module.Export.Names {
+			if err := checkExport(module, module.Export.Entries[name]); err != nil {
+				r.add(wasm.SectionIDExport, -1, err)
+			}
+		}
+	}
+
+	if module.Start != nil {
+		fn := module.GetFunction(int(module.Start.Index))
+		if fn == nil {
+			r.add(wasm.SectionIDStart, -1, wasm.InvalidFunctionIndexError(module.Start.Index))
+		} else if len(fn.Sig.ParamTypes) != 0 || len(fn.Sig.ReturnTypes) != 0 {
+			r.add(wasm.SectionIDStart, int(module.Start.Index), ErrInvalidStartFunction)
+		}
+	}
+
+	if module.Elements != nil {
+		for _, entry := range module.Elements.Entries {
+			if !entry.Passive && !entry.Declarative {
+				if module.GetTable(int(entry.Index)) == nil {
+					r.add(wasm.SectionIDElement, -1, wasm.InvalidTableIndexError(entry.Index))
+				}
+				if err := checkInitExpr(module, entry.Offset, wasm.ValueTypeI32, importedGlobals); err != nil {
+					r.add(wasm.SectionIDElement, -1, err)
+				}
+			}
+			for _, index := range entry.Elems {
+				if module.GetFunction(int(index)) == nil {
+					r.add(wasm.SectionIDElement, -1, wasm.InvalidFunctionIndexError(index))
+				}
+			}
+		}
+	}
+
+	if module.Data != nil {
+		for _, entry := range module.Data.Entries {
+			if entry.Passive {
+				continue
+			}
+			if entry.Index != 0 || !hasMemory(module) {
+				r.add(wasm.SectionIDData, -1, wasm.InvalidLinearMemoryIndexError(entry.Index))
+			}
+			if err := checkInitExpr(module, entry.Offset, wasm.ValueTypeI32, importedGlobals); err != nil {
+				r.add(wasm.SectionIDData, -1, err)
+			}
+		}
+	}
+}
+
+// checkValueTypes calls report for each invalid value type in the function
+// signatures, global imports, globals and local declarations of module,
+// with the index of the function declaring the locals, or -1.
+func checkValueTypes(module *wasm.Module, report func(id wasm.SectionID, fn int, err error)) {
+	check := func(id wasm.SectionID, fn int, types ...wasm.ValueType) {
+		for _, t := range types {
+			if !isValueType(t) {
+				report(id, fn, InvalidValueTypeError(t))
+			}
+		}
+	}
+	if module.Types != nil {
+		for _, sig := range module.Types.Entries {
+			check(wasm.SectionIDType, -1, sig.ParamTypes...)
+			check(wasm.SectionIDType, -1, sig.ReturnTypes...)
+		}
+	}
+	imported := 0
+	if module.Import != nil {
+		for _, entry := range module.Import.Entries {
+			switch t := entry.Type.(type) {
+			case wasm.FuncImport:
+				imported++
+			case wasm.GlobalVarImport:
+				check(wasm.SectionIDImport, -1, t.Type.Type)
+			}
+		}
+	}
+	if module.Global != nil {
+		for _, global := range module.Global.Globals {
+			check(wasm.SectionIDGlobal, -1, global.Type.Type)
+		}
+	}
+	if module.Code != nil {
+		for i, body := range module.Code.Bodies {
+			for _, entry := range body.Locals {
+				check(wasm.SectionIDCode, imported+i, entry.Type)
+			}
+		}
+	}
+}
+
+func checkLimits(lim wasm.ResizableLimits) error {
+	if lim.Flags&0x1 != 0 && lim.Initial > lim.Maximum {
+		return ErrInvalidLimits
+	}
+	return nil
+}
+
+// checkExport checks that entry refers to an existing function, table,
+// memory or global.
+func checkExport(module *wasm.Module, entry wasm.ExportEntry) error {
+	switch entry.Kind {
+	case wasm.ExternalFunction:
+		if module.GetFunction(int(entry.Index)) == nil {
+			return wasm.InvalidFunctionIndexError(entry.Index)
+		}
+	case wasm.ExternalTable:
+		if module.GetTable(int(entry.Index)) == nil {
+			return wasm.InvalidTableIndexError(entry.Index)
+		}
+	case wasm.ExternalMemory:
+		if entry.Index != 0 || !hasMemory(module) {
+			return wasm.InvalidLinearMemoryIndexError(entry.Index)
+		}
+	case wasm.ExternalGlobal:
+		if module.GetGlobal(int(entry.Index)) == nil {
+			return wasm.InvalidGlobalIndexError(entry.Index)
+		}
+	default:
+		return wasm.InvalidExternalError(entry.Kind)
+	}
+	return nil
+}
+
+// checkInitExpr checks that the initializer expression expr is a single
+// constant instruction giving a value of type want. It may only read the
+// first globals globals, which are the imported ones, if they are
+// immutable.
+func checkInitExpr(module *wasm.Module, expr []byte, want wasm.ValueType, globals int) error {
+	r := bytes.NewReader(expr)
+	op, err := r.ReadByte()
+	if err != nil {
+		return ErrInvalidInitExpr
+	}
+	var t wasm.ValueType
+	switch op {
+	case ops.I32Const:
+		t = wasm.ValueTypeI32
+		_, err = leb128.ReadVarint32(r)
+	case ops.I64Const:
+		t = wasm.ValueTypeI64
+		_, err = leb128.ReadVarint64(r)
+	case ops.F32Const:
+		t = wasm.ValueTypeF32
+		_, err = io.ReadFull(r, make([]byte, 4))
+	case ops.F64Const:
+		t = wasm.ValueTypeF64
+		_, err = io.ReadFull(r, make([]byte, 8))
+	case ops.GetGlobal:
+		var index uint32
+		if index, err = leb128.ReadVarUint32(r); err != nil {
+			break
+		}
+		global := module.GetGlobal(int(index))
+		if global == nil || int(index) >= globals {
+			return wasm.InvalidGlobalIndexError(index)
+		}
+		if global.Type.Mutable {
+			return ErrInvalidInitExpr
+		}
+		t = global.Type.Type
+	case ops.RefNull:
+		var elem wasm.ElemType
+		err = elem.UnmarshalWASM(r)
+		t = elem.ValueType()
+	case ops.RefFunc:
+		var index uint32
+		if index, err = leb128.ReadVarUint32(r); err != nil {
+			break
+		}
+		if module.GetFunction(int(index)) == nil {
+			return wasm.InvalidFunctionIndexError(index)
+		}
+		t = wasm.ValueTypeFuncRef
+	case ops.SIMDPrefix:
+		var sub uint32
+		if sub, err = leb128.ReadVarUint32(r); err != nil {
+			break
+		}
+		if sub != ops.V128Const {
+			return ErrInvalidInitExpr
+		}
+		t = wasm.ValueTypeV128
+		_, err = io.ReadFull(r, make([]byte, 16))
+	default:
+		return ErrInvalidInitExpr
+	}
+	if err != nil {
+		return err
+	}
+	if end, err := r.ReadByte(); err != nil || end != ops.End || r.Len() != 0 {
+		return ErrInvalidInitExpr
+	}
+	if t != want {
+		return InvalidTypeError{want, t}
+	}
+	return nil
+}
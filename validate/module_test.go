@@ -0,0 +1,224 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package validate
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ontio/wagon/wasm"
+)
+
+// section returns the encoding of a section with the given id and payload.
+func section(id byte, payload ...byte) []byte {
+	return append([]byte{id, byte(len(payload))}, payload...)
+}
+
+// moduleBytes returns the encoding of a module made of the given sections.
+func moduleBytes(sections ...[]byte) []byte {
+	header := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	return bytes.Join(append([][]byte{header}, sections...), nil)
+}
+
+// codeModule returns a module with the given type section payload and
+// the given sections, defining a function of the first type whose body is
+// code followed by end.
+func codeModule(types []byte, code []byte, sections ...[]byte) []byte {
+	body := append(append([]byte{0x00}, code...), 0x0b)
+	return moduleBytes(append([][]byte{
+		section(0x01, types...),
+		section(0x03, 0x01, 0x00),
+	}, append(sections, section(0x0a, append([]byte{0x01, byte(len(body))}, body...)...))...)...)
+}
+
+var (
+	typeVoid = []byte{0x01, 0x60, 0x00, 0x00}
+	typeI32  = []byte{0x01, 0x60, 0x00, 0x01, 0x7f}
+)
+
+func TestVerifyWasmCode(t *testing.T) {
+	memory := section(0x05, 0x01, 0x00, 0x01)
+	for _, tc := range []struct {
+		name  string
+		code  []byte
+		valid bool
+	}{
+		{"empty function", codeModule(typeVoid, nil), true},
+		{"i32 result", codeModule(typeI32, []byte{0x41, 0x01}), true},
+		{"missing result", codeModule(typeI32, nil), false},
+		{"operands left", codeModule(typeVoid, []byte{0x41, 0x01}), false},
+		{"unreachable operands", codeModule(typeI32, []byte{0x00, 0x6a}), true},
+		{"unreachable type mismatch", codeModule(typeI32, []byte{0x00, 0x42, 0x00, 0x6a}), false},
+		{"branch in unreachable block", codeModule(typeI32, []byte{0x02, 0x7f, 0x00, 0x0c, 0x00, 0x0b}), true},
+		{"block underflow", codeModule(typeI32, []byte{0x41, 0x01, 0x02, 0x7f, 0x6a, 0x0b}), false},
+		{"if without else", codeModule(typeI32, []byte{0x41, 0x01, 0x04, 0x7f, 0x41, 0x01, 0x0b}), false},
+		{"if with else", codeModule(typeI32, []byte{0x41, 0x01, 0x04, 0x7f, 0x41, 0x01, 0x05, 0x41, 0x02, 0x0b}), true},
+		{"br_table arities", codeModule(typeVoid, []byte{0x02, 0x7f, 0x02, 0x40, 0x41, 0x00, 0x0e, 0x01, 0x00, 0x01, 0x0b, 0x41, 0x00, 0x0b, 0x1a}), false},
+		{"select mismatch", codeModule(typeI32, []byte{0x41, 0x00, 0x42, 0x00, 0x41, 0x00, 0x1b}), false},
+		{"float operator", codeModule(typeVoid, []byte{0x43, 0x00, 0x00, 0x00, 0x00, 0x1a}), false},
+		{"float result", codeModule([]byte{0x01, 0x60, 0x00, 0x01, 0x7d}, []byte{0x00}), false},
+		{"sign extension", codeModule(typeI32, []byte{0x41, 0x00, 0xc0}), false},
+		{"block type index", codeModule(typeVoid, []byte{0x02, 0x00, 0x0b}), false},
+		{"multiple results", codeModule([]byte{0x01, 0x60, 0x00, 0x02, 0x7f, 0x7f}, []byte{0x41, 0x00, 0x41, 0x00}), false},
+		{"load", codeModule(typeI32, []byte{0x41, 0x00, 0x28, 0x02, 0x00}, memory), true},
+		{"load without memory", codeModule(typeI32, []byte{0x41, 0x00, 0x28, 0x02, 0x00}), false},
+		{"load alignment", codeModule(typeI32, []byte{0x41, 0x00, 0x28, 0x03, 0x00}, memory), false},
+		{"memory limits", codeModule(typeVoid, nil, section(0x05, 0x01, 0x01, 0x02, 0x01)), false},
+		{"memory size", codeModule(typeVoid, nil, section(0x05, 0x01, 0x00, 0x81, 0x80, 0x04)), false},
+		{"table limits", codeModule(typeVoid, nil, section(0x04, 0x01, 0x70, 0x01, 0x02, 0x01)), false},
+		{"set immutable global", codeModule(typeVoid, []byte{0x41, 0x00, 0x24, 0x00},
+			section(0x06, 0x01, 0x7f, 0x00, 0x41, 0x00, 0x0b)), false},
+		{"set mutable global", codeModule(typeVoid, []byte{0x41, 0x00, 0x24, 0x00},
+			section(0x06, 0x01, 0x7f, 0x01, 0x41, 0x00, 0x0b)), true},
+		{"global type mismatch", codeModule(typeVoid, nil, section(0x06, 0x01, 0x7f, 0x00, 0x42, 0x00, 0x0b)), false},
+		{"global reading a global", codeModule(typeVoid, nil,
+			section(0x06, 0x02, 0x7f, 0x00, 0x41, 0x00, 0x0b, 0x7f, 0x00, 0x23, 0x00, 0x0b)), false},
+		{"global reading an import", moduleBytes(
+			section(0x02, 0x01, 0x01, 'm', 0x01, 'g', 0x03, 0x7f, 0x00),
+			section(0x06, 0x01, 0x7f, 0x00, 0x23, 0x00, 0x0b)), true},
+		{"mutable global import", moduleBytes(
+			section(0x02, 0x01, 0x01, 'm', 0x01, 'g', 0x03, 0x7f, 0x01)), false},
+		{"mutable global export", moduleBytes(
+			section(0x06, 0x01, 0x7f, 0x01, 0x41, 0x00, 0x0b),
+			section(0x07, 0x01, 0x01, 'g', 0x03, 0x00)), false},
+		{"float function import", moduleBytes(
+			section(0x01, 0x01, 0x60, 0x01, 0x7d, 0x00),
+			section(0x02, 0x01, 0x01, 'm', 0x01, 'f', 0x00, 0x00)), true},
+		{"import type index", moduleBytes(
+			section(0x01, 0x01, 0x60, 0x00, 0x00),
+			section(0x02, 0x01, 0x01, 'm', 0x01, 'f', 0x00, 0x01)), false},
+		{"export index", codeModule(typeVoid, nil, section(0x07, 0x01, 0x01, 'f', 0x00, 0x01)), false},
+		{"start", codeModule(typeVoid, nil, section(0x08, 0x00)), true},
+		{"start signature", codeModule(typeI32, []byte{0x41, 0x00}, section(0x08, 0x00)), false},
+		{"element index", codeModule(typeVoid, nil,
+			section(0x04, 0x01, 0x70, 0x00, 0x01),
+			section(0x09, 0x01, 0x00, 0x41, 0x00, 0x0b, 0x01, 0x01)), false},
+		{"element without table", codeModule(typeVoid, nil,
+			section(0x09, 0x01, 0x00, 0x41, 0x00, 0x0b, 0x01, 0x00)), false},
+		{"data offset type", moduleBytes(memory,
+			section(0x0b, 0x01, 0x00, 0x42, 0x00, 0x0b, 0x01, 'a')), false},
+		{"data without memory", moduleBytes(
+			section(0x0b, 0x01, 0x00, 0x41, 0x00, 0x0b, 0x01, 'a')), false},
+		{"local type", moduleBytes(
+			section(0x01, typeVoid...),
+			section(0x03, 0x01, 0x00),
+			section(0x0a, 0x01, 0x04, 0x01, 0x01, 0x7e, 0x0b)), true},
+		{"invalid local type", moduleBytes(
+			section(0x01, typeVoid...),
+			section(0x03, 0x01, 0x00),
+			section(0x0a, 0x01, 0x04, 0x01, 0x01, 0x40, 0x0b)), false},
+		{"invalid param type", codeModule([]byte{0x01, 0x60, 0x01, 0x3c, 0x00}, nil), false},
+		{"invalid param type 0xed", codeModule([]byte{0x01, 0x60, 0x01, 0xed, 0x00}, nil), false},
+		{"invalid result type", codeModule([]byte{0x01, 0x60, 0x00, 0x01, 0xed}, []byte{0x00}), false},
+		{"invalid global import type", moduleBytes(
+			section(0x02, 0x01, 0x01, 'm', 0x01, 'g', 0x03, 0xff, 0x00)), false},
+		{"invalid global type", codeModule(typeVoid, nil, section(0x06, 0x01, 0x40, 0x00, 0x41, 0x00, 0x0b)), false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := VerifyWasmCode(tc.code)
+			if tc.valid && err != nil {
+				t.Errorf("VerifyWasmCode: %v", err)
+			} else if !tc.valid && err == nil {
+				t.Errorf("VerifyWasmCode accepted an invalid module")
+			}
+			if rustErr := VerifyWasmCodeFromRust(tc.code); (rustErr == nil) != tc.valid {
+				t.Errorf("VerifyWasmCodeFromRust: got error %v, want valid %v", rustErr, tc.valid)
+			}
+		})
+	}
+}
+
+func TestVerifyWasmCodeCorpus(t *testing.T) {
+	for _, dir := range testPaths {
+		fnames, err := filepath.Glob(filepath.Join(dir, "*.wasm"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, fname := range fnames {
+			name := fname
+			t.Run(name, func(t *testing.T) {
+				raw, err := ioutil.ReadFile(name)
+				if err != nil {
+					t.Fatal(err)
+				}
+				err = VerifyWasmCode(raw)
+				rustErr := VerifyWasmCodeFromRust(raw)
+				if (err == nil) != (rustErr == nil) {
+					t.Errorf("VerifyWasmCode: %v, VerifyWasmCodeFromRust: %v", err, rustErr)
+				}
+			})
+		}
+	}
+}
+
+func benchmarkVerify(b *testing.B, verify func([]byte) error) {
+	raw, err := ioutil.ReadFile("../wasm/testdata/int_exprs.wasm")
+	if err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < b.N; i++ {
+		if err := verify(raw); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkVerifyWasmCode(b *testing.B)         { benchmarkVerify(b, VerifyWasmCode) }
+func BenchmarkVerifyWasmCodeFromRust(b *testing.B) { benchmarkVerify(b, VerifyWasmCodeFromRust) }
+
+func TestVerifyWasmCodeAll(t *testing.T) {
+	// Function 0 adds an i64 to an i32, function 1 uses f32.const, and
+	// the memory has a maximum below its minimum.
+	code := moduleBytes(
+		section(0x01, typeI32...),
+		section(0x03, 0x02, 0x00, 0x00),
+		section(0x05, 0x01, 0x01, 0x02, 0x01),
+		section(0x0a, 0x02,
+			0x07, 0x00, 0x41, 0x01, 0x42, 0x00, 0x6a, 0x0b,
+			0x0a, 0x00, 0x43, 0x00, 0x00, 0x00, 0x00, 0x1a, 0x41, 0x00, 0x0b),
+	)
+	want := Report{
+		{Section: wasm.SectionIDMemory, Function: -1, Offset: 0x16, Err: ErrInvalidLimits},
+		{Section: wasm.SectionIDCode, Function: 0, Offset: 0x23, Op: "i32.add",
+			Wanted: wasm.ValueTypeI32, Got: wasm.ValueTypeI64, Err: InvalidTypeError{wasm.ValueTypeI32, wasm.ValueTypeI64}},
+		{Section: wasm.SectionIDCode, Function: 1, Offset: 0x27, Op: "f32.const", Err: ErrFloatingPoint},
+	}
+	report := VerifyWasmCodeAll(code)
+	if !reflect.DeepEqual(report, want) {
+		t.Fatalf("got report\n%v\nwant\n%v", report, want)
+	}
+	if err := VerifyWasmCode(code); err != want[0] {
+		t.Errorf("VerifyWasmCode: got error %v, want %v", err, want[0])
+	}
+	if got, want := report[1].Error(), "offset 0x23: code section, function 0, i32.add: invalid type, got: i64, wanted: i32"; got != want {
+		t.Errorf("got error %q, want %q", got, want)
+	}
+
+	var buf bytes.Buffer
+	if _, err := report.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 5 || lines[4] != "3 errors" {
+		t.Fatalf("got report\n%s", buf.String())
+	}
+	for i, want := range [][]string{
+		{"OFFSET", "SECTION", "FUNCTION", "OPERATOR", "WANTED", "GOT", "ERROR"},
+		{"0x00000016", "memory", "-", "-", "-", "-", "validate:"},
+		{"0x00000023", "code", "0", "i32.add", "i32", "i64", "invalid"},
+	} {
+		if got := strings.Fields(lines[i]); !reflect.DeepEqual(got[:len(want)], want) {
+			t.Errorf("line %d: got %q, want fields %q", i, lines[i], want)
+		}
+	}
+
+	if report := VerifyWasmCodeAll(codeModule(typeVoid, nil)); report != nil {
+		t.Errorf("valid module: got report %v", report)
+	}
+}
@@ -11,3 +11,12 @@ import (
 type operand struct {
 	Type wasm.ValueType
 }
+
+// unknownType is the type of the operands popped from an unreachable
+// block, which may be of any type.
+const unknownType wasm.ValueType = 0
+
+// is reports whether o may be of type t.
+func (o operand) is(t wasm.ValueType) bool {
+	return o.Type == t || o.Type == unknownType
+}
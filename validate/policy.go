@@ -0,0 +1,324 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package validate
+
+import (
+	"github.com/ontio/wagon/disasm"
+	"github.com/ontio/wagon/exec"
+	"github.com/ontio/wagon/wasm"
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// ImportName names an imported module, or one of its fields.
+type ImportName struct {
+	Module string
+	Field  string // the empty field names every field of Module
+}
+
+// Policy lists the restrictions applied to modules on top of their
+// validity, such as those keeping the execution of smart contracts
+// deterministic. Its zero value applies no restriction, and a zero size
+// leaves the corresponding size unbounded.
+//
+// Policy is the authoritative set of restrictions checked when deploying a
+// module. The wasm.Config used to read the module and the wasm.Policy used
+// to compile it must be derived from it with Config and WasmPolicy, so that
+// a module complying with the policy is not rejected by a different bound
+// later, nor a module violating it accepted.
+type Policy struct {
+	// DenyFloats rejects the floating point operators, and the floating
+	// point parameters and results of the functions defined by the
+	// module. Imported functions may have floating point parameters and
+	// results, as they cannot be called with floating point arguments.
+	DenyFloats bool
+	// DenyStart rejects the modules with a start function.
+	DenyStart bool
+	// DenyMutableGlobals rejects the imports and exports of mutable
+	// globals.
+	DenyMutableGlobals bool
+	// DenyMultiValue rejects the function types with several results and
+	// the blocks referring to a function type, of the multi-value
+	// proposal.
+	DenyMultiValue bool
+	// DeniedImports rejects the imports of the given modules or fields.
+	DeniedImports []ImportName
+
+	MaxMemoryPages uint32 // declared initial and maximum pages of a memory
+	MaxTableSize   uint32 // declared initial and maximum elements of a table
+	MaxFunctions   uint32 // functions, including the imported ones
+
+	// MaxStack bounds the slots of the arena of the VM taken by a call of
+	// an exported function or of the start function, and the calls nested
+	// in it, as computed by exec.FunctionCost.StackBound. The recursive
+	// calls are assumed to nest FrameLimit times, or
+	// exec.DefaultFrameLimit times if FrameLimit is zero.
+	MaxStack   uint64
+	FrameLimit uint32
+}
+
+// DefaultPolicy returns the restrictions applied to smart contracts: the
+// floating point operators, the start function, the mutable global
+// imports and exports and the multi-value proposal are denied, and the
+// memories and tables are bounded like by wasm.DefaultLimits.
+func DefaultPolicy() Policy {
+	return Policy{
+		DenyFloats:         true,
+		DenyStart:          true,
+		DenyMutableGlobals: true,
+		DenyMultiValue:     true,
+		MaxMemoryPages:     wasm.MaxPageNum,
+		MaxTableSize:       wasm.MaxTableSize,
+	}
+}
+
+// Config returns the configuration of wasm.ReadModuleWithConfig applying
+// the restrictions of p it is able to check: the start function, and the
+// sizes of the memories, tables and function index space.
+func (p Policy) Config() wasm.Config {
+	return wasm.Config{
+		Policy: p.WasmPolicy(),
+		Limits: wasm.Limits{
+			MemoryPages: p.MaxMemoryPages,
+			TableSize:   p.MaxTableSize,
+			Functions:   p.MaxFunctions,
+		},
+	}
+}
+
+// WasmPolicy returns the policy of exec.CompileModuleWithPolicy applying
+// the restrictions of p.
+func (p Policy) WasmPolicy() wasm.Policy {
+	return wasm.Policy{AllowStart: !p.DenyStart}
+}
+
+// wasmCodePolicy holds the restrictions of VerifyWasmCode, which are those
+// of the validator run by VerifyWasmCodeFromRust.
+var wasmCodePolicy = Policy{
+	DenyFloats:         true,
+	DenyMutableGlobals: true,
+	DenyMultiValue:     true,
+}
+
+// Check returns the violations of p by module, or nil if there is none.
+// The module must be decoded by wasm.DecodeModule, or read by
+// wasm.ReadModule without resolving its imports. Only the first
+// violating operator of each function body is reported.
+func (p Policy) Check(module *wasm.Module) Report {
+	r := &reporter{module: module}
+	if module.Code != nil {
+		r.code = codeOffsets(module.Code)
+	}
+	p.check(r)
+	return r.report
+}
+
+func (p Policy) check(r *reporter) {
+	module := r.module
+	imported := r.imported()
+
+	if p.DenyMultiValue && module.Types != nil {
+		for _, sig := range module.Types.Entries {
+			if len(sig.ReturnTypes) > 1 {
+				r.add(wasm.SectionIDType, -1, ErrMultiValue)
+			}
+		}
+	}
+
+	if module.Import != nil {
+		for _, entry := range module.Import.Entries {
+			if p.deniesImport(entry) {
+				r.add(wasm.SectionIDImport, -1, DeniedImportError{entry.ModuleName, entry.FieldName})
+			}
+			var err error
+			switch t := entry.Type.(type) {
+			case wasm.GlobalVarImport:
+				if p.DenyMutableGlobals && t.Type.Mutable {
+					err = ErrMutableGlobal
+				}
+			case wasm.MemoryImport:
+				err = checkPolicyLimits("Memory", t.Type.Limits, p.MaxMemoryPages)
+			case wasm.TableImport:
+				err = checkPolicyLimits("Table", t.Type.Limits, p.MaxTableSize)
+			}
+			if err != nil {
+				r.add(wasm.SectionIDImport, -1, err)
+			}
+		}
+	}
+
+	if module.Memory != nil {
+		for _, memory := range module.Memory.Entries {
+			if err := checkPolicyLimits("Memory", memory.Limits, p.MaxMemoryPages); err != nil {
+				r.add(wasm.SectionIDMemory, -1, err)
+			}
+		}
+	}
+	if module.Table != nil {
+		for _, table := range module.Table.Entries {
+			if err := checkPolicyLimits("Table", table.Limits, p.MaxTableSize); err != nil {
+				r.add(wasm.SectionIDTable, -1, err)
+			}
+		}
+	}
+
+	functions := uint64(imported)
+	if module.Function != nil {
+		functions += uint64(len(module.Function.Types))
+	}
+	if p.MaxFunctions != 0 && functions > uint64(p.MaxFunctions) {
+		r.add(wasm.SectionIDFunction, -1, wasm.OutsizeError{ImmType: "Function", Size: functions, Max: uint64(p.MaxFunctions)})
+	}
+
+	if p.DenyMutableGlobals && module.Export != nil {
+		for _, name := range module.Export.Names {
+			entry := module.Export.Entries[name]
+			if entry.Kind == wasm.ExternalGlobal && mutableGlobal(module, entry.Index) {
+				r.add(wasm.SectionIDExport, -1, ErrMutableGlobal)
+			}
+		}
+	}
+
+	if p.DenyStart && module.Start != nil {
+		r.add(wasm.SectionIDStart, int(module.Start.Index), wasm.ErrStartNotAllowed)
+	}
+	if p.MaxStack != 0 {
+		p.checkStack(r)
+	}
+
+	if module.Function == nil || module.Code == nil || module.Types == nil {
+		return
+	}
+	for i, typeIndex := range module.Function.Types {
+		if i >= len(module.Code.Bodies) || int(typeIndex) >= len(module.Types.Entries) {
+			break
+		}
+		fn := imported + i
+		sig := module.Types.Entries[typeIndex]
+		if p.DenyFloats && (hasFloat(sig.ParamTypes) || hasFloat(sig.ReturnTypes)) {
+			r.add(wasm.SectionIDFunction, fn, ErrFloatingPoint)
+		}
+		if !p.DenyFloats && !p.DenyMultiValue {
+			continue
+		}
+		instrs, err := disasm.Disassemble(module.Code.Bodies[i].Code)
+		if err != nil {
+			// The body is invalid, which is reported by its verification.
+			continue
+		}
+		for _, instr := range instrs {
+			if err := p.checkInstr(instr); err != nil {
+				r.addCode(fn, instr.Offset, instr.Op.Name, err)
+				break
+			}
+		}
+	}
+}
+
+// checkStack reports the exported functions and the start function of the
+// module of r whose calls may take more than MaxStack slots of the arena.
+func (p Policy) checkStack(r *reporter) {
+	module := indexModule(&reporter{module: r.module})
+	if err := VerifyModuleWithLimits(module, ^ops.Features(0), wasm.Limits{}); err != nil {
+		// The module is invalid, which is reported by its verification.
+		return
+	}
+	costs, err := exec.AnalyzeModule(module, nil)
+	if err != nil {
+		return
+	}
+	frameLimit := int(p.FrameLimit)
+	if frameLimit == 0 {
+		frameLimit = exec.DefaultFrameLimit
+	}
+	check := func(id wasm.SectionID, index uint32) {
+		if int(index) >= len(costs) {
+			return
+		}
+		if slots := costs[index].StackBound(frameLimit); slots > p.MaxStack {
+			r.add(id, int(index), wasm.OutsizeError{ImmType: "Stack", Size: slots, Max: p.MaxStack})
+		}
+	}
+	if module.Export != nil {
+		for _, name := range module.Export.Names {
+			if entry := module.Export.Entries[name]; entry.Kind == wasm.ExternalFunction {
+				check(wasm.SectionIDExport, entry.Index)
+			}
+		}
+	}
+	if module.Start != nil {
+		check(wasm.SectionIDStart, module.Start.Index)
+	}
+}
+
+func (p Policy) deniesImport(entry wasm.ImportEntry) bool {
+	for _, name := range p.DeniedImports {
+		if name.Module == entry.ModuleName && (name.Field == "" || name.Field == entry.FieldName) {
+			return true
+		}
+	}
+	return false
+}
+
+// mutableGlobal reports whether the global of the given index is mutable,
+// the imported globals coming first.
+func mutableGlobal(module *wasm.Module, index uint32) bool {
+	if module.Import != nil {
+		for _, entry := range module.Import.Entries {
+			global, ok := entry.Type.(wasm.GlobalVarImport)
+			if !ok {
+				continue
+			}
+			if index == 0 {
+				return global.Type.Mutable
+			}
+			index--
+		}
+	}
+	if module.Global == nil || int(index) >= len(module.Global.Globals) {
+		return false
+	}
+	return module.Global.Globals[index].Type.Mutable
+}
+
+func (p Policy) checkInstr(instr disasm.Instr) error {
+	if p.DenyFloats && (hasFloat(instr.Op.Args) || isFloat(instr.Op.Returns)) {
+		return ErrFloatingPoint
+	}
+	switch instr.Op.Code {
+	case ops.Block, ops.Loop, ops.If:
+		if _, ok := instr.Immediates[0].(wasm.BlockTypeIndex); ok && p.DenyMultiValue {
+			return ErrMultiValue
+		}
+	}
+	return nil
+}
+
+// checkPolicyLimits returns an OutsizeError if the declared initial or
+// maximum size of lim exceeds max, unless max is zero.
+func checkPolicyLimits(name string, lim wasm.ResizableLimits, max uint32) error {
+	if max == 0 {
+		return nil
+	}
+	if lim.Initial > max {
+		return wasm.OutsizeError{ImmType: name, Size: uint64(lim.Initial), Max: uint64(max)}
+	}
+	if lim.Flags&0x1 != 0 && lim.Maximum > max {
+		return wasm.OutsizeError{ImmType: name, Size: uint64(lim.Maximum), Max: uint64(max)}
+	}
+	return nil
+}
+
+func isFloat(t wasm.ValueType) bool {
+	return t == wasm.ValueTypeF32 || t == wasm.ValueTypeF64
+}
+
+func hasFloat(types []wasm.ValueType) bool {
+	for _, t := range types {
+		if isFloat(t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package validate
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/ontio/wagon/exec"
+	"github.com/ontio/wagon/wasm"
+)
+
+func TestPolicy(t *testing.T) {
+	// The module imports the function env.abort and a mutable global,
+	// has a memory of 200 pages, a table of 2000 elements and a start
+	// function, and defines two functions, the second one using
+	// f32.const.
+	code := moduleBytes(
+		section(0x01, typeVoid...),
+		section(0x02, 0x02,
+			0x03, 'e', 'n', 'v', 0x05, 'a', 'b', 'o', 'r', 't', 0x00, 0x00,
+			0x03, 'e', 'n', 'v', 0x01, 'g', 0x03, 0x7f, 0x01),
+		section(0x03, 0x02, 0x00, 0x00),
+		section(0x04, 0x01, 0x70, 0x00, 0xd0, 0x0f),
+		section(0x05, 0x01, 0x00, 0xc8, 0x01),
+		section(0x08, 0x01),
+		section(0x0a, 0x02,
+			0x02, 0x00, 0x0b,
+			0x08, 0x00, 0x43, 0x00, 0x00, 0x00, 0x00, 0x1a, 0x0b),
+	)
+	want := []struct {
+		section  wasm.SectionID
+		function int
+		err      error
+	}{
+		{wasm.SectionIDImport, -1, DeniedImportError{"env", "abort"}},
+		{wasm.SectionIDImport, -1, ErrMutableGlobal},
+		{wasm.SectionIDMemory, -1, wasm.OutsizeError{ImmType: "Memory", Size: 200, Max: wasm.MaxPageNum}},
+		{wasm.SectionIDTable, -1, wasm.OutsizeError{ImmType: "Table", Size: 2000, Max: wasm.MaxTableSize}},
+		{wasm.SectionIDFunction, -1, wasm.OutsizeError{ImmType: "Function", Size: 3, Max: 2}},
+		{wasm.SectionIDStart, 1, wasm.ErrStartNotAllowed},
+		{wasm.SectionIDCode, 2, ErrFloatingPoint},
+	}
+
+	policy := DefaultPolicy()
+	policy.DeniedImports = []ImportName{{Module: "env", Field: "abort"}}
+	policy.MaxFunctions = 2
+
+	decoded, err := wasm.DecodeModule(bytes.NewReader(code))
+	if err != nil {
+		t.Fatalf("could not decode module: %v", err)
+	}
+	read, err := wasm.ReadModuleWithConfig(bytes.NewReader(code), nil, wasm.Config{Policy: wasm.Policy{AllowStart: true}})
+	if err != nil {
+		t.Fatalf("could not read module: %v", err)
+	}
+	for _, m := range []*wasm.Module{decoded, read} {
+		report := policy.Check(m)
+		if len(report) != len(want) {
+			t.Fatalf("got report\n%v", report)
+		}
+		for i, d := range report {
+			if d.Section != want[i].section || d.Function != want[i].function || d.Err != want[i].err {
+				t.Errorf("got violation %v, want %v in the %v section and function %d", d, want[i].err, want[i].section, want[i].function)
+			}
+		}
+		if float := report[len(report)-1]; float.Offset != 0x43 || float.Op != "f32.const" {
+			t.Errorf("got violation %v, want it at offset 0x43 in f32.const", float)
+		}
+	}
+
+	policy.DeniedImports = []ImportName{{Module: "env"}}
+	if report := policy.Check(decoded); !reflect.DeepEqual(report[:2], Report{
+		{Section: wasm.SectionIDImport, Function: -1, Offset: 0x10, Err: DeniedImportError{"env", "abort"}},
+		{Section: wasm.SectionIDImport, Function: -1, Offset: 0x10, Err: DeniedImportError{"env", "g"}},
+	}) {
+		t.Errorf("denying a module: got report\n%v", report)
+	}
+
+	if report := (Policy{}).Check(decoded); report != nil {
+		t.Errorf("zero policy: got report\n%v", report)
+	}
+}
+
+func TestPolicyConfig(t *testing.T) {
+	// The module has a memory of 200 pages and a start function.
+	code := codeModule(typeVoid, nil, section(0x05, 0x01, 0x00, 0xc8, 0x01), section(0x08, 0x00))
+	decoded, err := wasm.DecodeModule(bytes.NewReader(code))
+	if err != nil {
+		t.Fatalf("could not decode module: %v", err)
+	}
+
+	policy := DefaultPolicy()
+	policy.DenyStart = false
+	want := wasm.OutsizeError{ImmType: "First Calibration Memory", Size: 200, Max: wasm.MaxPageNum}
+	if _, err := wasm.ReadModuleWithConfig(bytes.NewReader(code), nil, policy.Config()); err != want {
+		t.Errorf("got error %v, want %v", err, want)
+	}
+	want.ImmType = "Memory"
+	if report := policy.Check(decoded); len(report) != 1 || report[0].Err != want {
+		t.Errorf("got report\n%v\nwant %v", report, want)
+	}
+
+	policy.MaxMemoryPages = 200
+	m, err := wasm.ReadModuleWithConfig(bytes.NewReader(code), nil, policy.Config())
+	if err != nil {
+		t.Fatalf("could not read module: %v", err)
+	}
+	if _, err := exec.CompileModuleWithPolicy(m, nil, 0, policy.WasmPolicy()); err != nil {
+		t.Errorf("could not compile module: %v", err)
+	}
+
+	policy.DenyStart = true
+	if _, err := wasm.ReadModuleWithConfig(bytes.NewReader(code), nil, policy.Config()); err != wasm.ErrStartNotAllowed {
+		t.Errorf("got error %v, want %v", err, wasm.ErrStartNotAllowed)
+	}
+	if _, err := exec.CompileModuleWithPolicy(m, nil, 0, policy.WasmPolicy()); err != wasm.ErrStartNotAllowed {
+		t.Errorf("got error %v, want %v", err, wasm.ErrStartNotAllowed)
+	}
+}
+
+func TestPolicyStack(t *testing.T) {
+	// The module exports the recursive function f, calling itself, and
+	// the function g, calling h, which pushes one value.
+	code := moduleBytes(
+		section(0x01, typeVoid...),
+		section(0x03, 0x03, 0x00, 0x00, 0x00),
+		section(0x07, 0x02, 0x01, 'f', 0x00, 0x00, 0x01, 'g', 0x00, 0x01),
+		section(0x0a, 0x03,
+			0x04, 0x00, 0x10, 0x00, 0x0b,
+			0x04, 0x00, 0x10, 0x02, 0x0b,
+			0x05, 0x00, 0x41, 0x00, 0x1a, 0x0b),
+	)
+	m, err := wasm.DecodeModule(bytes.NewReader(code))
+	if err != nil {
+		t.Fatalf("could not decode module: %v", err)
+	}
+
+	// The frames of f and g take one slot, and the frame of h two.
+	for _, tc := range []struct {
+		policy Policy
+		want   Report
+	}{
+		{Policy{MaxStack: 10, FrameLimit: 10}, nil},
+		{Policy{MaxStack: 9, FrameLimit: 10}, Report{
+			{Section: wasm.SectionIDExport, Function: 0, Offset: 0x16, Err: wasm.OutsizeError{ImmType: "Stack", Size: 10, Max: 9}},
+		}},
+		{Policy{MaxStack: 2, FrameLimit: 2}, Report{
+			{Section: wasm.SectionIDExport, Function: 1, Offset: 0x16, Err: wasm.OutsizeError{ImmType: "Stack", Size: 3, Max: 2}},
+		}},
+	} {
+		if report := tc.policy.Check(m); !reflect.DeepEqual(report, tc.want) {
+			t.Errorf("%+v: got report\n%v\nwant\n%v", tc.policy, report, tc.want)
+		}
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package validate
+
+import (
+	"bytes"
+
+	"github.com/ontio/wagon/wasm"
+	"github.com/ontio/wagon/wasm/leb128"
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// verifyRef verifies the table and reference operators of the reference
+// types proposal, and typed select. refs holds the functions that ref.func
+// may refer to.
+func (vm *mockVM) verifyRef(op ops.Op, module *wasm.Module, refs map[uint32]bool) error {
+	switch op.Code {
+	case ops.SelectTyped:
+		n, err := vm.fetchVarUint()
+		if err != nil {
+			return err
+		}
+		if n != 1 {
+			return InvalidImmediateError{"single value type", op.Name}
+		}
+		b, err := vm.fetchByte()
+		if err != nil {
+			return err
+		}
+		t := wasm.ValueType(b)
+		if !isValueType(t) {
+			return InvalidImmediateError{"value type", op.Name}
+		}
+		if err := vm.popOperands([]wasm.ValueType{t, t, wasm.ValueTypeI32}); err != nil {
+			return err
+		}
+		vm.pushOperand(t)
+	case ops.TableGet, ops.TableSet:
+		table, err := vm.fetchTable(module)
+		if err != nil {
+			return err
+		}
+		t := table.ElementType.ValueType()
+		if op.Code == ops.TableSet {
+			return vm.popOperands([]wasm.ValueType{wasm.ValueTypeI32, t})
+		}
+		if err := vm.popOperands([]wasm.ValueType{wasm.ValueTypeI32}); err != nil {
+			return err
+		}
+		vm.pushOperand(t)
+	case ops.RefNull:
+		var t wasm.ElemType
+		if err := t.UnmarshalWASM(vm.code); err != nil {
+			return err
+		}
+		vm.pushOperand(t.ValueType())
+	case ops.RefIsNull:
+		o, under := vm.popOperand()
+		if under || (o.Type != unknownType && !o.Type.IsRef()) {
+			return InvalidTypeError{wasm.ValueTypeFuncRef, o.Type}
+		}
+		vm.pushOperand(wasm.ValueTypeI32)
+	case ops.RefFunc:
+		index, err := vm.fetchVarUint()
+		if err != nil {
+			return err
+		}
+		if module.GetFunction(int(index)) == nil {
+			return wasm.InvalidFunctionIndexError(index)
+		}
+		if !refs[index] {
+			return UndeclaredFunctionRefError(index)
+		}
+	}
+	return nil
+}
+
+// isValueType reports whether t is a valid value type.
+func isValueType(t wasm.ValueType) bool {
+	switch t {
+	case wasm.ValueTypeI32, wasm.ValueTypeI64, wasm.ValueTypeF32, wasm.ValueTypeF64, wasm.ValueTypeV128:
+		return true
+	}
+	return t.IsRef()
+}
+
+// fetchTable reads a table index and returns the table it refers to.
+func (vm *mockVM) fetchTable(module *wasm.Module) (*wasm.Table, error) {
+	index, err := vm.fetchVarUint()
+	if err != nil {
+		return nil, err
+	}
+	table := module.GetTable(int(index))
+	if table == nil {
+		return nil, wasm.InvalidTableIndexError(index)
+	}
+	return table, nil
+}
+
+// declaredFuncRefs returns the functions that ref.func may refer to: those
+// exported, or referred to by an element segment or a global initializer.
+func declaredFuncRefs(module *wasm.Module) map[uint32]bool {
+	refs := make(map[uint32]bool)
+	addExpr := func(expr []byte) {
+		if len(expr) == 0 || expr[0] != ops.RefFunc {
+			return
+		}
+		if index, err := leb128.ReadVarUint32(bytes.NewReader(expr[1:])); err == nil {
+			refs[index] = true
+		}
+	}
+	if module.Export != nil {
+		for _, entry := range module.Export.Entries {
+			if entry.Kind == wasm.ExternalFunction {
+				refs[entry.Index] = true
+			}
+		}
+	}
+	if module.Elements != nil {
+		for _, entry := range module.Elements.Entries {
+			for _, index := range entry.Elems {
+				refs[index] = true
+			}
+			for _, expr := range entry.Exprs {
+				addExpr(expr)
+			}
+		}
+	}
+	if module.Global != nil {
+		for _, global := range module.Global.Globals {
+			addExpr(global.Init)
+		}
+	}
+	return refs
+}
+
+// verifyElementSegments checks that the active element segments refer to a
+// table of their type, and that the segments holding expressions hold
+// references.
+func verifyElementSegments(module *wasm.Module) error {
+	if module.Elements == nil {
+		return nil
+	}
+	for _, entry := range module.Elements.Entries {
+		if !entry.Passive && !entry.Declarative {
+			table := module.GetTable(int(entry.Index))
+			if table == nil {
+				return wasm.InvalidTableIndexError(entry.Index)
+			}
+			if table.ElementType != entry.ElemType() {
+				return InvalidTypeError{table.ElementType.ValueType(), entry.ElemType().ValueType()}
+			}
+		}
+		if entry.Exprs != nil {
+			if _, err := entry.Entries(module); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// verifyReferenceTypes checks that module does not use the reference types,
+// the multiple tables and the element segments of the reference types
+// proposal.
+func verifyReferenceTypes(module *wasm.Module) error {
+	var tables int
+	if module.Import != nil {
+		for _, entry := range module.Import.Entries {
+			if table, ok := entry.Type.(wasm.TableImport); ok {
+				if table.Type.ElementType != wasm.ElemTypeAnyFunc {
+					return FeatureError(ops.FeatureReferenceTypes)
+				}
+				tables++
+			}
+		}
+	}
+	if module.Table != nil {
+		for _, table := range module.Table.Entries {
+			if table.ElementType != wasm.ElemTypeAnyFunc {
+				return FeatureError(ops.FeatureReferenceTypes)
+			}
+			tables++
+		}
+	}
+	if tables > 1 {
+		return FeatureError(ops.FeatureReferenceTypes)
+	}
+
+	if module.Elements != nil {
+		for _, entry := range module.Elements.Entries {
+			if entry.Declarative || entry.Exprs != nil {
+				return FeatureError(ops.FeatureReferenceTypes)
+			}
+		}
+	}
+	if module.Types != nil {
+		for _, sig := range module.Types.Entries {
+			if hasRefType(sig.ParamTypes) || hasRefType(sig.ReturnTypes) {
+				return FeatureError(ops.FeatureReferenceTypes)
+			}
+		}
+	}
+	if module.Global != nil {
+		for _, global := range module.Global.Globals {
+			if global.Type.Type.IsRef() {
+				return FeatureError(ops.FeatureReferenceTypes)
+			}
+		}
+	}
+	if module.Code != nil {
+		for _, body := range module.Code.Bodies {
+			for _, entry := range body.Locals {
+				if entry.Type.IsRef() {
+					return FeatureError(ops.FeatureReferenceTypes)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func hasRefType(types []wasm.ValueType) bool {
+	for _, t := range types {
+		if t.IsRef() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,210 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package validate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ontio/wagon/wasm"
+	"github.com/ontio/wagon/wasm/leb128"
+)
+
+// Diagnostic is an error found in a module by VerifyWasmCodeAll or
+// Policy.Check, with its location in the module.
+type Diagnostic struct {
+	// Section is the section holding the error. It is SectionIDCustom for
+	// the errors which are not specific to a section, such as the errors
+	// decoding the module.
+	Section  wasm.SectionID
+	Function int    // index of the function in the function index space, or -1
+	Offset   int64  // absolute byte offset of the error in the module, or -1 if unknown
+	Op       string // name of the operator at Offset, if any
+
+	// Wanted and Got are the expected and actual types of the operand of
+	// an InvalidTypeError. Got is zero if the operand is missing.
+	Wanted wasm.ValueType
+	Got    wasm.ValueType
+
+	Err error
+}
+
+func (d Diagnostic) location() []string {
+	loc := []string{"module"}
+	if d.Section != wasm.SectionIDCustom {
+		loc[0] = d.Section.String() + " section"
+	}
+	if d.Function >= 0 {
+		loc = append(loc, fmt.Sprintf("function %d", d.Function))
+	}
+	if d.Op != "" {
+		loc = append(loc, d.Op)
+	}
+	return loc
+}
+
+func (d Diagnostic) Error() string {
+	loc := strings.Join(d.location(), ", ")
+	if d.Offset < 0 {
+		return fmt.Sprintf("%s: %v", loc, d.Err)
+	}
+	return fmt.Sprintf("offset %#x: %s: %v", d.Offset, loc, d.Err)
+}
+
+func (d Diagnostic) Unwrap() error {
+	return d.Err
+}
+
+// Report lists the errors found in a module by VerifyWasmCodeAll or
+// Policy.Check, in the order of the checks.
+type Report []Diagnostic
+
+func (r Report) Error() string {
+	lines := make([]string, len(r))
+	for i, d := range r {
+		lines[i] = d.Error()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// WriteTo writes r to w as a table with a row per error, followed by the
+// number of errors.
+func (r Report) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+	tw := tabwriter.NewWriter(&buf, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "OFFSET\tSECTION\tFUNCTION\tOPERATOR\tWANTED\tGOT\tERROR")
+	for _, d := range r {
+		offset, section, function, op, wanted, got := "-", "-", "-", "-", "-", "-"
+		if d.Offset >= 0 {
+			offset = fmt.Sprintf("%#08x", d.Offset)
+		}
+		if d.Section != wasm.SectionIDCustom {
+			section = d.Section.String()
+		}
+		if d.Function >= 0 {
+			function = fmt.Sprint(d.Function)
+		}
+		if d.Op != "" {
+			op = d.Op
+		}
+		if d.Wanted != 0 {
+			wanted = d.Wanted.String()
+			got = "none"
+			if d.Got != 0 {
+				got = d.Got.String()
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%v\n", offset, section, function, op, wanted, got, d.Err)
+	}
+	tw.Flush()
+	switch len(r) {
+	case 0:
+		buf.WriteString("no errors\n")
+	case 1:
+		buf.WriteString("1 error\n")
+	default:
+		fmt.Fprintf(&buf, "%d errors\n", len(r))
+	}
+	return buf.WriteTo(w)
+}
+
+// reporter collects the diagnostics of a module.
+type reporter struct {
+	module *wasm.Module
+	report Report
+	code   []int64 // offsets of the code of the function bodies
+}
+
+// sectionOffset returns the offset of the payload of the section id of
+// the module, or -1 if it has none.
+func (r *reporter) sectionOffset(id wasm.SectionID) int64 {
+	if r.module == nil || id == wasm.SectionIDCustom {
+		return -1
+	}
+	for _, s := range r.module.Sections {
+		if raw := s.GetRawSection(); raw.ID == id {
+			return raw.Start
+		}
+	}
+	return -1
+}
+
+// add reports err, found in the section id, or in the function fn of the
+// function index space if fn is not negative. The error is located at
+// the start of the section.
+func (r *reporter) add(id wasm.SectionID, fn int, err error) {
+	r.addAt(id, fn, r.sectionOffset(id), "", err)
+}
+
+// addCode reports err, found at the given offset of the code of the
+// function body of the function fn, in the operator op.
+func (r *reporter) addCode(fn int, offset int, op string, err error) {
+	at := int64(-1)
+	if body := fn - r.imported(); body >= 0 && body < len(r.code) {
+		at = r.code[body] + int64(offset)
+	}
+	r.addAt(wasm.SectionIDCode, fn, at, op, err)
+}
+
+func (r *reporter) addAt(id wasm.SectionID, fn int, offset int64, op string, err error) {
+	d := Diagnostic{Section: id, Function: fn, Offset: offset, Op: op, Err: err}
+	var typeErr InvalidTypeError
+	if errors.As(err, &typeErr) {
+		d.Wanted, d.Got = typeErr.Wanted, typeErr.Got
+	}
+	r.report = append(r.report, d)
+}
+
+// imported returns the number of imported functions of the module, which
+// come first in the function index space.
+func (r *reporter) imported() int {
+	var n int
+	if r.module.Import != nil {
+		for _, entry := range r.module.Import.Entries {
+			if _, ok := entry.Type.(wasm.FuncImport); ok {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// codeOffsets returns the absolute offsets of the code of the function
+// bodies of the decoded code section s, after their local entries.
+func codeOffsets(s *wasm.SectionCode) []int64 {
+	r := bytes.NewReader(s.Bytes)
+	offsets := make([]int64, 0, len(s.Bodies))
+	if _, err := leb128.ReadVarUint32(r); err != nil {
+		return offsets
+	}
+	for range s.Bodies {
+		size, err := leb128.ReadVarUint32(r)
+		if err != nil {
+			return offsets
+		}
+		start := r.Size() - int64(r.Len())
+		entries, err := leb128.ReadVarUint32(r)
+		if err != nil {
+			return offsets
+		}
+		for i := uint32(0); i < entries; i++ {
+			if _, err := leb128.ReadVarUint32(r); err != nil {
+				return offsets
+			}
+			if _, err := r.ReadByte(); err != nil {
+				return offsets
+			}
+		}
+		offsets = append(offsets, s.Start+r.Size()-int64(r.Len()))
+		if _, err := r.Seek(start+int64(size), io.SeekStart); err != nil {
+			return offsets
+		}
+	}
+	return offsets
+}
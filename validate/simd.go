@@ -0,0 +1,137 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package validate
+
+import (
+	"io"
+
+	"github.com/ontio/wagon/wasm"
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// verifySIMD verifies the immediates of the SIMD operators. Their operands
+// are checked like those of the other operators, from their signature.
+func (vm *mockVM) verifySIMD(op ops.Op) error {
+	width, lanes := simdImmediates(op.Sub)
+	if width != 0 {
+		align, err := vm.fetchVarUint()
+		if err != nil {
+			return err
+		}
+		// The alignment is the log2 of the alignment in bytes, which may
+		// not exceed the number of bytes accessed.
+		if align >= 32 || 1<<align > width {
+			return InvalidImmediateError{"natural alignment", op.Name}
+		}
+		if _, err := vm.fetchVarUint(); err != nil {
+			return err
+		}
+	}
+	switch {
+	case op.Sub == ops.V128Const || op.Sub == ops.I8x16Shuffle:
+		var b [16]byte
+		if _, err := io.ReadFull(vm.code, b[:]); err != nil {
+			return err
+		}
+		if op.Sub == ops.I8x16Shuffle {
+			for _, lane := range b {
+				if lane >= 32 {
+					return InvalidImmediateError{"lane index", op.Name}
+				}
+			}
+		}
+	case lanes != 0:
+		lane, err := vm.fetchByte()
+		if err != nil {
+			return err
+		}
+		if int(lane) >= lanes {
+			return InvalidImmediateError{"lane index", op.Name}
+		}
+	}
+	return nil
+}
+
+// simdImmediates returns the number of bytes of memory accessed by the SIMD
+// operator sub, or 0 if it has no memory immediate, and the number of lanes
+// its lane index immediate may refer to, or 0 if it has none.
+func simdImmediates(sub uint32) (width uint32, lanes int) {
+	switch sub {
+	case ops.V128Load, ops.V128Store:
+		return 16, 0
+	case ops.V128Load8x8S, ops.V128Load8x8U, ops.V128Load16x4S, ops.V128Load16x4U, ops.V128Load32x2S, ops.V128Load32x2U:
+		return 8, 0
+	case ops.V128Load8Splat:
+		return 1, 0
+	case ops.V128Load16Splat:
+		return 2, 0
+	case ops.V128Load32Splat, ops.V128Load32Zero:
+		return 4, 0
+	case ops.V128Load64Splat, ops.V128Load64Zero:
+		return 8, 0
+	case ops.V128Load8Lane, ops.V128Store8Lane:
+		return 1, 16
+	case ops.V128Load16Lane, ops.V128Store16Lane:
+		return 2, 8
+	case ops.V128Load32Lane, ops.V128Store32Lane:
+		return 4, 4
+	case ops.V128Load64Lane, ops.V128Store64Lane:
+		return 8, 2
+	case ops.I8x16ExtractLaneS, ops.I8x16ExtractLaneU, ops.I8x16ReplaceLane:
+		return 0, 16
+	case ops.I16x8ExtractLaneS, ops.I16x8ExtractLaneU, ops.I16x8ReplaceLane:
+		return 0, 8
+	case ops.I32x4ExtractLane, ops.I32x4ReplaceLane, ops.F32x4ExtractLane, ops.F32x4ReplaceLane:
+		return 0, 4
+	case ops.I64x2ExtractLane, ops.I64x2ReplaceLane, ops.F64x2ExtractLane, ops.F64x2ReplaceLane:
+		return 0, 2
+	}
+	return 0, 0
+}
+
+// verifySIMDTypes checks that module does not use the v128 type of the SIMD
+// proposal.
+func verifySIMDTypes(module *wasm.Module) error {
+	if module.Types != nil {
+		for _, sig := range module.Types.Entries {
+			if hasV128(sig.ParamTypes) || hasV128(sig.ReturnTypes) {
+				return FeatureError(ops.FeatureSIMD)
+			}
+		}
+	}
+	if module.Global != nil {
+		for _, global := range module.Global.Globals {
+			if global.Type.Type == wasm.ValueTypeV128 {
+				return FeatureError(ops.FeatureSIMD)
+			}
+		}
+	}
+	if module.Import != nil {
+		for _, entry := range module.Import.Entries {
+			if global, ok := entry.Type.(wasm.GlobalVarImport); ok && global.Type.Type == wasm.ValueTypeV128 {
+				return FeatureError(ops.FeatureSIMD)
+			}
+		}
+	}
+	if module.Code != nil {
+		for _, body := range module.Code.Bodies {
+			for _, entry := range body.Locals {
+				if entry.Type == wasm.ValueTypeV128 {
+					return FeatureError(ops.FeatureSIMD)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func hasV128(types []wasm.ValueType) bool {
+	for _, t := range types {
+		if t == wasm.ValueTypeV128 {
+			return true
+		}
+	}
+	return false
+}
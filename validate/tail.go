@@ -0,0 +1,93 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package validate
+
+import (
+	"github.com/ontio/wagon/wasm"
+	ops "github.com/ontio/wagon/wasm/operators"
+)
+
+// verifyTailCall verifies return_call and return_call_indirect in the
+// function of signature fn. The results of the callee must be those of fn,
+// as they are returned to its caller.
+func (vm *mockVM) verifyTailCall(op ops.Op, fn *wasm.FunctionSig, module *wasm.Module) error {
+	var sig wasm.FunctionSig
+	if op.Code == ops.ReturnCall {
+		index, err := vm.fetchVarUint()
+		if err != nil {
+			return err
+		}
+		callee := module.GetFunction(int(index))
+		if callee == nil {
+			return wasm.InvalidFunctionIndexError(index)
+		}
+		sig = *callee.Sig
+	} else {
+		var err error
+		if sig, err = vm.fetchIndirectSig(module); err != nil {
+			return err
+		}
+		if err := vm.popOperands([]wasm.ValueType{wasm.ValueTypeI32}); err != nil {
+			return err
+		}
+	}
+
+	if !sameTypes(sig.ReturnTypes, fn.ReturnTypes) {
+		return ErrTailCallResults
+	}
+	if err := vm.popOperands(sig.ParamTypes); err != nil {
+		return err
+	}
+	vm.setPolymorphic()
+	return nil
+}
+
+// fetchIndirectSig reads the type and table indices of call_indirect or
+// return_call_indirect and returns the expected signature of the callee.
+//
+// The call_indirect process consists of getting two i32 values off (first
+// from the bytecode stream, and the second from the stack) and using first
+// as an index into the "Types" section of the module, while the the second
+// one into the function index space. The signature of the two elements are
+// then compared to see if they match, and the call proceeds as normal if
+// they do. Since this is possible only during program execution, we only
+// perform the static check for the function index mentioned in the bytecode
+// stream here.
+func (vm *mockVM) fetchIndirectSig(module *wasm.Module) (wasm.FunctionSig, error) {
+	if module.GetTable(0) == nil {
+		return wasm.FunctionSig{}, NoSectionError(wasm.SectionIDTable)
+	}
+
+	// type index
+	index, err := vm.fetchVarUint()
+	if err != nil {
+		return wasm.FunctionSig{}, err
+	}
+	// The table index is a reserved byte in the MVP.
+	table, err := vm.fetchTable(module)
+	if err != nil {
+		return wasm.FunctionSig{}, err
+	}
+	if table.ElementType != wasm.ElemTypeAnyFunc {
+		return wasm.FunctionSig{}, InvalidTypeError{wasm.ValueTypeFuncRef, table.ElementType.ValueType()}
+	}
+
+	if module.Types == nil || index >= uint32(len(module.Types.Entries)) {
+		return wasm.FunctionSig{}, InvalidTypeIndexError(index)
+	}
+	return module.Types.Entries[index], nil
+}
+
+func sameTypes(a, b []wasm.ValueType) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
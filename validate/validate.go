@@ -16,7 +16,7 @@ import (
 )
 
 // vibhavp: TODO: We do not verify whether blocks don't access for the parent block, do that.
-func verifyBody(fn *wasm.FunctionSig, body *wasm.FunctionBody, module *wasm.Module) (*mockVM, error) {
+func verifyBody(fn *wasm.FunctionSig, body *wasm.FunctionBody, module *wasm.Module, features ops.Features, limits wasm.Limits, refs map[uint32]bool) (*mockVM, error) {
 	vm := &mockVM{
 		stack:    []operand{},
 		stackTop: 0,
@@ -40,7 +40,7 @@ func verifyBody(fn *wasm.FunctionSig, body *wasm.FunctionBody, module *wasm.Modu
 	totalArgCount := uint64(0)
 	for _, entry := range body.Locals {
 		totalArgCount += uint64(entry.Count)
-		if totalArgCount > uint64(wasm.MaxLocalEntryCount) {
+		if limits.Locals != 0 && totalArgCount > uint64(limits.Locals) {
 			return vm, ErrLocalEntryCount
 		}
 		vars := make([]operand, entry.Count)
@@ -52,6 +52,7 @@ func verifyBody(fn *wasm.FunctionSig, body *wasm.FunctionBody, module *wasm.Modu
 	}
 
 	for {
+		vm.opStart = vm.pc()
 		op, err := vm.code.ReadByte()
 		if err == io.EOF {
 			break
@@ -59,10 +60,14 @@ func verifyBody(fn *wasm.FunctionSig, body *wasm.FunctionBody, module *wasm.Modu
 			return vm, err
 		}
 
-		opStruct, err := ops.New(op)
+		opStruct, err := vm.readOp(op)
 		if err != nil {
 			return vm, err
 		}
+		vm.op = opStruct
+		if err := opStruct.CheckFeatures(features); err != nil {
+			return vm, err
+		}
 
 		logger.Printf("PC: %d OP: %s polymorphic: %v", vm.pc(), opStruct.Name, vm.isPolymorphic())
 
@@ -74,19 +79,28 @@ func verifyBody(fn *wasm.FunctionSig, body *wasm.FunctionBody, module *wasm.Modu
 
 		switch op {
 		case ops.If, ops.Block, ops.Loop:
-			sig, err := vm.fetchByte()
+			bt, err := wasm.ReadBlockType(vm.code)
 			if err != nil {
 				return vm, err
 			}
+			if _, ok := bt.(wasm.BlockTypeIndex); ok && !features.Has(ops.FeatureMultiValue) {
+				return vm, FeatureError(ops.FeatureMultiValue)
+			}
 
-			switch wasm.ValueType(sig) {
-			//case wasm.ValueTypeI32, wasm.ValueTypeI64, wasm.ValueTypeF32, wasm.ValueTypeF64, wasm.ValueType(wasm.BlockTypeEmpty):
-			case wasm.ValueTypeI32, wasm.ValueTypeI64, wasm.ValueType(wasm.BlockTypeEmpty):
-				vm.pushBlock(op, wasm.BlockType(sig))
-			default:
-				if !vm.isPolymorphic() {
-					return vm, InvalidImmediateError{"block_type", opStruct.Name}
-				}
+			sig, err := module.BlockSig(bt)
+			if err != nil {
+				return vm, InvalidImmediateError{"block_type", opStruct.Name}
+			}
+			// The parameters of the block are moved to its stack.
+			if err := vm.popOperands(sig.ParamTypes); err != nil {
+				return vm, err
+			}
+			vm.pushBlock(op, sig)
+			if limits.NestingDepth != 0 && len(vm.blocks) > int(limits.NestingDepth) {
+				return vm, wasm.OutsizeError{ImmType: "Nesting depth", Size: uint64(len(vm.blocks)), Max: uint64(limits.NestingDepth)}
+			}
+			for _, t := range sig.ParamTypes {
+				vm.pushOperand(t)
 			}
 
 		case ops.Else:
@@ -94,33 +108,30 @@ func verifyBody(fn *wasm.FunctionSig, body *wasm.FunctionBody, module *wasm.Modu
 			if block == nil || block.op != ops.If {
 				return vm, UnmatchedOpError(op)
 			}
-
-			if block.blockType != wasm.BlockTypeEmpty {
-				top, under := vm.topOperand()
-				if !vm.isPolymorphic() && (under || top.Type != wasm.ValueType(block.blockType)) {
-					return vm, InvalidTypeError{wasm.ValueType(block.blockType), top.Type}
-				}
-				vm.pushOperand(wasm.ValueType(block.blockType))
+			if err := vm.endBlock(block.sig.ReturnTypes); err != nil {
+				return vm, err
+			}
+			// The else branch starts reachable, with the parameters of
+			// the block.
+			block.op = ops.Else
+			block.polymorphic = false
+			for _, t := range block.sig.ParamTypes {
+				vm.pushOperand(t)
 			}
-			vm.stackTop = block.stackTop
 		case ops.End:
-			isPolymorphic := vm.isPolymorphic()
-
-			block := vm.popBlock()
+			block := vm.topBlock()
 			if block == nil {
 				return vm, UnmatchedOpError(op)
 			}
-
-			if block.blockType != wasm.BlockTypeEmpty {
-				top, under := vm.topOperand()
-				if !isPolymorphic && (under || top.Type != wasm.ValueType(block.blockType)) {
-					return vm, InvalidTypeError{wasm.ValueType(block.blockType), top.Type}
-				}
-				vm.stackTop = block.stackTop
-				vm.pushOperand(wasm.ValueType(block.blockType))
-				vm.stackTop = block.stackTop + 1 // as we pushed an element
-			} else {
-				vm.stackTop = block.stackTop
+			if err := vm.endBlock(block.sig.ReturnTypes); err != nil {
+				return vm, err
+			}
+			if block.op == ops.If && !sameTypes(block.sig.ParamTypes, block.sig.ReturnTypes) {
+				return vm, ErrIfWithoutElse
+			}
+			block = vm.popBlock()
+			for _, t := range block.sig.ReturnTypes {
+				vm.pushOperand(t)
 			}
 
 		case ops.BrIf, ops.Br:
@@ -128,57 +139,63 @@ func verifyBody(fn *wasm.FunctionSig, body *wasm.FunctionBody, module *wasm.Modu
 			if err != nil {
 				return vm, err
 			}
-			if err = vm.canBranch(int(depth)); !vm.isPolymorphic() && err != nil {
+			if err = vm.canBranch(depth); err != nil {
 				return vm, err
 			}
 			if op == ops.Br {
 				vm.setPolymorphic()
 			}
 		case ops.BrTable:
-			operand, under := vm.popOperand()
-			if !vm.isPolymorphic() && (under || operand.Type != wasm.ValueTypeI32) {
-				return vm, InvalidTypeError{wasm.ValueTypeI32, operand.Type}
+			if _, err := vm.popOperandOf(wasm.ValueTypeI32); err != nil {
+				return vm, err
 			}
 			// read table entries
 			targetCount, err := vm.fetchVarUint()
 			if err != nil {
 				return vm, err
 			}
+			// Every target takes at least a byte.
+			if int(targetCount) > vm.code.Len() {
+				return vm, InvalidImmediateError{"branch table size", opStruct.Name}
+			}
 
-			var targetTable []uint32
-			for i := uint32(0); i < targetCount; i++ {
-				entry, err := vm.fetchVarUint()
-				if err != nil {
-					return vm, err
-				}
-				if err = vm.canBranch(int(entry)); !vm.isPolymorphic() && err != nil {
+			targets := make([]uint32, targetCount+1)
+			for i := range targets {
+				if targets[i], err = vm.fetchVarUint(); err != nil {
 					return vm, err
 				}
-				targetTable = append(targetTable, entry)
 			}
-
-			defaultTarget, err := vm.fetchVarUint()
+			// The default target is the last one. All the targets must
+			// take values of the same types.
+			defaultTypes, err := vm.labelTypes(targets[targetCount])
 			if err != nil {
 				return vm, err
 			}
-			if err = vm.canBranch(int(defaultTarget)); !vm.isPolymorphic() && err != nil {
-				return vm, err
+			for _, depth := range targets {
+				types, err := vm.labelTypes(depth)
+				if err != nil {
+					return vm, err
+				}
+				if !sameTypes(types, defaultTypes) {
+					return vm, InvalidLabelError(depth)
+				}
+				if err := vm.canBranch(depth); err != nil {
+					return vm, err
+				}
 			}
 			vm.setPolymorphic()
 
 		case ops.Return:
-			if len(fn.ReturnTypes) > 1 {
-				panic("not implemented")
-			}
-			if len(fn.ReturnTypes) != 0 {
-				// only single returns supported for now
-				top, under := vm.popOperand()
-				if !vm.isPolymorphic() && (under || top.Type != fn.ReturnTypes[0]) {
-					return vm, InvalidTypeError{fn.ReturnTypes[0], top.Type}
-				}
+			if err := vm.popOperands(fn.ReturnTypes); err != nil {
+				return vm, err
 			}
 			vm.setPolymorphic()
 
+		case ops.ReturnCall, ops.ReturnCallIndirect:
+			if err := vm.verifyTailCall(opStruct, fn, module); err != nil {
+				return vm, err
+			}
+
 		case ops.Unreachable:
 			vm.setPolymorphic()
 
@@ -192,16 +209,16 @@ func verifyBody(fn *wasm.FunctionSig, body *wasm.FunctionBody, module *wasm.Modu
 			if err != nil {
 				return vm, err
 			}
-		//case ops.F32Const:
-		//	_, err := vm.fetchUint32()
-		//	if err != nil {
-		//		return vm, err
-		//	}
-		//case ops.F64Const:
-		//	_, err := vm.fetchUint64()
-		//	if err != nil {
-		//		return vm, err
-		//	}
+		case ops.F32Const:
+			_, err := vm.fetchUint32()
+			if err != nil {
+				return vm, err
+			}
+		case ops.F64Const:
+			_, err := vm.fetchUint64()
+			if err != nil {
+				return vm, err
+			}
 		case ops.GetLocal, ops.SetLocal, ops.TeeLocal:
 			i, err := vm.fetchVarUint()
 			if err != nil {
@@ -216,9 +233,8 @@ func verifyBody(fn *wasm.FunctionSig, body *wasm.FunctionBody, module *wasm.Modu
 			if op == ops.GetLocal {
 				vm.pushOperand(v.Type)
 			} else { // == set_local or tee_local
-				top, under := vm.popOperand()
-				if !vm.isPolymorphic() && (under || top.Type != v.Type) {
-					return vm, InvalidTypeError{v.Type, top.Type}
+				if _, err := vm.popOperandOf(v.Type); err != nil {
+					return vm, err
 				}
 				if op == ops.TeeLocal {
 					vm.pushOperand(v.Type)
@@ -238,32 +254,55 @@ func verifyBody(fn *wasm.FunctionSig, body *wasm.FunctionBody, module *wasm.Modu
 			if op == ops.GetGlobal {
 				vm.pushOperand(gv.Type.Type)
 			} else {
-				val, under := vm.popOperand()
-				if !vm.isPolymorphic() && (under || val.Type != gv.Type.Type) {
-					return vm, InvalidTypeError{gv.Type.Type, val.Type}
+				if !gv.Type.Mutable {
+					return vm, ErrImmutableGlobal
+				}
+				if _, err := vm.popOperandOf(gv.Type.Type); err != nil {
+					return vm, err
 				}
 			}
 
-		//case ops.I32Load, ops.I64Load, ops.F32Load, ops.F64Load, ops.I32Load8s, ops.I32Load8u, ops.I32Load16s, ops.I32Load16u, ops.I64Load8s, ops.I64Load8u, ops.I64Load16s, ops.I64Load16u, ops.I64Load32s, ops.I64Load32u, ops.I32Store, ops.I64Store, ops.F32Store, ops.F64Store, ops.I32Store8, ops.I32Store16, ops.I64Store8, ops.I64Store16, ops.I64Store32:
-		case ops.I32Load, ops.I64Load, ops.I32Load8s, ops.I32Load8u, ops.I32Load16s, ops.I32Load16u, ops.I64Load8s, ops.I64Load8u, ops.I64Load16s, ops.I64Load16u, ops.I64Load32s, ops.I64Load32u, ops.I32Store, ops.I64Store, ops.I32Store8, ops.I32Store16, ops.I64Store8, ops.I64Store16, ops.I64Store32:
+		case ops.I32Load, ops.I64Load, ops.F32Load, ops.F64Load, ops.I32Load8s, ops.I32Load8u, ops.I32Load16s, ops.I32Load16u, ops.I64Load8s, ops.I64Load8u, ops.I64Load16s, ops.I64Load16u, ops.I64Load32s, ops.I64Load32u, ops.I32Store, ops.I64Store, ops.F32Store, ops.F64Store, ops.I32Store8, ops.I32Store16, ops.I64Store8, ops.I64Store16, ops.I64Store32:
+			if !hasMemory(module) {
+				return vm, NoSectionError(wasm.SectionIDMemory)
+			}
 			// read memory_immediate
-			// flags
-			_, err := vm.fetchVarUint()
+			// flags, the log2 of the alignment in bytes, which may not
+			// exceed the number of bytes accessed
+			align, err := vm.fetchVarUint()
 			if err != nil {
 				return vm, err
 			}
+			if align >= 32 || 1<<align > memoryAccessWidth(op) {
+				return vm, InvalidImmediateError{"natural alignment", opStruct.Name}
+			}
 			// offset
 			_, err = vm.fetchVarUint()
 			if err != nil {
 				return vm, err
 			}
 		case ops.CurrentMemory, ops.GrowMemory:
+			if !hasMemory(module) {
+				return vm, NoSectionError(wasm.SectionIDMemory)
+			}
 			memIndex, err := vm.fetchByte()
 			if err != nil {
 				return vm, err
 			}
 			if memIndex != 0x00 {
-				return vm, errors.New("validate: memory index must be 0")
+				return vm, ErrMemoryIndex
+			}
+		case ops.MiscPrefix:
+			if err := vm.verifyMisc(opStruct, module); err != nil {
+				return vm, err
+			}
+		case ops.SIMDPrefix:
+			if err := vm.verifySIMD(opStruct); err != nil {
+				return vm, err
+			}
+		case ops.SelectTyped, ops.TableGet, ops.TableSet, ops.RefNull, ops.RefIsNull, ops.RefFunc:
+			if err := vm.verifyRef(opStruct, module, refs); err != nil {
+				return vm, err
 			}
 
 		case ops.Call:
@@ -278,116 +317,160 @@ func verifyBody(fn *wasm.FunctionSig, body *wasm.FunctionBody, module *wasm.Modu
 			}
 
 			logger.Printf("Function being called: %v", fn)
-			for index := range fn.Sig.ParamTypes {
-				argType := fn.Sig.ParamTypes[len(fn.Sig.ParamTypes)-index-1]
-				operand, under := vm.popOperand()
-				if !vm.isPolymorphic() && (under || operand.Type != argType) {
-					return vm, InvalidTypeError{argType, operand.Type}
-				}
+			if err := vm.popOperands(fn.Sig.ParamTypes); err != nil {
+				return vm, err
 			}
 
-			if len(fn.Sig.ReturnTypes) > 0 {
-				vm.pushOperand(fn.Sig.ReturnTypes[0])
+			for _, t := range fn.Sig.ReturnTypes {
+				vm.pushOperand(t)
 			}
 
 		case ops.CallIndirect:
-			if module.Table == nil || len(module.Table.Entries) == 0 {
-				return vm, NoSectionError(wasm.SectionIDTable)
-			}
-			// The call_indirect process consists of getting two i32 values
-			// off (first from the bytecode stream, and the second from
-			//  the stack) and using first as an index into the "Types" section
-			// of the module, while the the second one into the function index
-			// space. The signature of the two elements are then compared
-			// to see if they match, and the call proceeds as normal if they
-			// do.
-			// Since this is possible only during program execution, we only
-			// perform the static check for the function index mentioned
-			// in the bytecode stream here.
-
-			// type index
-			index, err := vm.fetchVarUint()
+			fnExpectSig, err := vm.fetchIndirectSig(module)
 			if err != nil {
 				return vm, err
 			}
-			tableIndex, err := vm.fetchByte()
-			if err != nil {
-				return vm, err
-			}
-			if tableIndex != 0x00 {
-				return vm, errors.New("validate: table index in call_indirect must be 0")
-			}
 
-			if index >= uint32(len(module.Types.Entries)) {
-				return vm, errors.New("validate: type index out of range in call_indirect")
-			}
-
-			fnExpectSig := module.Types.Entries[index]
-
-			if operand, under := vm.popOperand(); !vm.isPolymorphic() && (under || operand.Type != wasm.ValueTypeI32) {
-				return vm, InvalidTypeError{wasm.ValueTypeI32, operand.Type}
+			if _, err := vm.popOperandOf(wasm.ValueTypeI32); err != nil {
+				return vm, err
 			}
-
-			for index := range fnExpectSig.ParamTypes {
-				argType := fnExpectSig.ParamTypes[len(fnExpectSig.ParamTypes)-index-1]
-				operand, under := vm.popOperand()
-				if !vm.isPolymorphic() && (under || (operand.Type != argType)) {
-					return vm, InvalidTypeError{argType, operand.Type}
-				}
+			if err := vm.popOperands(fnExpectSig.ParamTypes); err != nil {
+				return vm, err
 			}
 
-			if len(fnExpectSig.ReturnTypes) > 0 {
-				vm.pushOperand(fnExpectSig.ReturnTypes[0])
+			for _, t := range fnExpectSig.ReturnTypes {
+				vm.pushOperand(t)
 			}
 
 		case ops.Drop:
-			if _, under := vm.popOperand(); !vm.isPolymorphic() && under {
+			if _, under := vm.popOperand(); under {
 				return vm, ErrStackUnderflow
 			}
 
 		case ops.Select:
-			if vm.isPolymorphic() {
-				continue
-			}
-			operands := make([]operand, 2)
-			c, under := vm.popOperand()
-			if under || c.Type != wasm.ValueTypeI32 {
-				return vm, InvalidTypeError{wasm.ValueTypeI32, c.Type}
+			if _, err := vm.popOperandOf(wasm.ValueTypeI32); err != nil {
+				return vm, err
 			}
-
-			for i := 0; i < 2; i++ {
+			var operands [2]operand
+			for i := range operands {
 				operand, under := vm.popOperand()
-				if !vm.isPolymorphic() && under {
+				if under {
 					return vm, ErrStackUnderflow
 				}
 				operands[i] = operand
 			}
 
-			// last 2 popped values should be of the same type
-			if operands[0].Type != operands[1].Type {
-				return vm, InvalidTypeError{operands[1].Type, operands[2].Type}
+			// last 2 popped values should be of the same type, unless
+			// one of them is of unknown type
+			t := operands[0].Type
+			if t == unknownType {
+				t = operands[1].Type
+			}
+			if !operands[1].is(t) {
+				return vm, InvalidTypeError{operands[1].Type, operands[0].Type}
+			}
+			if t.IsRef() {
+				return vm, ErrSelectRef
 			}
 
-			vm.pushOperand(operands[1].Type)
+			vm.pushOperand(t)
 		}
 	}
 
+	// The final end of the body is not part of its code.
+	vm.op, _ = ops.New(ops.End)
+	if len(vm.blocks) != 0 {
+		return vm, ErrUnterminatedBlock
+	}
+	if err := vm.endBlock(fn.ReturnTypes); err != nil {
+		return vm, err
+	}
+
 	return vm, nil
 }
 
+// hasMemory reports whether module defines or imports a linear memory.
+func hasMemory(module *wasm.Module) bool {
+	if module.Memory != nil && len(module.Memory.Entries) != 0 {
+		return true
+	}
+	if module.Import != nil {
+		for _, entry := range module.Import.Entries {
+			if _, ok := entry.Type.(wasm.MemoryImport); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// memoryAccessWidth returns the number of bytes accessed by the load or
+// store operator op.
+func memoryAccessWidth(op byte) uint32 {
+	switch op {
+	case ops.I32Load8s, ops.I32Load8u, ops.I64Load8s, ops.I64Load8u, ops.I32Store8, ops.I64Store8:
+		return 1
+	case ops.I32Load16s, ops.I32Load16u, ops.I64Load16s, ops.I64Load16u, ops.I32Store16, ops.I64Store16:
+		return 2
+	case ops.I32Load, ops.F32Load, ops.I64Load32s, ops.I64Load32u, ops.I32Store, ops.F32Store, ops.I64Store32:
+		return 4
+	}
+	return 8
+}
+
 // VerifyModule verifies the given module according to WebAssembly verification
-// specs.
+// specs. Only MVP operators and signatures are accepted.
 func VerifyModule(module *wasm.Module) error {
+	return VerifyModuleWithFeatures(module, 0)
+}
+
+// VerifyModuleWithFeatures is like VerifyModule, but also accepts the
+// operators and signatures of the post-MVP proposals enabled in features.
+func VerifyModuleWithFeatures(module *wasm.Module, features ops.Features) error {
+	return VerifyModuleWithLimits(module, features, wasm.DefaultLimits())
+}
+
+// VerifyModuleWithLimits is like VerifyModuleWithFeatures, but the function
+// bodies must not exceed the number of locals and the nesting depth allowed
+// by limits, instead of those of wasm.DefaultLimits.
+func VerifyModuleWithLimits(module *wasm.Module, features ops.Features, limits wasm.Limits) error {
+	var typeErr error
+	checkValueTypes(module, func(_ wasm.SectionID, _ int, err error) {
+		if typeErr == nil {
+			typeErr = err
+		}
+	})
+	if typeErr != nil {
+		return typeErr
+	}
+	if err := verifyFeatures(module, features); err != nil {
+		return err
+	}
+	var refs map[uint32]bool
+	if features.Has(ops.FeatureReferenceTypes) {
+		refs = declaredFuncRefs(module)
+	}
 	if module.Function == nil || module.Types == nil || len(module.Types.Entries) == 0 {
 		return nil
 	}
+	if !features.Has(ops.FeatureMultiValue) {
+		for _, sig := range module.Types.Entries {
+			if len(sig.ReturnTypes) > 1 {
+				return FeatureError(ops.FeatureMultiValue)
+			}
+		}
+	}
 	if module.Code == nil {
 		return NoSectionError(wasm.SectionIDCode)
 	}
 
 	logger.Printf("There are %d functions", len(module.Function.Types))
 	for i, fn := range module.FunctionIndexSpace {
-		if vm, err := verifyBody(fn.Sig, fn.Body, module); err != nil {
+		// Imported functions which are not resolved have no body.
+		if fn.Body == nil {
+			continue
+		}
+		if vm, err := verifyBody(fn.Sig, fn.Body, module, features, limits, refs); err != nil {
 			return Error{vm.pc(), i, err}
 		}
 		logger.Printf("No errors in function %d", i)
@@ -396,6 +479,29 @@ func VerifyModule(module *wasm.Module) error {
 	return nil
 }
 
+// verifyFeatures checks that module only uses the sections, segments and
+// types of the proposals enabled in features.
+func verifyFeatures(module *wasm.Module, features ops.Features) error {
+	if !features.Has(ops.FeatureBulkMemory) {
+		if err := verifyBulkMemorySegments(module); err != nil {
+			return err
+		}
+	}
+	if features.Has(ops.FeatureReferenceTypes) {
+		if err := verifyElementSegments(module); err != nil {
+			return err
+		}
+	} else if err := verifyReferenceTypes(module); err != nil {
+		return err
+	}
+	if !features.Has(ops.FeatureSIMD) {
+		if err := verifySIMDTypes(module); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type RustValidator struct {
 	code             *exec.CompiledModule
 	allocBufferIndex uint32
@@ -410,7 +516,7 @@ var rustValidator = func() RustValidator {
 	if err != nil {
 		panic(err)
 	}
-	compiled, err := exec.CompileModule(m)
+	compiled, err := exec.CompileModule(m, nil)
 	if err != nil {
 		panic(err)
 	}
@@ -438,6 +544,7 @@ func VerifyWasmCodeFromRust(code []byte) error {
 
 	vm.RecoverPanic = true
 	vm.CallStackDepth = 20000
+	vm.FrameLimit = 20000
 
 	GasLimit := uint64(10000000000)
 	ExecStep := uint64(10000000000)
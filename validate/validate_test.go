@@ -1,9 +1,13 @@
 package validate
 
 import (
+	"bytes"
 	"io/ioutil"
 	"path/filepath"
 	"testing"
+
+	"github.com/ontio/wagon/wasm"
+	ops "github.com/ontio/wagon/wasm/operators"
 )
 
 var testPaths = []string{
@@ -37,3 +41,343 @@ func TestVerifyModuleFromRust(t *testing.T) {
 		}
 	}
 }
+
+// multiValueModule returns a module defining swap(i32, i64) (i64, i32),
+// sumloop(i32) i32, which runs a loop taking two parameters, and pair()
+// (i32, i32), whose body is a block returning the values given by body.
+func multiValueModule(body ...byte) []byte {
+	code := []byte{
+		0x03,
+		0x06, 0x00, 0x20, 0x01, 0x20, 0x00, 0x0b,
+		0x21, 0x01, 0x02, 0x7f, 0x20, 0x00, 0x41, 0x00, 0x03, 0x01, 0x21, 0x02, 0x21, 0x01,
+		0x20, 0x01, 0x41, 0x01, 0x6b, 0x20, 0x02, 0x20, 0x01, 0x6a,
+		0x20, 0x01, 0x41, 0x01, 0x4b, 0x0d, 0x00, 0x0b, 0x6a, 0x0b,
+		byte(len(body) + 5), 0x00, 0x02, 0x03,
+	}
+	code = append(append(code, body...), 0x0b, 0x0b)
+	m := []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+		0x01, 0x19, 0x04,
+		0x60, 0x02, 0x7f, 0x7e, 0x02, 0x7e, 0x7f,
+		0x60, 0x02, 0x7f, 0x7f, 0x02, 0x7f, 0x7f,
+		0x60, 0x01, 0x7f, 0x01, 0x7f,
+		0x60, 0x00, 0x02, 0x7f, 0x7f,
+		0x03, 0x04, 0x03, 0x00, 0x02, 0x03,
+		0x0a, byte(len(code)),
+	}
+	return append(m, code...)
+}
+
+func TestVerifyModuleMultiValue(t *testing.T) {
+	for _, tc := range []struct {
+		body  []byte
+		valid bool
+	}{
+		{[]byte{0x41, 0x01, 0x41, 0x02}, true},
+		{[]byte{0x41, 0x01, 0x41, 0x02, 0x41, 0x03, 0x0c, 0x00}, true},
+		{[]byte{0x41, 0x01, 0x42, 0x02}, false},
+		{[]byte{0x41, 0x01, 0x42, 0x02, 0x0c, 0x00}, false},
+	} {
+		m, err := wasm.ReadModule(bytes.NewReader(multiValueModule(tc.body...)), nil)
+		if err != nil {
+			t.Fatalf("could not read module: %v", err)
+		}
+		if err := VerifyModule(m); err != FeatureError(ops.FeatureMultiValue) {
+			t.Errorf("body %x: VerifyModule: got error %v, want %v", tc.body, err, FeatureError(ops.FeatureMultiValue))
+		}
+		err = VerifyModuleWithFeatures(m, ops.FeatureMultiValue)
+		if tc.valid && err != nil {
+			t.Errorf("body %x: %v", tc.body, err)
+		} else if !tc.valid && err == nil {
+			t.Errorf("body %x: invalid module was accepted", tc.body)
+		}
+	}
+}
+
+func TestVerifyModuleBlockTypeIndex(t *testing.T) {
+	// A module defining a function () i32 whose body is a block returning 1,
+	// with the signature given by the type index 0.
+	raw := []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+		0x01, 0x05, 0x01, 0x60, 0x00, 0x01, 0x7f,
+		0x03, 0x02, 0x01, 0x00,
+		0x0a, 0x09, 0x01, 0x07, 0x00, 0x02, 0x00, 0x41, 0x01, 0x0b, 0x0b,
+	}
+	m, err := wasm.ReadModule(bytes.NewReader(raw), nil)
+	if err != nil {
+		t.Fatalf("could not read module: %v", err)
+	}
+
+	err = VerifyModule(m)
+	verr, ok := err.(Error)
+	if !ok || verr.Err != FeatureError(ops.FeatureMultiValue) {
+		t.Errorf("VerifyModule: got error %v, want %v", err, FeatureError(ops.FeatureMultiValue))
+	}
+	if err := VerifyModuleWithFeatures(m, ops.FeatureMultiValue); err != nil {
+		t.Errorf("VerifyModuleWithFeatures: %v", err)
+	}
+}
+
+func TestVerifyModuleWithFeatures(t *testing.T) {
+	// A module defining a function (i32) i32 returning i32.extend16_s
+	// applied to its argument.
+	raw := []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+		0x01, 0x06, 0x01, 0x60, 0x01, 0x7f, 0x01, 0x7f,
+		0x03, 0x02, 0x01, 0x00,
+		0x0a, 0x07, 0x01, 0x05, 0x00, 0x20, 0x00, 0xc1, 0x0b,
+	}
+	m, err := wasm.ReadModule(bytes.NewReader(raw), nil)
+	if err != nil {
+		t.Fatalf("could not read module: %v", err)
+	}
+
+	err = VerifyModule(m)
+	verr, ok := err.(Error)
+	if !ok || verr.Err != ops.DisabledOpcodeError(ops.I32Extend16S) {
+		t.Errorf("VerifyModule: got error %v, want %v", err, ops.DisabledOpcodeError(ops.I32Extend16S))
+	}
+	if err := VerifyModuleWithFeatures(m, ops.FeatureSignExt); err != nil {
+		t.Errorf("VerifyModuleWithFeatures: %v", err)
+	}
+}
+
+func TestVerifyModuleBulkMemory(t *testing.T) {
+	header := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	types := []byte{0x01, 0x04, 0x01, 0x60, 0x00, 0x00}
+	funcs := []byte{0x03, 0x02, 0x01, 0x00}
+	memory := []byte{0x05, 0x03, 0x01, 0x00, 0x01}
+	dataCount := []byte{0x0c, 0x01, 0x01}
+	// A function calling memory.init 0 with three zero operands.
+	code := []byte{0x0a, 0x0e, 0x01, 0x0c, 0x00,
+		0x41, 0x00, 0x41, 0x00, 0x41, 0x00, 0xfc, 0x08, 0x00, 0x00, 0x0b}
+	data := []byte{0x0b, 0x04, 0x01, 0x01, 0x01, 'a'}
+	read := func(sections ...[]byte) *wasm.Module {
+		raw := bytes.Join(append([][]byte{header}, sections...), nil)
+		m, err := wasm.ReadModule(bytes.NewReader(raw), nil)
+		if err != nil {
+			t.Fatalf("could not read module: %v", err)
+		}
+		return m
+	}
+
+	m := read(types, funcs, memory, dataCount, code, data)
+	if err := VerifyModule(m); err != FeatureError(ops.FeatureBulkMemory) {
+		t.Errorf("VerifyModule: got error %v, want %v", err, FeatureError(ops.FeatureBulkMemory))
+	}
+	if err := VerifyModuleWithFeatures(m, ops.FeatureBulkMemory); err != nil {
+		t.Errorf("VerifyModuleWithFeatures: %v", err)
+	}
+
+	m = read(types, funcs, memory, code, data)
+	err := VerifyModuleWithFeatures(m, ops.FeatureBulkMemory)
+	if verr, ok := err.(Error); !ok || verr.Err != NoSectionError(wasm.SectionIDDataCount) {
+		t.Errorf("without a data count section: got error %v, want %v", err, NoSectionError(wasm.SectionIDDataCount))
+	}
+}
+
+func TestVerifyModuleTruncSat(t *testing.T) {
+	// A module defining functions (f32) i32 and (i32) i32 returning
+	// i32.trunc_sat_f32_s applied to their argument.
+	raw := []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+		0x01, 0x0b, 0x02, 0x60, 0x01, 0x7d, 0x01, 0x7f, 0x60, 0x01, 0x7f, 0x01, 0x7f,
+		0x03, 0x03, 0x02, 0x00, 0x01,
+		0x0a, 0x0f, 0x02,
+		0x06, 0x00, 0x20, 0x00, 0xfc, 0x00, 0x0b,
+		0x06, 0x00, 0x20, 0x00, 0xfc, 0x00, 0x0b,
+	}
+	m, err := wasm.ReadModule(bytes.NewReader(raw), nil)
+	if err != nil {
+		t.Fatalf("could not read module: %v", err)
+	}
+
+	disabled := ops.DisabledPrefixedOpcodeError{Prefix: ops.MiscPrefix, Sub: ops.I32TruncSatSF32}
+	if verr, ok := VerifyModule(m).(Error); !ok || verr.Function != 0 || verr.Err != disabled {
+		t.Errorf("VerifyModule: got error %v, want %v", verr, disabled)
+	}
+	mismatch := InvalidTypeError{wasm.ValueTypeF32, wasm.ValueTypeI32}
+	err = VerifyModuleWithFeatures(m, ops.FeatureNonTrappingFloatToInt)
+	if verr, ok := err.(Error); !ok || verr.Function != 1 || verr.Err != mismatch {
+		t.Errorf("VerifyModuleWithFeatures: got error %v, want %v", err, mismatch)
+	}
+}
+
+func TestVerifyModuleReferenceTypes(t *testing.T) {
+	header := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	types := []byte{0x01, 0x09, 0x02, 0x60, 0x00, 0x00, 0x60, 0x01, 0x7f, 0x01, 0x7f}
+	funcs := []byte{0x03, 0x03, 0x02, 0x01, 0x00}
+	tables := []byte{0x04, 0x07, 0x02, 0x70, 0x00, 0x01, 0x6f, 0x00, 0x01}
+	// A declarative element segment holding function 0.
+	elems := []byte{0x09, 0x05, 0x01, 0x03, 0x00, 0x01, 0x00}
+	// Function 0 returns ref.is_null applied to an element of table 1,
+	// function 1 drops ref.func 0.
+	code := []byte{0x0a, 0x0f, 0x02,
+		0x07, 0x00, 0x20, 0x00, 0x25, 0x01, 0xd1, 0x0b,
+		0x05, 0x00, 0xd2, 0x00, 0x1a, 0x0b}
+	// Function 1 stores ref.func 0 in table 1 instead.
+	codeSet := []byte{0x0a, 0x12, 0x02,
+		0x07, 0x00, 0x20, 0x00, 0x25, 0x01, 0xd1, 0x0b,
+		0x08, 0x00, 0x41, 0x00, 0xd2, 0x00, 0x26, 0x01, 0x0b}
+	read := func(sections ...[]byte) *wasm.Module {
+		raw := bytes.Join(append([][]byte{header}, sections...), nil)
+		m, err := wasm.ReadModule(bytes.NewReader(raw), nil)
+		if err != nil {
+			t.Fatalf("could not read module: %v", err)
+		}
+		return m
+	}
+
+	m := read(types, funcs, tables, elems, code)
+	if err := VerifyModule(m); err != FeatureError(ops.FeatureReferenceTypes) {
+		t.Errorf("VerifyModule: got error %v, want %v", err, FeatureError(ops.FeatureReferenceTypes))
+	}
+	if err := VerifyModuleWithFeatures(m, ops.FeatureReferenceTypes); err != nil {
+		t.Errorf("VerifyModuleWithFeatures: %v", err)
+	}
+
+	m = read(types, funcs, tables, code)
+	err := VerifyModuleWithFeatures(m, ops.FeatureReferenceTypes)
+	if verr, ok := err.(Error); !ok || verr.Function != 1 || verr.Err != UndeclaredFunctionRefError(0) {
+		t.Errorf("without an element segment: got error %v, want %v", err, UndeclaredFunctionRefError(0))
+	}
+
+	m = read(types, funcs, tables, elems, codeSet)
+	mismatch := InvalidTypeError{wasm.ValueTypeExternRef, wasm.ValueTypeFuncRef}
+	err = VerifyModuleWithFeatures(m, ops.FeatureReferenceTypes)
+	if verr, ok := err.(Error); !ok || verr.Function != 1 || verr.Err != mismatch {
+		t.Errorf("table.set of a funcref in an externref table: got error %v, want %v", err, mismatch)
+	}
+}
+
+func TestVerifyModuleTailCall(t *testing.T) {
+	header := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	types := []byte{0x01, 0x09, 0x02, 0x60, 0x00, 0x01, 0x7f, 0x60, 0x00, 0x01, 0x7e}
+	funcs := []byte{0x03, 0x04, 0x03, 0x00, 0x00, 0x01}
+	// Function 0 tail calls function 1, which returns an i32, function 2
+	// returns an i64.
+	code := []byte{0x0a, 0x10, 0x03,
+		0x04, 0x00, 0x12, 0x01, 0x0b,
+		0x04, 0x00, 0x41, 0x01, 0x0b,
+		0x04, 0x00, 0x42, 0x01, 0x0b}
+	// Function 2 tail calls function 1 instead.
+	codeMismatch := []byte{0x0a, 0x10, 0x03,
+		0x04, 0x00, 0x12, 0x01, 0x0b,
+		0x04, 0x00, 0x41, 0x01, 0x0b,
+		0x04, 0x00, 0x12, 0x01, 0x0b}
+	read := func(sections ...[]byte) *wasm.Module {
+		raw := bytes.Join(append([][]byte{header}, sections...), nil)
+		m, err := wasm.ReadModule(bytes.NewReader(raw), nil)
+		if err != nil {
+			t.Fatalf("could not read module: %v", err)
+		}
+		return m
+	}
+
+	m := read(types, funcs, code)
+	disabled := ops.DisabledOpcodeError(ops.ReturnCall)
+	if verr, ok := VerifyModule(m).(Error); !ok || verr.Function != 0 || verr.Err != disabled {
+		t.Errorf("VerifyModule: got error %v, want %v", verr, disabled)
+	}
+	if err := VerifyModuleWithFeatures(m, ops.FeatureTailCall); err != nil {
+		t.Errorf("VerifyModuleWithFeatures: %v", err)
+	}
+
+	m = read(types, funcs, codeMismatch)
+	err := VerifyModuleWithFeatures(m, ops.FeatureTailCall)
+	if verr, ok := err.(Error); !ok || verr.Function != 2 || verr.Err != ErrTailCallResults {
+		t.Errorf("tail call returning an i32 from an i64 function: got error %v, want %v", err, ErrTailCallResults)
+	}
+}
+
+func TestVerifyModuleSIMD(t *testing.T) {
+	header := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	types := []byte{0x01, 0x0b, 0x02, 0x60, 0x01, 0x7b, 0x01, 0x7f, 0x60, 0x01, 0x7f, 0x01, 0x7b}
+	funcs := []byte{0x03, 0x03, 0x02, 0x00, 0x01}
+	// Function 0 extracts a lane of its v128 parameter, function 1 loads a
+	// v128 value with the given alignment.
+	code := func(lane, align byte) []byte {
+		return []byte{0x0a, 0x12, 0x02,
+			0x07, 0x00, 0x20, 0x00, 0xfd, 0x1b, lane, 0x0b,
+			0x08, 0x00, 0x20, 0x00, 0xfd, 0x00, align, 0x00, 0x0b}
+	}
+	read := func(sections ...[]byte) *wasm.Module {
+		raw := bytes.Join(append([][]byte{header}, sections...), nil)
+		m, err := wasm.ReadModule(bytes.NewReader(raw), nil)
+		if err != nil {
+			t.Fatalf("could not read module: %v", err)
+		}
+		return m
+	}
+
+	m := read(types, funcs, code(3, 4))
+	if err := VerifyModule(m); err != FeatureError(ops.FeatureSIMD) {
+		t.Errorf("VerifyModule: got error %v, want %v", err, FeatureError(ops.FeatureSIMD))
+	}
+	if err := VerifyModuleWithFeatures(m, ops.FeatureSIMD); err != nil {
+		t.Errorf("VerifyModuleWithFeatures: %v", err)
+	}
+
+	for _, tt := range []struct {
+		lane, align byte
+		fn          int
+	}{
+		{4, 4, 0},
+		{3, 5, 1},
+	} {
+		err := VerifyModuleWithFeatures(read(types, funcs, code(tt.lane, tt.align)), ops.FeatureSIMD)
+		verr, ok := err.(Error)
+		if _, imm := verr.Err.(InvalidImmediateError); !ok || verr.Function != tt.fn || !imm {
+			t.Errorf("lane %d, alignment %d: got error %v, want an InvalidImmediateError in function %d", tt.lane, tt.align, err, tt.fn)
+		}
+	}
+}
+
+func TestVerifyModuleLimits(t *testing.T) {
+	// A function with two locals and two nested blocks.
+	raw := []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+		0x01, 0x04, 0x01, 0x60, 0x00, 0x00,
+		0x03, 0x02, 0x01, 0x00,
+		0x0a, 0x0c, 0x01, 0x0a, 0x01, 0x02, 0x7f, 0x02, 0x40, 0x02, 0x40, 0x0b, 0x0b, 0x0b,
+	}
+	m, err := wasm.ReadModule(bytes.NewReader(raw), nil)
+	if err != nil {
+		t.Fatalf("could not read module: %v", err)
+	}
+
+	for _, tt := range []struct {
+		limits wasm.Limits
+		want   error
+	}{
+		{wasm.Limits{}, nil},
+		{wasm.Limits{Locals: 2, NestingDepth: 2}, nil},
+		{wasm.Limits{Locals: 1}, ErrLocalEntryCount},
+		{wasm.Limits{NestingDepth: 1}, wasm.OutsizeError{ImmType: "Nesting depth", Size: 2, Max: 1}},
+	} {
+		err := VerifyModuleWithLimits(m, 0, tt.limits)
+		if verr, ok := err.(Error); ok {
+			err = verr.Err
+		}
+		if err != tt.want {
+			t.Errorf("%+v: got error %v, want %v", tt.limits, err, tt.want)
+		}
+	}
+}
+
+func TestVerifyModuleValueTypes(t *testing.T) {
+	// A module defining a function with a local of type 0x40.
+	raw := []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+		0x01, 0x04, 0x01, 0x60, 0x00, 0x00,
+		0x03, 0x02, 0x01, 0x00,
+		0x0a, 0x06, 0x01, 0x04, 0x01, 0x01, 0x40, 0x0b,
+	}
+	m, err := wasm.ReadModule(bytes.NewReader(raw), nil)
+	if err != nil {
+		t.Fatalf("could not read module: %v", err)
+	}
+	if err := VerifyModule(m); err != InvalidValueTypeError(0x40) {
+		t.Errorf("VerifyModule: got error %v, want %v", err, InvalidValueTypeError(0x40))
+	}
+}
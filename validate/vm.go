@@ -27,18 +27,21 @@ type mockVM struct {
 	blocks      []block // a stack of encountered blocks
 
 	curFunc *wasm.FunctionSig
+
+	op      ops.Op // the operator being verified
+	opStart int    // the offset of the operator being verified
 }
 
 // a block represents an instruction sequence preceded by a control flow operator
 // it is used to verify that the block signature set by the operator is the correct
 // one when the block ends
 type block struct {
-	pc          int            // the pc where the control flow operator starting the block is located
-	stackTop    int            // stack top when the block started
-	blockType   wasm.BlockType // block_type signature of the control operator
-	op          byte           // opcode for the operator starting the new block
-	polymorphic bool           // whether the block has a polymorphic stack
-	loop        bool           // whether the block is the body of a loop instruction
+	pc          int               // the pc where the control flow operator starting the block is located
+	stackTop    int               // stack top when the block started, below its parameters
+	sig         *wasm.FunctionSig // parameter and result types of the block
+	op          byte              // opcode for the operator starting the new block
+	polymorphic bool              // whether the block has a polymorphic stack
+	loop        bool              // whether the block is the body of a loop instruction
 }
 
 func (vm *mockVM) fetchVarUint() (uint32, error) {
@@ -75,13 +78,26 @@ func (vm *mockVM) fetchUint64() (uint64, error) {
 	return binary.LittleEndian.Uint64(buf[:]), nil
 }
 
-func (vm *mockVM) pushBlock(op byte, blockType wasm.BlockType) {
-	logger.Printf("Pushing block %v", blockType)
+// readOp returns the operator starting with the byte code, reading the
+// sub-opcode of prefixed operators.
+func (vm *mockVM) readOp(code byte) (ops.Op, error) {
+	if !ops.IsPrefix(code) {
+		return ops.New(code)
+	}
+	sub, err := vm.fetchVarUint()
+	if err != nil {
+		return ops.Op{}, err
+	}
+	return ops.NewPrefixed(code, sub)
+}
+
+func (vm *mockVM) pushBlock(op byte, sig *wasm.FunctionSig) {
+	logger.Printf("Pushing block %v", sig)
 	vm.blocks = append(vm.blocks, block{
 		pc:          vm.pc(),
 		stackTop:    vm.stackTop,
-		blockType:   blockType,
-		polymorphic: vm.isPolymorphic(),
+		sig:         sig,
+		polymorphic: false,
 		op:          op,
 		loop:        op == ops.Loop,
 	})
@@ -96,35 +112,50 @@ func (vm *mockVM) getBlockFromDepth(depth int) *block {
 	return &vm.blocks[len(vm.blocks)-1-depth]
 }
 
-// Returns nil if depth is a valid nesting depth value that can be
-// branched to.
-func (vm *mockVM) canBranch(depth int) error {
-	blockType := wasm.BlockTypeEmpty
-
-	block := vm.getBlockFromDepth(depth)
-	// jumping to the start of a loop block doesn't push a value
-	// on the stack.
-	if block == nil {
-		if depth == len(vm.blocks) {
-			// equivalent to a `return', as the function
-			// body is an "implicit" block
-			if len(vm.curFunc.ReturnTypes) != 0 {
-				blockType = wasm.BlockType(vm.curFunc.ReturnTypes[0])
-			}
-		} else {
-			return InvalidLabelError(uint32(depth))
-		}
-	} else if !block.loop {
-		blockType = block.blockType
+// labelTypes returns the types of the values passed by a branch to the
+// block of the given nesting depth.
+func (vm *mockVM) labelTypes(depth uint32) ([]wasm.ValueType, error) {
+	block := vm.getBlockFromDepth(int(depth))
+	// jumping to the start of a loop block passes the parameters
+	// of the loop instead of its results.
+	switch {
+	case block != nil && block.loop:
+		return block.sig.ParamTypes, nil
+	case block != nil:
+		return block.sig.ReturnTypes, nil
+	case int(depth) == len(vm.blocks):
+		// equivalent to a `return', as the function
+		// body is an "implicit" block
+		return vm.curFunc.ReturnTypes, nil
 	}
+	return nil, InvalidLabelError(depth)
+}
 
-	if blockType != wasm.BlockTypeEmpty {
-		top, under := vm.topOperand()
-		if under || top.Type != wasm.ValueType(blockType) {
-			return InvalidTypeError{wasm.ValueType(blockType), top.Type}
-		}
+// canBranch returns an error if depth is not a valid nesting depth, or
+// if the operands on the top of the stack cannot be passed to its block.
+func (vm *mockVM) canBranch(depth uint32) error {
+	types, err := vm.labelTypes(depth)
+	if err != nil {
+		return err
+	}
+	if err := vm.popOperands(types); err != nil {
+		return err
+	}
+	for _, t := range types {
+		vm.pushOperand(t)
 	}
+	return nil
+}
 
+// endBlock pops the results of the innermost block, or of the function
+// body if there is no block, which must be the only operands of the block.
+func (vm *mockVM) endBlock(results []wasm.ValueType) error {
+	if err := vm.popOperands(results); err != nil {
+		return err
+	}
+	if vm.stackTop != vm.stackBase() {
+		return ErrUnbalancedStack
+	}
 	return nil
 }
 
@@ -149,22 +180,47 @@ func (vm *mockVM) topBlock() *block {
 	return &vm.blocks[len(vm.blocks)-1]
 }
 
-func (vm *mockVM) topOperand() (o operand, under bool) {
-	stackTop := vm.stackTop - 1
-	if stackTop == -1 {
-		under = true
-		return
+// stackBase returns the height of the operand stack below the operands of
+// the innermost block.
+func (vm *mockVM) stackBase() int {
+	if len(vm.blocks) == 0 {
+		return 0
 	}
-	o = vm.stack[stackTop]
-	return
+	return vm.topBlock().stackTop
 }
 
+// popOperands pops operands of the given types, the last one being the
+// topmost.
+func (vm *mockVM) popOperands(types []wasm.ValueType) error {
+	for i := len(types) - 1; i >= 0; i-- {
+		if _, err := vm.popOperandOf(types[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// popOperandOf pops an operand of type t.
+func (vm *mockVM) popOperandOf(t wasm.ValueType) (operand, error) {
+	o, under := vm.popOperand()
+	if under || !o.is(t) {
+		return o, InvalidTypeError{t, o.Type}
+	}
+	return o, nil
+}
+
+// popOperand pops an operand of the innermost block. The operands below
+// those of the block are out of its reach, but an unreachable block has
+// as many operands of unknown type as needed.
 func (vm *mockVM) popOperand() (operand, bool) {
 	var o operand
-	stackTop := vm.stackTop - 1
-	if stackTop == -1 {
+	if vm.stackTop == vm.stackBase() {
+		if vm.isPolymorphic() {
+			return operand{unknownType}, false
+		}
 		return o, true
 	}
+	stackTop := vm.stackTop - 1
 	o = vm.stack[stackTop]
 	vm.stackTop--
 
@@ -187,9 +243,8 @@ func (vm *mockVM) pushOperand(t wasm.ValueType) {
 
 func (vm *mockVM) adjustStack(op ops.Op) error {
 	for _, t := range op.Args {
-		op, under := vm.popOperand()
-		if !vm.isPolymorphic() && (under || op.Type != t) {
-			return InvalidTypeError{t, op.Type}
+		if _, err := vm.popOperandOf(t); err != nil {
+			return err
 		}
 	}
 
@@ -200,11 +255,12 @@ func (vm *mockVM) adjustStack(op ops.Op) error {
 	return nil
 }
 
-// setPolymorphic sets the current block as having a polymorphic stack
-// blocks created under it will be polymorphic too. All type-checking
-// is ignored in a polymorphic stack.
-// (See https://github.com/WebAssembly/design/blob/27ac254c854994103c24834a994be16f74f54186/Semantics.md#validation)
+// setPolymorphic sets the current block as having a polymorphic stack, as
+// the rest of the block is unreachable. Its operands are dropped, and it
+// has as many operands of unknown type as it pops.
+// (See https://webassembly.github.io/spec/core/appendix/algorithm.html)
 func (vm *mockVM) setPolymorphic() {
+	vm.stackTop = vm.stackBase()
 	if len(vm.blocks) == 0 {
 		vm.polymorphic = true
 	} else {
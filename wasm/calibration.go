@@ -9,56 +9,187 @@ const (
 	MaxPageNum    = MaxMemorySize / WasmPageSize
 )
 
-func checkTableLimits(m *Module) error {
-	if m.Table != nil {
-		for i, e := range m.Table.Entries {
-			if e.Limits.Initial > MaxTableSize {
-				return OutsizeError{"First Calibration Table", uint64(e.Limits.Initial), uint64(MaxTableSize)}
+// Limits bounds the sizes of the modules read by ReadModuleWithConfig.
+// A zero field leaves the corresponding size unbounded. A size exceeding
+// its bound is reported with an OutsizeError. The Config of a module being
+// deployed is derived from its validate.Policy by the Config method.
+type Limits struct {
+	MemoryPages  uint32 // initial and maximum pages of a linear memory
+	TableSize    uint32 // initial and maximum elements of a table
+	Locals       uint32 // local variables of a function, not counting its parameters
+	Functions    uint32 // functions, including the imported ones
+	Globals      uint32 // globals, including the imported ones
+	DataBytes    uint64 // total size of the data segments
+	NestingDepth uint32 // nested blocks of a function body, checked by the validate package
+	BodySize     uint32 // size of the instructions of a function body, without the final end
+}
+
+// DefaultLimits returns the limits used by the validate package, which
+// allow MaxPageNum pages of memory, MaxTableSize table elements and
+// MaxLocalEntryCount locals. The other sizes are unbounded.
+func DefaultLimits() Limits {
+	return Limits{
+		MemoryPages: MaxPageNum,
+		TableSize:   MaxTableSize,
+		Locals:      MaxLocalEntryCount,
+	}
+}
+
+// Config holds the options of ReadModuleWithConfig.
+type Config struct {
+	Policy Policy
+	Limits Limits
+
+	// KeepDeclaredLimits leaves the limits of the tables and memories of
+	// the module as they were decoded, so that it encodes back to the same
+	// bytes. Otherwise, those without a maximum are given the one allowed
+	// by Limits.
+	KeepDeclaredLimits bool
+}
+
+// DefaultConfig returns the configuration used by ReadModule. Like
+// WasmCalibration, it only bounds the tables and memories, with the limits
+// of DefaultLimits; the locals are left to the validate package.
+func DefaultConfig() Config {
+	l := DefaultLimits()
+	return Config{Limits: Limits{MemoryPages: l.MemoryPages, TableSize: l.TableSize}}
+}
+
+// Check returns an OutsizeError if a size of m exceeds its bound in l.
+// The nesting depth of the function bodies is not checked, as their
+// instructions are only decoded by the validate package.
+func (l Limits) Check(m *Module) error {
+	if err := checkTableLimits(m, l); err != nil {
+		return err
+	}
+	if err := checkMemoryLimits(m, l); err != nil {
+		return err
+	}
+
+	var functions, globals uint64
+	if m.Import != nil {
+		for _, entry := range m.Import.Entries {
+			switch entry.Type.(type) {
+			case FuncImport:
+				functions++
+			case GlobalVarImport:
+				globals++
 			}
+		}
+	}
+	if m.Function != nil {
+		functions += uint64(len(m.Function.Types))
+	}
+	if m.Global != nil {
+		globals += uint64(len(m.Global.Globals))
+	}
+	if l.Functions != 0 && functions > uint64(l.Functions) {
+		return OutsizeError{"Function", functions, uint64(l.Functions)}
+	}
+	if l.Globals != 0 && globals > uint64(l.Globals) {
+		return OutsizeError{"Global", globals, uint64(l.Globals)}
+	}
 
-			if e.Limits.Flags&0x1 != 0 && e.Limits.Maximum > MaxTableSize {
-				return OutsizeError{"First Calibration Table", uint64(e.Limits.Maximum), uint64(MaxTableSize)}
-			} else {
-				m.Table.Entries[i].Limits.Flags = 1
-				m.Table.Entries[i].Limits.Maximum = MaxTableSize
+	if m.Code != nil {
+		for _, body := range m.Code.Bodies {
+			if l.BodySize != 0 && len(body.Code) > int(l.BodySize) {
+				return OutsizeError{"Function body", uint64(len(body.Code)), uint64(l.BodySize)}
+			}
+			locals := uint64(0)
+			for _, entry := range body.Locals {
+				locals += uint64(entry.Count)
+			}
+			if l.Locals != 0 && locals > uint64(l.Locals) {
+				return OutsizeError{"Local", locals, uint64(l.Locals)}
 			}
 		}
 	}
 
+	if m.Data != nil && l.DataBytes != 0 {
+		size := uint64(0)
+		for _, entry := range m.Data.Entries {
+			size += uint64(len(entry.Data))
+		}
+		if size > l.DataBytes {
+			return OutsizeError{"Data", size, l.DataBytes}
+		}
+	}
 	return nil
 }
 
-func checkMemoryLimits(m *Module) error {
-	if m.Memory != nil {
-		for i, e := range m.Memory.Entries {
-			if e.Limits.Initial > MaxPageNum {
-				return OutsizeError{"First Calibration Memory", uint64(e.Limits.Initial), uint64(MaxPageNum)}
-			}
+func checkTableLimits(m *Module, l Limits) error {
+	if m.Table == nil || l.TableSize == 0 {
+		return nil
+	}
+	for _, e := range m.Table.Entries {
+		if e.Limits.Initial > l.TableSize {
+			return OutsizeError{"First Calibration Table", uint64(e.Limits.Initial), uint64(l.TableSize)}
+		}
+		if e.Limits.Flags&0x1 != 0 && e.Limits.Maximum > l.TableSize {
+			return OutsizeError{"First Calibration Table", uint64(e.Limits.Maximum), uint64(l.TableSize)}
+		}
+	}
+	return nil
+}
+
+func checkMemoryLimits(m *Module, l Limits) error {
+	if m.Memory == nil || l.MemoryPages == 0 {
+		return nil
+	}
+	for _, e := range m.Memory.Entries {
+		if e.Limits.Initial > l.MemoryPages {
+			return OutsizeError{"First Calibration Memory", uint64(e.Limits.Initial), uint64(l.MemoryPages)}
+		}
+		if e.Limits.Flags&0x1 != 0 && e.Limits.Maximum > l.MemoryPages {
+			return OutsizeError{"First Calibration Memory", uint64(e.Limits.Maximum), uint64(l.MemoryPages)}
+		}
+	}
+	return nil
+}
 
-			if e.Limits.Flags == 1 {
-				if e.Limits.Maximum > MaxPageNum {
-					return OutsizeError{"First Calibration Memory", uint64(e.Limits.Maximum), uint64(MaxPageNum)}
-				}
-			} else {
-				m.Memory.Entries[i].Limits.Flags = 1
-				m.Memory.Entries[i].Limits.Maximum = MaxPageNum
+// setMaximums gives the tables and memories of m without a maximum the
+// one allowed by l, if any.
+func setMaximums(m *Module, l Limits) {
+	if m.Table != nil && l.TableSize != 0 {
+		for i, e := range m.Table.Entries {
+			if e.Limits.Flags&0x1 == 0 {
+				m.Table.Entries[i].Limits.Flags |= 0x1
+				m.Table.Entries[i].Limits.Maximum = l.TableSize
+			}
+		}
+	}
+	if m.Memory != nil && l.MemoryPages != 0 {
+		for i, e := range m.Memory.Entries {
+			if e.Limits.Flags&0x1 == 0 {
+				m.Memory.Entries[i].Limits.Flags |= 0x1
+				m.Memory.Entries[i].Limits.Maximum = l.MemoryPages
 			}
 		}
 	}
+}
 
-	return nil
+// maximum returns the maximum size declared by lim, or else bound, or else
+// max if bound is zero.
+func maximum(lim ResizableLimits, bound uint32, max uint64) uint64 {
+	if lim.Flags&0x1 != 0 {
+		return uint64(lim.Maximum)
+	}
+	if bound != 0 {
+		return uint64(bound)
+	}
+	return max
 }
 
+// WasmCalibration checks the tables and memories of m against
+// DefaultLimits, and gives those without a maximum the one allowed.
 func WasmCalibration(m *Module) error {
-	err := checkTableLimits(m)
-	if err != nil {
+	l := DefaultConfig().Limits
+	if err := checkTableLimits(m, l); err != nil {
 		return err
 	}
-
-	err = checkMemoryLimits(m)
-	if err != nil {
+	if err := checkMemoryLimits(m, l); err != nil {
 		return err
 	}
-
+	setMaximums(m, l)
 	return nil
 }
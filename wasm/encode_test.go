@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 
 	"github.com/ontio/wagon/wasm"
@@ -48,3 +49,154 @@ func TestEncode(t *testing.T) {
 		}
 	}
 }
+
+func TestBlockType(t *testing.T) {
+	for _, tc := range []struct {
+		raw  []byte
+		want interface{}
+	}{
+		{[]byte{0x40}, wasm.BlockTypeEmpty},
+		{[]byte{0x7f}, wasm.BlockType(wasm.ValueTypeI32)},
+		{[]byte{0x03}, wasm.BlockTypeIndex(3)},
+		{[]byte{0xc0, 0x00}, wasm.BlockTypeIndex(64)},
+		{[]byte{0xff, 0xff, 0xff, 0xff, 0x0f}, wasm.BlockTypeIndex(1<<32 - 1)},
+	} {
+		bt, err := wasm.ReadBlockType(bytes.NewReader(tc.raw))
+		if err != nil {
+			t.Errorf("%x: %v", tc.raw, err)
+			continue
+		}
+		if bt != tc.want {
+			t.Errorf("%x: got block type %v, want %v", tc.raw, bt, tc.want)
+		}
+		buf := new(bytes.Buffer)
+		if err := wasm.WriteBlockType(buf, bt); err != nil || !bytes.Equal(buf.Bytes(), tc.raw) {
+			t.Errorf("%v: encoded as %x (%v), want %x", bt, buf.Bytes(), err, tc.raw)
+		}
+	}
+
+	for _, raw := range [][]byte{{0x80, 0x40}, {0xff, 0xff, 0xff, 0xff, 0x1f}, {0x80, 0x80, 0x80, 0x80, 0x80, 0x00}} {
+		if bt, err := wasm.ReadBlockType(bytes.NewReader(raw)); err == nil {
+			t.Errorf("%x: invalid block type read as %v", raw, bt)
+		}
+	}
+}
+
+func TestSegments(t *testing.T) {
+	header := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	tables := []byte{0x04, 0x07, 0x02, 0x70, 0x00, 0x01, 0x70, 0x00, 0x01}
+	memory := []byte{0x05, 0x03, 0x01, 0x00, 0x01}
+	elems := []byte{0x09, 0x13, 0x03,
+		0x00, 0x41, 0x00, 0x0b, 0x01, 0x00,
+		0x01, 0x00, 0x01, 0x00,
+		0x02, 0x01, 0x41, 0x00, 0x0b, 0x00, 0x01, 0x00}
+	dataCount := []byte{0x0c, 0x01, 0x03}
+	data := []byte{0x0b, 0x11, 0x03,
+		0x00, 0x41, 0x00, 0x0b, 0x01, 'a',
+		0x01, 0x01, 'b',
+		0x02, 0x01, 0x41, 0x00, 0x0b, 0x01, 'c'}
+	module := func(sections ...[]byte) []byte {
+		return bytes.Join(append([][]byte{header}, sections...), nil)
+	}
+
+	raw := module(tables, memory, elems, dataCount, data)
+	m, err := wasm.DecodeModule(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("could not decode module: %v", err)
+	}
+	for i, want := range []struct {
+		index   uint32
+		passive bool
+	}{{0, false}, {0, true}, {1, false}} {
+		e, d := m.Elements.Entries[i], m.Data.Entries[i]
+		if e.Index != want.index || e.Passive != want.passive || (e.Offset == nil) != want.passive {
+			t.Errorf("element segment %d: got index %d, passive %v, offset %x", i, e.Index, e.Passive, e.Offset)
+		}
+		if d.Index != want.index || d.Passive != want.passive || (d.Offset == nil) != want.passive {
+			t.Errorf("data segment %d: got index %d, passive %v, offset %x", i, d.Index, d.Passive, d.Offset)
+		}
+	}
+	if m.DataCount == nil || m.DataCount.Count != 3 {
+		t.Errorf("got data count section %v, want a count of 3", m.DataCount)
+	}
+	buf := new(bytes.Buffer)
+	if err := wasm.EncodeModule(buf, m); err != nil {
+		t.Fatalf("could not encode module: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), raw) {
+		t.Errorf("module encoded as %x, want %x", buf.Bytes(), raw)
+	}
+
+	for name, raw := range map[string][]byte{
+		"data count after data": module(tables, memory, elems, data, dataCount),
+		"data count mismatch":   module(tables, memory, elems, []byte{0x0c, 0x01, 0x02}, data),
+		"missing data":          module(tables, memory, elems, dataCount),
+		"invalid flags":         module(memory, []byte{0x0b, 0x04, 0x01, 0x03, 0x01, 'a'}),
+		"invalid element kind":  module(tables, []byte{0x09, 0x04, 0x01, 0x01, 0x70, 0x00}),
+	} {
+		if _, err := wasm.DecodeModule(bytes.NewReader(raw)); err == nil {
+			t.Errorf("%s: invalid module was decoded", name)
+		}
+	}
+}
+
+func TestElementSegmentExprs(t *testing.T) {
+	header := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	tables := []byte{0x04, 0x07, 0x02, 0x70, 0x00, 0x01, 0x6f, 0x00, 0x01}
+	elems := []byte{0x09, 0x26, 0x05,
+		0x03, 0x00, 0x01, 0x02,
+		0x04, 0x41, 0x00, 0x0b, 0x02, 0xd2, 0x01, 0x0b, 0xd0, 0x70, 0x0b,
+		0x05, 0x6f, 0x01, 0xd0, 0x6f, 0x0b,
+		0x06, 0x01, 0x41, 0x00, 0x0b, 0x6f, 0x01, 0xd0, 0x6f, 0x0b,
+		0x07, 0x70, 0x01, 0xd2, 0x00, 0x0b}
+	module := func(sections ...[]byte) []byte {
+		return bytes.Join(append([][]byte{header}, sections...), nil)
+	}
+
+	raw := module(tables, elems)
+	m, err := wasm.DecodeModule(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("could not decode module: %v", err)
+	}
+	for i, want := range []struct {
+		index       uint32
+		passive     bool
+		declarative bool
+		typ         wasm.ElemType
+		entries     []wasm.TableEntry
+	}{
+		{0, false, true, wasm.ElemTypeAnyFunc, []wasm.TableEntry{{Index: 2, Initialized: true}}},
+		{0, false, false, wasm.ElemTypeAnyFunc, []wasm.TableEntry{{Index: 1, Initialized: true}, {}}},
+		{0, true, false, wasm.ElemTypeExternRef, []wasm.TableEntry{{}}},
+		{1, false, false, wasm.ElemTypeExternRef, []wasm.TableEntry{{}}},
+		{0, false, true, wasm.ElemTypeAnyFunc, []wasm.TableEntry{{Index: 0, Initialized: true}}},
+	} {
+		e := m.Elements.Entries[i]
+		if e.Index != want.index || e.Passive != want.passive || e.Declarative != want.declarative || e.Type != want.typ {
+			t.Errorf("element segment %d: got index %d, passive %v, declarative %v, type %v", i, e.Index, e.Passive, e.Declarative, e.Type)
+		}
+		entries, err := e.Entries(m)
+		if err != nil {
+			t.Errorf("element segment %d: %v", i, err)
+		} else if !reflect.DeepEqual(entries, want.entries) {
+			t.Errorf("element segment %d: got entries %v, want %v", i, entries, want.entries)
+		}
+	}
+	buf := new(bytes.Buffer)
+	if err := wasm.EncodeModule(buf, m); err != nil {
+		t.Fatalf("could not encode module: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), raw) {
+		t.Errorf("module encoded as %x, want %x", buf.Bytes(), raw)
+	}
+
+	for name, raw := range map[string][]byte{
+		"invalid flags":          module(tables, []byte{0x09, 0x04, 0x01, 0x08, 0x70, 0x00}),
+		"invalid reference type": module(tables, []byte{0x09, 0x04, 0x01, 0x05, 0x7f, 0x00}),
+		"invalid ref.null type":  module(tables, []byte{0x09, 0x07, 0x01, 0x05, 0x70, 0x01, 0xd0, 0x7f, 0x0b}),
+	} {
+		if _, err := wasm.DecodeModule(bytes.NewReader(raw)); err == nil {
+			t.Errorf("%s: invalid module was decoded", name)
+		}
+	}
+}
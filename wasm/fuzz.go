@@ -0,0 +1,27 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build gofuzz
+// +build gofuzz
+
+package wasm
+
+import "bytes"
+
+// Fuzz is the entry point for go-fuzz (https://github.com/dvyukov/go-fuzz).
+// It decodes data as a module, and reads it again like ReadModule if it
+// could be decoded. The modules of testdata make a good initial corpus:
+//
+//	go-fuzz-build github.com/ontio/wagon/wasm
+//	mkdir -p corpus && cp testdata/*.wasm corpus
+//	go-fuzz -bin wasm-fuzz.zip
+func Fuzz(data []byte) int {
+	if _, err := DecodeModule(bytes.NewReader(data)); err != nil {
+		return 0
+	}
+	if _, err := ReadModule(bytes.NewReader(data), nil); err != nil {
+		return 0
+	}
+	return 1
+}
@@ -204,14 +204,15 @@ func (module *Module) resolveImports(resolve ResolveFunc) error {
 			module.GlobalIndexSpace = append(module.GlobalIndexSpace, *glb)
 			module.imports.Globals++
 
-			// In both cases below, index should be always 0 (according to the MVP)
-			// We check it against the length of the index space anyway.
 		case ExternalTable:
 			if int(index) >= len(importedModule.TableIndexSpace) {
 				return InvalidTableIndexError(index)
 			}
-			module.TableIndexSpace[0] = importedModule.TableIndexSpace[0]
+			module.TableIndexSpace = append(module.TableIndexSpace, importedModule.TableIndexSpace[index])
 			module.imports.Tables++
+
+			// In the case below, index should be always 0 (according to the MVP)
+			// We check it against the length of the index space anyway.
 		case ExternalMemory:
 			if int(index) >= len(importedModule.LinearMemoryIndexSpace) {
 				return InvalidLinearMemoryIndexError(index)
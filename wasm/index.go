@@ -6,6 +6,7 @@ package wasm
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 )
 
@@ -53,6 +54,15 @@ func (m *Module) populateFunctions() error {
 	if m.Types == nil || m.Function == nil {
 		return nil
 	}
+	if m.Code == nil {
+		if len(m.Function.Types) != 0 {
+			return MissingSectionError(SectionIDCode)
+		}
+	} else if len(m.Code.Bodies) != len(m.Function.Types)+len(m.imports.Funcs) {
+		// The bodies of the imported functions follow those of the code
+		// section.
+		return ErrFunctionCodeMismatch
+	}
 
 	for codeIndex, typeIndex := range m.Function.Types {
 		if int(typeIndex) >= len(m.Types.Entries) {
@@ -104,14 +114,52 @@ func (m *Module) GetGlobal(i int) *GlobalEntry {
 	return &m.GlobalIndexSpace[i]
 }
 
-func (m *Module) populateTables() error {
-	if m.Table == nil || len(m.Table.Entries) == 0 || m.Elements == nil || len(m.Elements.Entries) == 0 {
+// GetTable returns a *Table, based on the table's index in the table
+// index space, where the imported tables come first. Returns nil when the
+// index is invalid.
+func (m *Module) GetTable(i int) *Table {
+	if i < 0 {
+		return nil
+	}
+	if m.Import != nil {
+		for _, entry := range m.Import.Entries {
+			table, ok := entry.Type.(TableImport)
+			if !ok {
+				continue
+			}
+			if i == 0 {
+				return &table.Type
+			}
+			i--
+		}
+	}
+	if m.Table == nil || i >= len(m.Table.Entries) {
+		return nil
+	}
+	return &m.Table.Entries[i]
+}
+
+func (m *Module) populateTables(limits Limits) error {
+	if len(m.TableIndexSpace) == 0 {
+		return nil
+	}
+	// Tables start with their initial number of uninitialized elements,
+	// which table.init and table.copy may write to.
+	if m.Table != nil {
+		for i, t := range m.Table.Entries {
+			if m.TableIndexSpace[m.imports.Tables+i] == nil {
+				m.TableIndexSpace[m.imports.Tables+i] = make([]TableEntry, t.Limits.Initial)
+			}
+		}
+	}
+	if m.Elements == nil || len(m.Elements.Entries) == 0 {
 		return nil
 	}
 
 	for _, elem := range m.Elements.Entries {
-		// the MVP dictates that index should always be zero, we should
-		// probably check this
+		if elem.Passive || elem.Declarative {
+			continue
+		}
 		if elem.Index >= uint32(len(m.TableIndexSpace)) {
 			return InvalidTableIndexError(elem.Index)
 		}
@@ -125,25 +173,25 @@ func (m *Module) populateTables() error {
 			return InvalidValueTypeInitExprError{reflect.Int32, reflect.TypeOf(val).Kind()}
 		}
 		offset := uint32(off)
+		entries, err := elem.Entries(m)
+		if err != nil {
+			return err
+		}
 
 		table := m.TableIndexSpace[elem.Index]
 		//use uint64 to avoid overflow
-		totalSize := uint64(offset) + uint64(len(elem.Elems))
+		totalSize := uint64(offset) + uint64(len(entries))
 		if totalSize > uint64(len(table)) {
-			maxAllowSize := uint64(m.Table.Entries[elem.Index].Limits.Maximum)
+			maxAllowSize := maximum(m.GetTable(int(elem.Index)).Limits, limits.TableSize, math.MaxUint32)
 			if totalSize > maxAllowSize {
 				return OutsizeError{"Table", totalSize, maxAllowSize}
 			}
 			data := make([]TableEntry, totalSize)
 			copy(data, table)
-			for i, index := range elem.Elems {
-				data[offset+uint32(i)] = TableEntry{Index: index, Initialized: true}
-			}
+			copy(data[offset:], entries)
 			m.TableIndexSpace[elem.Index] = data
 		} else {
-			for i, index := range elem.Elems {
-				table[offset+uint32(i)] = TableEntry{Index: index, Initialized: true}
-			}
+			copy(table[offset:], entries)
 		}
 	}
 
@@ -166,13 +214,16 @@ func (m *Module) GetTableElement(index int) (uint32, error) {
 	return entry.Index, nil
 }
 
-func (m *Module) populateLinearMemory() error {
+func (m *Module) populateLinearMemory(limits Limits) error {
 	if m.Data == nil || len(m.Data.Entries) == 0 {
 		return nil
 	}
 	// each module can only have a single linear memory in the MVP
 
 	for _, entry := range m.Data.Entries {
+		if entry.Passive {
+			continue
+		}
 		if entry.Index != 0 {
 			return InvalidLinearMemoryIndexError(entry.Index)
 		}
@@ -189,7 +240,7 @@ func (m *Module) populateLinearMemory() error {
 
 		memory := m.LinearMemoryIndexSpace[entry.Index]
 		if uint64(offset)+uint64(len(entry.Data)) > uint64(len(memory)) {
-			bound := uint64(m.Memory.Entries[entry.Index].Limits.Maximum * WasmPageSize)
+			bound := maximum(m.Memory.Entries[entry.Index].Limits, limits.MemoryPages, 1<<16) * WasmPageSize
 			if uint64(offset)+uint64(len(entry.Data)) > bound {
 				return OutsizeError{"Memory", uint64(offset) + uint64(len(entry.Data)), bound}
 			}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 
 	"github.com/ontio/wagon/wasm/leb128"
 )
@@ -19,7 +20,14 @@ const (
 	f32Const  byte = 0x43
 	f64Const  byte = 0x44
 	getGlobal byte = 0x23
+	refNull   byte = 0xd0
+	refFunc   byte = 0xd2
 	end       byte = 0x0b
+
+	// v128.const is encoded as the SIMD prefix byte followed by the
+	// sub-opcode v128Const and the 16 bytes of the constant.
+	simdPrefix byte   = 0xfd
+	v128Const  uint32 = 0x0c
 )
 
 var ErrEmptyInitExpr = errors.New("wasm: Initializer expression produces no value")
@@ -71,6 +79,26 @@ outer:
 			if err != nil {
 				return nil, err
 			}
+		case refNull:
+			var t ElemType
+			if err := t.UnmarshalWASM(r); err != nil {
+				return nil, err
+			}
+		case refFunc:
+			if _, err := leb128.ReadVarUint32(r); err != nil {
+				return nil, err
+			}
+		case simdPrefix:
+			sub, err := leb128.ReadVarUint32(r)
+			if err != nil {
+				return nil, err
+			}
+			if sub != v128Const {
+				return nil, InvalidInitExprOpError(b[0])
+			}
+			if _, err := io.ReadFull(r, make([]byte, 16)); err != nil {
+				return nil, err
+			}
 		case end:
 			break outer
 		default:
@@ -86,12 +114,15 @@ outer:
 }
 
 // ExecInitExpr executes an initializer expression and returns an interface{} value
-// which can either be int32, int64, float32 or float64.
+// which can either be int32, int64, float32 or float64, a TableEntry for
+// references, which is uninitialized for a null reference, or a [16]byte
+// holding a v128 value in little-endian order.
 // It returns an error if the expression is invalid, and nil when the expression
 // yields no value.
 func (m *Module) ExecInitExpr(expr []byte) (interface{}, error) {
 	var stack []uint64
 	var lastVal ValueType
+	var vec [16]byte
 	r := bytes.NewReader(expr)
 
 	if r.Len() == 0 {
@@ -120,20 +151,20 @@ func (m *Module) ExecInitExpr(expr []byte) (interface{}, error) {
 			}
 			stack = append(stack, uint64(i))
 			lastVal = ValueTypeI64
-		//case f32Const:
-		//	i, err := readU32(r)
-		//	if err != nil {
-		//		return nil, err
-		//	}
-		//	stack = append(stack, uint64(i))
-		//	lastVal = ValueTypeF32
-		//case f64Const:
-		//	i, err := readU64(r)
-		//	if err != nil {
-		//		return nil, err
-		//	}
-		//	stack = append(stack, i)
-		//	lastVal = ValueTypeF64
+		case f32Const:
+			i, err := readU32(r)
+			if err != nil {
+				return nil, err
+			}
+			stack = append(stack, uint64(i))
+			lastVal = ValueTypeF32
+		case f64Const:
+			i, err := readU64(r)
+			if err != nil {
+				return nil, err
+			}
+			stack = append(stack, i)
+			lastVal = ValueTypeF64
 		case getGlobal:
 			index, err := leb128.ReadVarUint32(r)
 			if err != nil {
@@ -144,6 +175,35 @@ func (m *Module) ExecInitExpr(expr []byte) (interface{}, error) {
 				return nil, InvalidGlobalIndexError(index)
 			}
 			lastVal = globalVar.Type.Type
+		case refNull:
+			t, err := ReadByte(r)
+			if err != nil {
+				return nil, err
+			}
+			// References are pushed as the index of the function
+			// plus one, so that the null reference is 0.
+			stack = append(stack, 0)
+			lastVal = ValueType(t)
+		case refFunc:
+			index, err := leb128.ReadVarUint32(r)
+			if err != nil {
+				return nil, err
+			}
+			stack = append(stack, uint64(index)+1)
+			lastVal = ValueTypeFuncRef
+		case simdPrefix:
+			sub, err := leb128.ReadVarUint32(r)
+			if err != nil {
+				return nil, err
+			}
+			if sub != v128Const {
+				return nil, InvalidInitExprOpError(b)
+			}
+			if _, err := io.ReadFull(r, vec[:]); err != nil {
+				return nil, err
+			}
+			stack = append(stack, 0)
+			lastVal = ValueTypeV128
 		case end:
 			break
 		default:
@@ -161,10 +221,17 @@ func (m *Module) ExecInitExpr(expr []byte) (interface{}, error) {
 		return int32(v), nil
 	case ValueTypeI64:
 		return int64(v), nil
-	//case ValueTypeF32:
-	//	return math.Float32frombits(uint32(v)), nil
-	//case ValueTypeF64:
-	//	return math.Float64frombits(uint64(v)), nil
+	case ValueTypeF32:
+		return math.Float32frombits(uint32(v)), nil
+	case ValueTypeF64:
+		return math.Float64frombits(uint64(v)), nil
+	case ValueTypeFuncRef, ValueTypeExternRef:
+		if v == 0 {
+			return TableEntry{}, nil
+		}
+		return TableEntry{Index: uint32(v - 1), Initialized: true}, nil
+	case ValueTypeV128:
+		return vec, nil
 	default:
 		panic(fmt.Sprintf("Invalid value type produced by initializer expression: %d", int8(lastVal)))
 	}
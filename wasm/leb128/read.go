@@ -22,7 +22,9 @@ func readVarUint(r io.Reader, n uint) (uint64, error) {
 	var shift uint
 	for {
 		_, err := io.ReadFull(r, p)
-		if err != nil {
+		if err == io.EOF && shift != 0 {
+			return 0, io.ErrUnexpectedEOF
+		} else if err != nil {
 			return 0, err
 		}
 		b := uint64(p[0])
@@ -51,7 +53,9 @@ func readVarint(r io.Reader, n uint) (int64, error) {
 	var shift uint
 	for {
 		_, err := io.ReadFull(r, p)
-		if err != nil {
+		if err == io.EOF && shift != 0 {
+			return 0, io.ErrUnexpectedEOF
+		} else if err != nil {
 			return 0, err
 		}
 		b := int64(p[0])
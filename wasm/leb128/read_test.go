@@ -43,6 +43,21 @@ func TestReadVarUint32Err(t *testing.T) {
 	}
 }
 
+func TestReadVarUint32Invalid(t *testing.T) {
+	for _, c := range []struct {
+		b   []byte
+		err string
+	}{
+		{[]byte{0x80, 0x80}, io.ErrUnexpectedEOF.Error()},
+		{[]byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x00}, "leb128: invalid uint"}, // overlong
+		{[]byte{0xff, 0xff, 0xff, 0xff, 0x1f}, "leb128: invalid uint"},       // more than 32 bits
+	} {
+		if _, err := ReadVarUint32(bytes.NewReader(c.b)); fmt.Sprint(err) != c.err {
+			t.Errorf("%x: got err=%v, want=%v", c.b, err, c.err)
+		}
+	}
+}
+
 var casesInt = []struct {
 	v int64
 	b []byte
@@ -15,6 +15,10 @@ import (
 
 var ErrInvalidMagic = errors.New("wasm: Invalid magic number")
 
+// ErrDataCountMismatch is returned when the data count section does not match
+// the number of segments of the data section.
+var ErrDataCountMismatch = errors.New("wasm: data count and data section have inconsistent lengths")
+
 const (
 	Magic   uint32 = 0x6d736100
 	Version uint32 = 0x1
@@ -39,18 +43,19 @@ type Module struct {
 	Version  uint32
 	Sections []Section
 
-	Types    *SectionTypes
-	Import   *SectionImports
-	Function *SectionFunctions
-	Table    *SectionTables
-	Memory   *SectionMemories
-	Global   *SectionGlobals
-	Export   *SectionExports
-	Start    *SectionStartFunction
-	Elements *SectionElements
-	Code     *SectionCode
-	Data     *SectionData
-	Customs  []*SectionCustom
+	Types     *SectionTypes
+	Import    *SectionImports
+	Function  *SectionFunctions
+	Table     *SectionTables
+	Memory    *SectionMemories
+	Global    *SectionGlobals
+	Export    *SectionExports
+	Start     *SectionStartFunction
+	Elements  *SectionElements
+	DataCount *SectionDataCount
+	Code      *SectionCode
+	Data      *SectionData
+	Customs   []*SectionCustom
 
 	// The function index space of the module
 	FunctionIndexSpace []Function
@@ -129,22 +134,43 @@ func DecodeModule(r io.Reader) (*Module, error) {
 	if err != nil {
 		return nil, err
 	}
+	if m.DataCount != nil {
+		n := 0
+		if m.Data != nil {
+			n = len(m.Data.Entries)
+		}
+		if int(m.DataCount.Count) != n {
+			return nil, ErrDataCountMismatch
+		}
+	}
 
 	return m, nil
 }
 
 // ReadModule reads a module from the reader r. resolvePath must take a string
 // and a return a reader to the module pointed to by the string.
+// The module is read with DefaultConfig.
 func ReadModule(r io.Reader, resolvePath ResolveFunc) (*Module, error) {
+	return ReadModuleWithConfig(r, resolvePath, DefaultConfig())
+}
+
+// ReadModuleWithPolicy is like ReadModule, but the module must comply with
+// policy instead.
+func ReadModuleWithPolicy(r io.Reader, resolvePath ResolveFunc, policy Policy) (*Module, error) {
+	config := DefaultConfig()
+	config.Policy = policy
+	return ReadModuleWithConfig(r, resolvePath, config)
+}
+
+// ReadModuleWithConfig is like ReadModule, but the module is read with
+// config.
+func ReadModuleWithConfig(r io.Reader, resolvePath ResolveFunc, config Config) (*Module, error) {
 	m, err := DecodeModule(r)
 	if err != nil {
 		return nil, err
 	}
 
 	m.LinearMemoryIndexSpace = make([][]byte, 1)
-	if m.Table != nil {
-		m.TableIndexSpace = make([][]TableEntry, int(len(m.Table.Entries)))
-	}
 
 	if m.Import != nil && resolvePath != nil {
 		if m.Code == nil {
@@ -156,17 +182,23 @@ func ReadModule(r io.Reader, resolvePath ResolveFunc) (*Module, error) {
 			return nil, err
 		}
 	}
+	// The imported tables come first in the table index space.
+	if m.Table != nil {
+		m.TableIndexSpace = append(m.TableIndexSpace, make([][]TableEntry, len(m.Table.Entries))...)
+	}
 
-	err = WasmCalibration(m)
-	if err != nil {
+	if err := config.Limits.Check(m); err != nil {
 		return nil, err
 	}
+	if !config.KeepDeclaredLimits {
+		setMaximums(m, config.Limits)
+	}
 
 	for _, fn := range []func() error{
 		m.populateGlobals,
 		m.populateFunctions,
-		m.populateTables,
-		m.populateLinearMemory,
+		func() error { return m.populateTables(config.Limits) },
+		func() error { return m.populateLinearMemory(config.Limits) },
 	} {
 		if err := fn(); err != nil {
 			return nil, err
@@ -174,9 +206,8 @@ func ReadModule(r io.Reader, resolvePath ResolveFunc) (*Module, error) {
 
 	}
 
-	//for smart contract , start entry is not supported
-	if m.Start != nil {
-		return nil, errors.New("start entry is not supported in smart contract")
+	if err := config.Policy.Check(m); err != nil {
+		return nil, err
 	}
 
 	logger.Printf("There are %d entries in the function index space.", len(m.FunctionIndexSpace))
@@ -6,6 +6,7 @@ package wasm_test
 
 import (
 	"bytes"
+	"io"
 	"io/ioutil"
 	"path/filepath"
 	"reflect"
@@ -173,3 +174,144 @@ func TestDuplicateExportError_NoStackOverflow(t *testing.T) {
 	err := wasm.DuplicateExportError("h")
 	_ = err.Error()
 }
+
+func TestReadModulePolicy(t *testing.T) {
+	// A module with a single function, () -> (), which is its start function.
+	raw := []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+		0x01, 0x04, 0x01, 0x60, 0x00, 0x00,
+		0x03, 0x02, 0x01, 0x00,
+		0x08, 0x01, 0x00,
+		0x0a, 0x04, 0x01, 0x02, 0x00, 0x0b,
+	}
+	if _, err := wasm.ReadModule(bytes.NewReader(raw), nil); err != wasm.ErrStartNotAllowed {
+		t.Fatalf("ReadModule: got error %v, want %v", err, wasm.ErrStartNotAllowed)
+	}
+	m, err := wasm.ReadModuleWithPolicy(bytes.NewReader(raw), nil, wasm.Policy{AllowStart: true})
+	if err != nil {
+		t.Fatalf("ReadModuleWithPolicy: %v", err)
+	}
+	if m.Start == nil || m.Start.Index != 0 {
+		t.Errorf("got start section %v, want function 0", m.Start)
+	}
+}
+
+func TestReadModuleLimits(t *testing.T) {
+	// Two functions, the first with two locals and the second with two
+	// nops, a table of two elements, a memory of two pages, two globals and
+	// a data segment of three bytes. The table and memory have no maximum.
+	raw := []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+		0x01, 0x04, 0x01, 0x60, 0x00, 0x00,
+		0x03, 0x03, 0x02, 0x00, 0x00,
+		0x04, 0x04, 0x01, 0x70, 0x00, 0x02,
+		0x05, 0x03, 0x01, 0x00, 0x02,
+		0x06, 0x0b, 0x02, 0x7f, 0x00, 0x41, 0x00, 0x0b, 0x7f, 0x00, 0x41, 0x00, 0x0b,
+		0x0a, 0x0b, 0x02, 0x04, 0x01, 0x02, 0x7f, 0x0b, 0x04, 0x00, 0x01, 0x01, 0x0b,
+		0x0b, 0x09, 0x01, 0x00, 0x41, 0x00, 0x0b, 0x03, 0x61, 0x62, 0x63,
+	}
+
+	for _, tt := range []struct {
+		limits wasm.Limits
+		want   error
+	}{
+		{wasm.Limits{MemoryPages: 1}, wasm.OutsizeError{ImmType: "First Calibration Memory", Size: 2, Max: 1}},
+		{wasm.Limits{TableSize: 1}, wasm.OutsizeError{ImmType: "First Calibration Table", Size: 2, Max: 1}},
+		{wasm.Limits{Locals: 1}, wasm.OutsizeError{ImmType: "Local", Size: 2, Max: 1}},
+		{wasm.Limits{Functions: 1}, wasm.OutsizeError{ImmType: "Function", Size: 2, Max: 1}},
+		{wasm.Limits{Globals: 1}, wasm.OutsizeError{ImmType: "Global", Size: 2, Max: 1}},
+		{wasm.Limits{DataBytes: 2}, wasm.OutsizeError{ImmType: "Data", Size: 3, Max: 2}},
+		{wasm.Limits{BodySize: 1}, wasm.OutsizeError{ImmType: "Function body", Size: 2, Max: 1}},
+		{wasm.Limits{MemoryPages: 2, TableSize: 2, Locals: 2, Functions: 2, Globals: 2, DataBytes: 3, BodySize: 2}, nil},
+	} {
+		_, err := wasm.ReadModuleWithConfig(bytes.NewReader(raw), nil, wasm.Config{Limits: tt.limits})
+		if err != tt.want {
+			t.Errorf("%+v: got error %v, want %v", tt.limits, err, tt.want)
+		}
+	}
+
+	m, err := wasm.ReadModule(bytes.NewReader(raw), nil)
+	if err != nil {
+		t.Fatalf("ReadModule: %v", err)
+	}
+	if got, want := m.Memory.Entries[0].Limits, (wasm.ResizableLimits{Flags: 1, Initial: 2, Maximum: wasm.MaxPageNum}); got != want {
+		t.Errorf("ReadModule: got memory limits %+v, want %+v", got, want)
+	}
+
+	config := wasm.DefaultConfig()
+	config.KeepDeclaredLimits = true
+	m, err = wasm.ReadModuleWithConfig(bytes.NewReader(raw), nil, config)
+	if err != nil {
+		t.Fatalf("ReadModuleWithConfig: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := wasm.EncodeModule(&buf, m); err != nil {
+		t.Fatalf("EncodeModule: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), raw) {
+		t.Errorf("the module with its declared limits encodes to\n%x, want\n%x", buf.Bytes(), raw)
+	}
+}
+
+func TestReadModuleLocals(t *testing.T) {
+	// A function with MaxLocalEntryCount+1 locals, which ReadModule leaves
+	// to the validate package.
+	raw := []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+		0x01, 0x04, 0x01, 0x60, 0x00, 0x00,
+		0x03, 0x02, 0x01, 0x00,
+		0x0a, 0x07, 0x01, 0x05, 0x01, 0x81, 0x08, 0x7f, 0x0b,
+	}
+	if _, err := wasm.ReadModule(bytes.NewReader(raw), nil); err != nil {
+		t.Fatalf("ReadModule: %v", err)
+	}
+	config := wasm.DefaultConfig()
+	config.Limits = wasm.DefaultLimits()
+	_, err := wasm.ReadModuleWithConfig(bytes.NewReader(raw), nil, config)
+	if want := (wasm.OutsizeError{ImmType: "Local", Size: wasm.MaxLocalEntryCount + 1, Max: wasm.MaxLocalEntryCount}); err != want {
+		t.Errorf("ReadModuleWithConfig: got error %v, want %v", err, want)
+	}
+}
+
+func TestDecodeModuleMalformed(t *testing.T) {
+	header := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	types := []byte{0x01, 0x04, 0x01, 0x60, 0x00, 0x00}
+	funcs := []byte{0x03, 0x02, 0x01, 0x00}
+	customs := bytes.Repeat([]byte{0x00, 0x01, 0x00}, 1025)
+
+	for _, tt := range []struct {
+		name     string
+		sections [][]byte
+		want     error
+	}{
+		{"huge count", [][]byte{{0x01, 0x06, 0xff, 0xff, 0xff, 0xff, 0x0f, 0x00}}, wasm.ErrCountTooLarge},
+		{"truncated section", [][]byte{{0x01, 0x0a, 0x01, 0x60}}, io.ErrUnexpectedEOF},
+		{"unread payload", [][]byte{{0x01, 0x02, 0x00, 0x00}}, wasm.ErrSectionSizeMismatch},
+		{"empty body", [][]byte{types, funcs, {0x0a, 0x03, 0x01, 0x01, 0x00}}, wasm.ErrFunctionNoEnd},
+		{"too many sections", [][]byte{customs}, wasm.ErrTooManySections},
+	} {
+		raw := bytes.Join(append([][]byte{header}, tt.sections...), nil)
+		if _, err := wasm.DecodeModule(bytes.NewReader(raw)); err != tt.want {
+			t.Errorf("%s: got error %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestReadModuleMissingBodies(t *testing.T) {
+	header := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	types := []byte{0x01, 0x04, 0x01, 0x60, 0x00, 0x00}
+	funcs := []byte{0x03, 0x03, 0x02, 0x00, 0x00}
+	for _, tt := range []struct {
+		name     string
+		sections [][]byte
+		want     error
+	}{
+		{"no code section", [][]byte{types, funcs}, wasm.MissingSectionError(wasm.SectionIDCode)},
+		{"missing body", [][]byte{types, funcs, {0x0a, 0x04, 0x01, 0x02, 0x00, 0x0b}}, wasm.ErrFunctionCodeMismatch},
+	} {
+		raw := bytes.Join(append([][]byte{header}, tt.sections...), nil)
+		if _, err := wasm.ReadModule(bytes.NewReader(raw), nil); err != tt.want {
+			t.Errorf("%s: got error %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package operators
+
+import (
+	"github.com/ontio/wagon/wasm"
+)
+
+// Sub-opcodes of the bulk memory operators, which follow MiscPrefix.
+var (
+	MemoryInit = newPrefixedOp(FeatureBulkMemory, MiscPrefix, 0x08, "memory.init", []wasm.ValueType{wasm.ValueTypeI32, wasm.ValueTypeI32, wasm.ValueTypeI32}, noReturn)
+	DataDrop   = newPrefixedOp(FeatureBulkMemory, MiscPrefix, 0x09, "data.drop", nil, noReturn)
+	MemoryCopy = newPrefixedOp(FeatureBulkMemory, MiscPrefix, 0x0a, "memory.copy", []wasm.ValueType{wasm.ValueTypeI32, wasm.ValueTypeI32, wasm.ValueTypeI32}, noReturn)
+	MemoryFill = newPrefixedOp(FeatureBulkMemory, MiscPrefix, 0x0b, "memory.fill", []wasm.ValueType{wasm.ValueTypeI32, wasm.ValueTypeI32, wasm.ValueTypeI32}, noReturn)
+	TableInit  = newPrefixedOp(FeatureBulkMemory, MiscPrefix, 0x0c, "table.init", []wasm.ValueType{wasm.ValueTypeI32, wasm.ValueTypeI32, wasm.ValueTypeI32}, noReturn)
+	ElemDrop   = newPrefixedOp(FeatureBulkMemory, MiscPrefix, 0x0d, "elem.drop", nil, noReturn)
+	TableCopy  = newPrefixedOp(FeatureBulkMemory, MiscPrefix, 0x0e, "table.copy", []wasm.ValueType{wasm.ValueTypeI32, wasm.ValueTypeI32, wasm.ValueTypeI32}, noReturn)
+)
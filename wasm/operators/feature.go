@@ -0,0 +1,116 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package operators
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Features is a set of post-MVP WebAssembly proposals. Operators introduced
+// by a proposal are rejected by the validator and the compiler unless the
+// proposal is enabled, so that a chain can turn them on at an upgrade height.
+// The zero value only allows MVP operators.
+type Features uint64
+
+const (
+	// FeatureSignExt enables the sign-extension operators i32.extend8_s,
+	// i32.extend16_s, i64.extend8_s, i64.extend16_s and i64.extend32_s.
+	FeatureSignExt Features = 1 << iota
+	// FeatureMultiValue enables functions returning several values and
+	// blocks whose signature is given as an index into the type section.
+	FeatureMultiValue
+	// FeatureBulkMemory enables the bulk memory operators, passive data
+	// and element segments and the data count section.
+	FeatureBulkMemory
+	// FeatureNonTrappingFloatToInt enables the saturating float-to-int
+	// conversion operators, such as i32.trunc_sat_f32_s.
+	FeatureNonTrappingFloatToInt
+	// FeatureReferenceTypes enables the funcref and externref value types,
+	// the table and reference operators, typed select, multiple tables
+	// and the element segments holding expressions.
+	FeatureReferenceTypes
+	// FeatureTailCall enables the return_call and return_call_indirect
+	// operators, which reuse the frame of the calling function.
+	FeatureTailCall
+	// FeatureSIMD enables the v128 value type and the 128-bit SIMD
+	// operators.
+	FeatureSIMD
+)
+
+var featureNames = []string{
+	"sign-extension",
+	"multi-value",
+	"bulk-memory",
+	"nontrapping-float-to-int",
+	"reference-types",
+	"tail-call",
+	"simd",
+}
+
+// Has reports whether all the features in o are enabled in f.
+func (f Features) Has(o Features) bool {
+	return f&o == o
+}
+
+func (f Features) String() string {
+	if f == 0 {
+		return "mvp"
+	}
+	var names []string
+	for i, name := range featureNames {
+		if f&(1<<uint(i)) != 0 {
+			names = append(names, name)
+		}
+	}
+	if rest := f &^ (1<<uint(len(featureNames)) - 1); rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", uint64(rest)))
+	}
+	return strings.Join(names, "|")
+}
+
+// Enabled reports whether the operator may be used when the given features
+// are enabled.
+func (o Op) Enabled(f Features) bool {
+	return f.Has(o.Feature)
+}
+
+// CheckFeatures returns a DisabledOpcodeError, or a
+// DisabledPrefixedOpcodeError for prefixed operators, if the operator may not
+// be used when the given features are enabled.
+func (o Op) CheckFeatures(f Features) error {
+	switch {
+	case o.Enabled(f):
+		return nil
+	case o.IsPrefixed():
+		return DisabledPrefixedOpcodeError{o.Code, o.Sub}
+	}
+	return DisabledOpcodeError(o.Code)
+}
+
+// withFeature marks the operator with the given opcode as belonging to the
+// proposal f.
+func withFeature(f Features, code byte) byte {
+	ops[code].Feature = f
+	return code
+}
+
+// DisabledOpcodeError is returned when an operator belongs to a proposal
+// that has not been enabled.
+type DisabledOpcodeError byte
+
+func (e DisabledOpcodeError) Error() string {
+	op := ops[byte(e)]
+	return fmt.Sprintf("Opcode %#x (%s) requires the %s feature", byte(e), op.Name, op.Feature)
+}
+
+// DisabledPrefixedOpcodeError is returned when a prefixed operator belongs to
+// a proposal that has not been enabled.
+type DisabledPrefixedOpcodeError PrefixedOpcode
+
+func (e DisabledPrefixedOpcodeError) Error() string {
+	op := prefixedOps[e.Prefix][e.Sub]
+	return fmt.Sprintf("Opcode %#x %#x (%s) requires the %s feature", e.Prefix, e.Sub, op.Name, op.Feature)
+}
@@ -15,25 +15,63 @@ import (
 var (
 	ops      [256]Op // an array of Op values mapped by wasm opcodes, used by New().
 	noReturn = wasm.ValueType(wasm.BlockTypeEmpty)
+
+	// Op values of prefixed operators, mapped by prefix byte and
+	// sub-opcode, used by NewPrefixed().
+	prefixedOps = map[byte]map[uint32]Op{}
+)
+
+// Prefix bytes of the multi-byte opcodes introduced by post-MVP proposals.
+// A prefix byte is followed by the sub-opcode of the operator, encoded as a
+// varuint32, and by the immediates of the operator.
+const (
+	MiscPrefix   byte = 0xfc // saturating truncation and bulk memory operators
+	SIMDPrefix   byte = 0xfd // 128-bit SIMD operators
+	AtomicPrefix byte = 0xfe // threads and atomics operators
 )
 
+// IsPrefix reports whether code is the prefix byte of multi-byte opcodes.
+func IsPrefix(code byte) bool {
+	switch code {
+	case MiscPrefix, SIMDPrefix, AtomicPrefix:
+		return true
+	}
+	return false
+}
+
+// PrefixedOpcode is the encoding of a prefixed operator.
+type PrefixedOpcode struct {
+	Prefix byte   // The prefix byte
+	Sub    uint32 // The sub-opcode following the prefix byte
+}
+
 // Op describes a WASM operator.
 type Op struct {
-	Code byte   // The single-byte opcode
+	Code byte   // The single-byte opcode, or the prefix byte of a prefixed operator
+	Sub  uint32 // The sub-opcode of a prefixed operator, 0 otherwise
 	Name string // The name of the operator
 
 	// Whether this operator is polymorphic.
 	// A polymorphic operator has a variable arity. call, call_indirect, and
 	// drop are examples of polymorphic operators.
 	Polymorphic bool
-	Args        []wasm.ValueType // an array of value types used by the operator as arguments, is nil for polymorphic operators
+	Args        []wasm.ValueType // an array of value types used by the operator as arguments, the topmost first, is nil for polymorphic operators
 	Returns     wasm.ValueType   // the value returned (pushed) by the operator, is 0 for polymorphic operators
+
+	// The proposal that introduced this operator, 0 for MVP operators.
+	Feature Features
 }
 
 func (o Op) IsValid() bool {
 	return o.Name != ""
 }
 
+// IsPrefixed reports whether the operator is encoded as a prefix byte
+// followed by a sub-opcode.
+func (o Op) IsPrefixed() bool {
+	return IsPrefix(o.Code)
+}
+
 func newOp(code byte, name string, args []wasm.ValueType, returns wasm.ValueType) byte {
 	if ops[code].IsValid() {
 		panic(fmt.Errorf("Opcode %#x is already assigned to %s", code, ops[code].Name))
@@ -64,6 +102,35 @@ func newPolymorphicOp(code byte, name string) byte {
 	return code
 }
 
+func newPrefixedOp(f Features, prefix byte, sub uint32, name string, args []wasm.ValueType, returns wasm.ValueType) uint32 {
+	subs, ok := prefixedOps[prefix]
+	if !ok {
+		subs = make(map[uint32]Op)
+		prefixedOps[prefix] = subs
+	}
+	if op, ok := subs[sub]; ok {
+		panic(fmt.Errorf("Opcode %#x %#x is already assigned to %s", prefix, sub, op.Name))
+	}
+
+	subs[sub] = Op{
+		Code:    prefix,
+		Sub:     sub,
+		Name:    name,
+		Args:    args,
+		Returns: returns,
+		Feature: f,
+	}
+	return sub
+}
+
+func newPrefixedPolymorphicOp(f Features, prefix byte, sub uint32, name string) uint32 {
+	newPrefixedOp(f, prefix, sub, name, nil, noReturn)
+	op := prefixedOps[prefix][sub]
+	op.Polymorphic = true
+	prefixedOps[prefix][sub] = op
+	return sub
+}
+
 type InvalidOpcodeError byte
 
 func (e InvalidOpcodeError) Error() string {
@@ -84,3 +151,22 @@ func New(code byte) (Op, error) {
 	}
 	return op, nil
 }
+
+// InvalidPrefixedOpcodeError is returned when the sub-opcode following a
+// prefix byte is unknown.
+type InvalidPrefixedOpcodeError PrefixedOpcode
+
+func (e InvalidPrefixedOpcodeError) Error() string {
+	return fmt.Sprintf("Invalid opcode: %#x %#x", e.Prefix, e.Sub)
+}
+
+// NewPrefixed returns the Op object for the operator encoded as the given
+// prefix byte followed by the sub-opcode sub.
+// If the encoding is invalid, an InvalidPrefixedOpcodeError is returned.
+func NewPrefixed(prefix byte, sub uint32) (Op, error) {
+	op, ok := prefixedOps[prefix][sub]
+	if !ok {
+		return op, InvalidPrefixedOpcodeError{prefix, sub}
+	}
+	return op, nil
+}
@@ -28,3 +28,57 @@ func TestNew(t *testing.T) {
 		t.Fatalf("0xff: operator %v is valid (should be invalid)", op2)
 	}
 }
+
+func TestFeatures(t *testing.T) {
+	op, err := New(I64Extend32S)
+	if err != nil {
+		t.Fatalf("unexpected error from New: %v", err)
+	}
+	if op.Enabled(0) {
+		t.Errorf("%s is enabled without FeatureSignExt", op.Name)
+	}
+	if !op.Enabled(FeatureSignExt) {
+		t.Errorf("%s is disabled with FeatureSignExt", op.Name)
+	}
+
+	op, err = New(I32Add)
+	if err != nil {
+		t.Fatalf("unexpected error from New: %v", err)
+	}
+	if !op.Enabled(0) {
+		t.Errorf("MVP operator %s is disabled", op.Name)
+	}
+
+	if got, want := Features(0).String(), "mvp"; got != want {
+		t.Errorf("got=%q, want=%q", got, want)
+	}
+	if got, want := (FeatureSignExt | 1<<40).String(), "sign-extension|0x10000000000"; got != want {
+		t.Errorf("got=%q, want=%q", got, want)
+	}
+}
+
+func TestNewPrefixed(t *testing.T) {
+	op, err := NewPrefixed(MiscPrefix, MemoryCopy)
+	if err != nil {
+		t.Fatalf("unexpected error from NewPrefixed: %v", err)
+	}
+	if op.Name != "memory.copy" || op.Code != MiscPrefix || op.Sub != MemoryCopy {
+		t.Fatalf("0xfc 0x0a: unexpected Op %v", op)
+	}
+	if !op.IsPrefixed() {
+		t.Errorf("%s is not prefixed", op.Name)
+	}
+	if err := op.CheckFeatures(0); err != (DisabledPrefixedOpcodeError{MiscPrefix, MemoryCopy}) {
+		t.Errorf("got error %v, want a DisabledPrefixedOpcodeError", err)
+	}
+	if err := op.CheckFeatures(FeatureBulkMemory); err != nil {
+		t.Errorf("unexpected error from CheckFeatures: %v", err)
+	}
+
+	if _, err := NewPrefixed(AtomicPrefix, MemoryCopy); err != (InvalidPrefixedOpcodeError{AtomicPrefix, MemoryCopy}) {
+		t.Errorf("0xfe 0x0a: got error %v, want an InvalidPrefixedOpcodeError", err)
+	}
+	if _, err := New(MiscPrefix); err == nil {
+		t.Errorf("0xfc: expected error while getting Op value")
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package operators
+
+import (
+	"github.com/ontio/wagon/wasm"
+)
+
+// Reference types operators, enabled by FeatureReferenceTypes. The operators
+// whose operand or result types depend on a table or on an immediate are
+// polymorphic.
+var (
+	SelectTyped = withFeature(FeatureReferenceTypes, newPolymorphicOp(0x1c, "select"))
+	TableGet    = withFeature(FeatureReferenceTypes, newPolymorphicOp(0x25, "table.get"))
+	TableSet    = withFeature(FeatureReferenceTypes, newPolymorphicOp(0x26, "table.set"))
+	RefNull     = withFeature(FeatureReferenceTypes, newPolymorphicOp(0xd0, "ref.null"))
+	RefIsNull   = withFeature(FeatureReferenceTypes, newPolymorphicOp(0xd1, "ref.is_null"))
+	RefFunc     = withFeature(FeatureReferenceTypes, newOp(0xd2, "ref.func", nil, wasm.ValueTypeFuncRef))
+)
+
+// Sub-opcodes of the table operators of the reference types proposal, which
+// follow MiscPrefix.
+var (
+	TableGrow = newPrefixedPolymorphicOp(FeatureReferenceTypes, MiscPrefix, 0x0f, "table.grow")
+	TableSize = newPrefixedOp(FeatureReferenceTypes, MiscPrefix, 0x10, "table.size", nil, wasm.ValueTypeI32)
+	TableFill = newPrefixedPolymorphicOp(FeatureReferenceTypes, MiscPrefix, 0x11, "table.fill")
+)
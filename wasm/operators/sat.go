@@ -0,0 +1,28 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package operators
+
+import (
+	"github.com/ontio/wagon/wasm"
+)
+
+func newSatConversionOp(sub uint32, name string, param, returns wasm.ValueType) uint32 {
+	return newPrefixedOp(FeatureNonTrappingFloatToInt, MiscPrefix, sub, name, []wasm.ValueType{param}, returns)
+}
+
+// Sub-opcodes of the saturating float-to-int conversion operators, which
+// follow MiscPrefix. Unlike the MVP truncation operators, they do not trap:
+// NaN is converted to 0 and out of range values to the nearest bound of the
+// integer type.
+var (
+	I32TruncSatSF32 = newSatConversionOp(0x00, "i32.trunc_sat_f32_s", wasm.ValueTypeF32, wasm.ValueTypeI32)
+	I32TruncSatUF32 = newSatConversionOp(0x01, "i32.trunc_sat_f32_u", wasm.ValueTypeF32, wasm.ValueTypeI32)
+	I32TruncSatSF64 = newSatConversionOp(0x02, "i32.trunc_sat_f64_s", wasm.ValueTypeF64, wasm.ValueTypeI32)
+	I32TruncSatUF64 = newSatConversionOp(0x03, "i32.trunc_sat_f64_u", wasm.ValueTypeF64, wasm.ValueTypeI32)
+	I64TruncSatSF32 = newSatConversionOp(0x04, "i64.trunc_sat_f32_s", wasm.ValueTypeF32, wasm.ValueTypeI64)
+	I64TruncSatUF32 = newSatConversionOp(0x05, "i64.trunc_sat_f32_u", wasm.ValueTypeF32, wasm.ValueTypeI64)
+	I64TruncSatSF64 = newSatConversionOp(0x06, "i64.trunc_sat_f64_s", wasm.ValueTypeF64, wasm.ValueTypeI64)
+	I64TruncSatUF64 = newSatConversionOp(0x07, "i64.trunc_sat_f64_u", wasm.ValueTypeF64, wasm.ValueTypeI64)
+)
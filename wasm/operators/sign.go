@@ -0,0 +1,18 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package operators
+
+import (
+	"github.com/ontio/wagon/wasm"
+)
+
+// Sign-extension operators, enabled by FeatureSignExt.
+var (
+	I32Extend8S  = withFeature(FeatureSignExt, newOp(0xc0, "i32.extend8_s", []wasm.ValueType{wasm.ValueTypeI32}, wasm.ValueTypeI32))
+	I32Extend16S = withFeature(FeatureSignExt, newOp(0xc1, "i32.extend16_s", []wasm.ValueType{wasm.ValueTypeI32}, wasm.ValueTypeI32))
+	I64Extend8S  = withFeature(FeatureSignExt, newOp(0xc2, "i64.extend8_s", []wasm.ValueType{wasm.ValueTypeI64}, wasm.ValueTypeI64))
+	I64Extend16S = withFeature(FeatureSignExt, newOp(0xc3, "i64.extend16_s", []wasm.ValueType{wasm.ValueTypeI64}, wasm.ValueTypeI64))
+	I64Extend32S = withFeature(FeatureSignExt, newOp(0xc4, "i64.extend32_s", []wasm.ValueType{wasm.ValueTypeI64}, wasm.ValueTypeI64))
+)
@@ -0,0 +1,11 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package operators
+
+// Tail call operators, enabled by FeatureTailCall.
+var (
+	ReturnCall         = withFeature(FeatureTailCall, newPolymorphicOp(0x12, "return_call"))
+	ReturnCallIndirect = withFeature(FeatureTailCall, newPolymorphicOp(0x13, "return_call_indirect"))
+)
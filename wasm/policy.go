@@ -0,0 +1,31 @@
+// Copyright 2017 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wasm
+
+import "errors"
+
+// ErrStartNotAllowed is returned when a module has a start function, which
+// its Policy does not allow.
+var ErrStartNotAllowed = errors.New("start entry is not supported in smart contract")
+
+// Policy selects the restrictions applied to modules on top of the
+// WebAssembly specification. Its zero value applies every restriction,
+// which suits smart contracts, and is the policy used by ReadModule.
+//
+// The restrictions checked when deploying a module are those of
+// validate.Policy, from which the Policy given to ReadModuleWithPolicy and
+// exec.CompileModuleWithPolicy is derived by its WasmPolicy method.
+type Policy struct {
+	// AllowStart accepts modules with a start function.
+	AllowStart bool
+}
+
+// Check returns an error if m breaks a restriction of p.
+func (p Policy) Check(m *Module) error {
+	if m.Start != nil && !p.AllowStart {
+		return ErrStartNotAllowed
+	}
+	return nil
+}
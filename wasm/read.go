@@ -17,6 +17,10 @@ import (
 // to avoid memory attack
 const maxInitialCap = 10 * 1024
 
+// ErrCountTooLarge is returned when the number of entries of a vector
+// exceeds the number of bytes left to read them from.
+var ErrCountTooLarge = errors.New("wasm: vector length exceeds the remaining bytes")
+
 func getInitialCap(count uint32) uint32 {
 	if count > maxInitialCap {
 		return maxInitialCap
@@ -24,10 +28,37 @@ func getInitialCap(count uint32) uint32 {
 	return count
 }
 
+// remaining returns the number of bytes left in r, if r is a bytes.Reader,
+// a bytes.Buffer or another reader with a Len method.
+func remaining(r io.Reader) (int, bool) {
+	l, ok := r.(interface{ Len() int })
+	if !ok {
+		return 0, false
+	}
+	return l.Len(), true
+}
+
+// readCount reads the number of entries of a vector. Every entry takes at
+// least a byte, so a count exceeding the bytes left in r is rejected before
+// anything is allocated for the entries.
+func readCount(r io.Reader) (uint32, error) {
+	n, err := leb128.ReadVarUint32(r)
+	if err != nil {
+		return 0, err
+	}
+	if left, ok := remaining(r); ok && uint64(n) > uint64(left) {
+		return 0, ErrCountTooLarge
+	}
+	return n, nil
+}
+
 func readBytes(r io.Reader, n uint32) ([]byte, error) {
 	if n == 0 {
 		return nil, nil
 	}
+	if left, ok := remaining(r); ok && uint64(n) > uint64(left) {
+		return nil, io.ErrUnexpectedEOF
+	}
 	limited := io.LimitReader(r, int64(n))
 	buf := &bytes.Buffer{}
 	num, _ := buf.ReadFrom(limited)
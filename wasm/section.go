@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"reflect"
 	"sort"
 
 	"github.com/ontio/wagon/wasm/internal/readpos"
@@ -45,22 +46,27 @@ const (
 	SectionIDElement  SectionID = 9
 	SectionIDCode     SectionID = 10
 	SectionIDData     SectionID = 11
+	// SectionIDDataCount is the ID of the data count section introduced by
+	// the bulk memory proposal. It appears between the element and the code
+	// sections.
+	SectionIDDataCount SectionID = 12
 )
 
 func (s SectionID) String() string {
 	n, ok := map[SectionID]string{
-		SectionIDCustom:   "custom",
-		SectionIDType:     "type",
-		SectionIDImport:   "import",
-		SectionIDFunction: "function",
-		SectionIDTable:    "table",
-		SectionIDMemory:   "memory",
-		SectionIDGlobal:   "global",
-		SectionIDExport:   "export",
-		SectionIDStart:    "start",
-		SectionIDElement:  "element",
-		SectionIDCode:     "code",
-		SectionIDData:     "data",
+		SectionIDCustom:    "custom",
+		SectionIDType:      "type",
+		SectionIDImport:    "import",
+		SectionIDFunction:  "function",
+		SectionIDTable:     "table",
+		SectionIDMemory:    "memory",
+		SectionIDGlobal:    "global",
+		SectionIDExport:    "export",
+		SectionIDStart:     "start",
+		SectionIDElement:   "element",
+		SectionIDCode:      "code",
+		SectionIDData:      "data",
+		SectionIDDataCount: "data_count",
 	}[s]
 	if !ok {
 		return "unknown"
@@ -99,6 +105,22 @@ func (e InvalidCodeIndexError) Error() string {
 
 var ErrUnsupportedSection = errors.New("wasm: unsupported section")
 
+// ErrSectionSizeMismatch is returned when the payload of a section is
+// shorter than the size of the section.
+var ErrSectionSizeMismatch = errors.New("wasm: section size mismatch")
+
+// ErrTooManySections is returned when a module has more than maxSections
+// sections.
+var ErrTooManySections = errors.New("wasm: too many sections")
+
+// maxSections is the maximum number of sections of a module. Only custom
+// sections may occur more than once.
+const maxSections = 1024
+
+// ErrFunctionCodeMismatch is returned when the function section declares a
+// different number of functions than the code section has bodies.
+var ErrFunctionCodeMismatch = errors.New("wasm: the number of entries in the function and code section are unequal")
+
 type MissingSectionError SectionID
 
 func (e MissingSectionError) Error() string {
@@ -106,10 +128,19 @@ func (e MissingSectionError) Error() string {
 }
 
 type sectionsReader struct {
-	lastSecOrder uint8 // previous non-custom sectionid
+	lastSecOrder int // order of the previous non-custom section
 	m            *Module
 }
 
+// sectionOrder returns the rank of the section with the given ID in the order
+// prescribed for the sections of a module.
+func sectionOrder(id SectionID) int {
+	if id == SectionIDDataCount {
+		return int(SectionIDElement)*2 + 1
+	}
+	return int(id) * 2
+}
+
 func newSectionsReader(m *Module) *sectionsReader {
 	return &sectionsReader{m: m}
 }
@@ -138,11 +169,14 @@ func (sr *sectionsReader) readSection(r *readpos.ReadPos) (bool, error) {
 	} else if err != nil {
 		return false, err
 	}
+	if len(m.Sections) >= maxSections {
+		return false, ErrTooManySections
+	}
 	if id != uint8(SectionIDCustom) {
-		if id <= sr.lastSecOrder {
+		if sectionOrder(SectionID(id)) <= sr.lastSecOrder {
 			return false, fmt.Errorf("wasm: sections must occur at most once and in the prescribed order")
 		}
-		sr.lastSecOrder = id
+		sr.lastSecOrder = sectionOrder(SectionID(id))
 	}
 
 	s := RawSection{ID: SectionID(id)}
@@ -158,10 +192,13 @@ func (sr *sectionsReader) readSection(r *readpos.ReadPos) (bool, error) {
 
 	s.Start = r.CurPos
 
-	sectionBytes := new(bytes.Buffer)
-
-	sectionBytes.Grow(int(getInitialCap(payloadDataLen)))
-	sectionReader := io.LimitReader(io.TeeReader(r, sectionBytes), int64(payloadDataLen))
+	// The payload is read first, so that its entries can be checked
+	// against its actual size.
+	payload, err := readBytes(r, payloadDataLen)
+	if err != nil {
+		return false, err
+	}
+	sectionReader := bytes.NewReader(payload)
 
 	var sec Section
 	switch s.ID {
@@ -206,6 +243,10 @@ func (sr *sectionsReader) readSection(r *readpos.ReadPos) (bool, error) {
 		logger.Println("section element")
 		m.Elements = &SectionElements{}
 		sec = m.Elements
+	case SectionIDDataCount:
+		logger.Println("section data count")
+		m.DataCount = &SectionDataCount{}
+		sec = m.DataCount
 	case SectionIDCode:
 		logger.Println("section code")
 		m.Code = &SectionCode{}
@@ -222,8 +263,11 @@ func (sr *sectionsReader) readSection(r *readpos.ReadPos) (bool, error) {
 		logger.Println(err)
 		return false, err
 	}
+	if sectionReader.Len() != 0 {
+		return false, ErrSectionSizeMismatch
+	}
 	s.End = r.CurPos
-	s.Bytes = sectionBytes.Bytes()
+	s.Bytes = payload
 	*sec.GetRawSection() = s
 	switch s.ID {
 	case SectionIDCode:
@@ -232,7 +276,7 @@ func (sr *sectionsReader) readSection(r *readpos.ReadPos) (bool, error) {
 			return false, MissingSectionError(SectionIDFunction)
 		}
 		if len(m.Function.Types) != len(s.Bodies) {
-			return false, errors.New("wasm: the number of entries in the function and code section are unequal")
+			return false, ErrFunctionCodeMismatch
 		}
 		if m.Types == nil {
 			return false, MissingSectionError(SectionIDType)
@@ -292,7 +336,7 @@ func (*SectionTypes) SectionID() SectionID {
 }
 
 func (s *SectionTypes) ReadPayload(r io.Reader) error {
-	count, err := leb128.ReadVarUint32(r)
+	count, err := readCount(r)
 	if err != nil {
 		return err
 	}
@@ -334,7 +378,7 @@ func (*SectionImports) SectionID() SectionID {
 }
 
 func (s *SectionImports) ReadPayload(r io.Reader) error {
-	count, err := leb128.ReadVarUint32(r)
+	count, err := readCount(r)
 	if err != nil {
 		return err
 	}
@@ -442,7 +486,7 @@ func (*SectionFunctions) SectionID() SectionID {
 }
 
 func (s *SectionFunctions) ReadPayload(r io.Reader) error {
-	count, err := leb128.ReadVarUint32(r)
+	count, err := readCount(r)
 	if err != nil {
 		return err
 	}
@@ -480,7 +524,7 @@ func (*SectionTables) SectionID() SectionID {
 }
 
 func (s *SectionTables) ReadPayload(r io.Reader) error {
-	count, err := leb128.ReadVarUint32(r)
+	count, err := readCount(r)
 	if err != nil {
 		return err
 	}
@@ -519,7 +563,7 @@ func (*SectionMemories) SectionID() SectionID {
 }
 
 func (s *SectionMemories) ReadPayload(r io.Reader) error {
-	count, err := leb128.ReadVarUint32(r)
+	count, err := readCount(r)
 	if err != nil {
 		return err
 	}
@@ -557,7 +601,7 @@ func (*SectionGlobals) SectionID() SectionID {
 }
 
 func (s *SectionGlobals) ReadPayload(r io.Reader) error {
-	count, err := leb128.ReadVarUint32(r)
+	count, err := readCount(r)
 	if err != nil {
 		return err
 	}
@@ -623,7 +667,7 @@ func (*SectionExports) SectionID() SectionID {
 }
 
 func (s *SectionExports) ReadPayload(r io.Reader) error {
-	count, err := leb128.ReadVarUint32(r)
+	count, err := readCount(r)
 	if err != nil {
 		return err
 	}
@@ -738,7 +782,7 @@ func (*SectionElements) SectionID() SectionID {
 }
 
 func (s *SectionElements) ReadPayload(r io.Reader) error {
-	count, err := leb128.ReadVarUint32(r)
+	count, err := readCount(r)
 	if err != nil {
 		return err
 	}
@@ -766,27 +810,163 @@ func (s *SectionElements) WritePayload(w io.Writer) error {
 	return nil
 }
 
+// Flags of the segments of the data and element sections, as defined by the
+// bulk memory proposal. Element segments may also combine them with
+// segmentExprs, as defined by the reference types proposal.
+const (
+	segmentActive         = 0x00 // active segment of table or memory 0
+	segmentPassive        = 0x01 // passive segment
+	segmentActiveExplicit = 0x02 // active segment with an explicit table or memory index
+	segmentDeclarative    = 0x03 // declarative element segment
+	segmentExprs          = 0x04 // element segment holding expressions
+)
+
+// elemKindFuncRef is the only element kind of the element segments holding
+// function indices.
+const elemKindFuncRef = 0x00
+
+// InvalidSegmentFlagsError is returned when a data or element segment has
+// unsupported flags.
+type InvalidSegmentFlagsError uint32
+
+func (e InvalidSegmentFlagsError) Error() string {
+	return fmt.Sprintf("wasm: invalid segment flags: %#x", uint32(e))
+}
+
+// InvalidElemKindError is returned when an element segment has an unknown
+// element kind.
+type InvalidElemKindError uint8
+
+func (e InvalidElemKindError) Error() string {
+	return fmt.Sprintf("wasm: invalid element kind: %#x", uint8(e))
+}
+
+// readSegmentHeader reads the flags of a data segment, followed by the index
+// and offset of active segments.
+func readSegmentHeader(r io.Reader) (index uint32, offset []byte, passive bool, err error) {
+	flags, err := leb128.ReadVarUint32(r)
+	if err != nil {
+		return
+	}
+	switch flags {
+	case segmentActive:
+	case segmentPassive:
+		passive = true
+		return
+	case segmentActiveExplicit:
+		if index, err = leb128.ReadVarUint32(r); err != nil {
+			return
+		}
+	default:
+		err = InvalidSegmentFlagsError(flags)
+		return
+	}
+	offset, err = readInitExpr(r)
+	return
+}
+
+// writeSegmentHeader writes the flags of a data segment, followed by the
+// index and offset of active segments.
+func writeSegmentHeader(w io.Writer, index uint32, offset []byte, passive bool) (err error) {
+	var flags uint32
+	switch {
+	case passive:
+		flags = segmentPassive
+	case index != 0:
+		flags = segmentActiveExplicit
+	}
+	if _, err = leb128.WriteVarUint32(w, flags); err != nil {
+		return
+	}
+	if passive {
+		return
+	}
+	if flags == segmentActiveExplicit {
+		if _, err = leb128.WriteVarUint32(w, index); err != nil {
+			return
+		}
+	}
+	_, err = w.Write(offset)
+	return
+}
+
 // ElementSegment describes a group of repeated elements that begin at a specified offset
 type ElementSegment struct {
 	Index  uint32 // The index into the global table space, should always be 0 in the MVP.
 	Offset []byte // initializer expression for computing the offset for placing elements, should return an i32 value
 	Elems  []uint32
+	// Passive segments are not copied into a table when the module is
+	// instantiated, but by the table.init operator. Their Offset is nil.
+	Passive bool
+	// Declarative segments are neither copied into a table nor available
+	// to table.init. They declare the functions referenced by ref.func.
+	// Their Offset is nil.
+	Declarative bool
+	// Type is the type of the elements. Only segments holding expressions
+	// may have elements of type ElemTypeExternRef.
+	Type ElemType
+	// Exprs holds the initializer expressions of the elements, either
+	// ref.func or ref.null, if the segment is encoded with expressions
+	// instead of function indices. Elems is nil in this case.
+	Exprs [][]byte
 }
 
 func (s *ElementSegment) UnmarshalWASM(r io.Reader) error {
-	var err error
-
-	if s.Index, err = leb128.ReadVarUint32(r); err != nil {
+	flags, err := leb128.ReadVarUint32(r)
+	if err != nil {
 		return err
 	}
-	if s.Offset, err = readInitExpr(r); err != nil {
-		return err
+	if flags > segmentDeclarative|segmentExprs {
+		return InvalidSegmentFlagsError(flags)
+	}
+	switch flags &^ segmentExprs {
+	case segmentActiveExplicit:
+		if s.Index, err = leb128.ReadVarUint32(r); err != nil {
+			return err
+		}
+		fallthrough
+	case segmentActive:
+		if s.Offset, err = readInitExpr(r); err != nil {
+			return err
+		}
+	case segmentPassive:
+		s.Passive = true
+	case segmentDeclarative:
+		s.Declarative = true
+	}
+
+	s.Type = ElemTypeAnyFunc
+	if flags&^segmentExprs != segmentActive {
+		if flags&segmentExprs != 0 {
+			if err := s.Type.UnmarshalWASM(r); err != nil {
+				return err
+			}
+		} else {
+			kind, err := ReadByte(r)
+			if err != nil {
+				return err
+			}
+			if kind != elemKindFuncRef {
+				return InvalidElemKindError(kind)
+			}
+		}
 	}
 
-	numElems, err := leb128.ReadVarUint32(r)
+	numElems, err := readCount(r)
 	if err != nil {
 		return err
 	}
+	if flags&segmentExprs != 0 {
+		s.Exprs = make([][]byte, 0, getInitialCap(numElems))
+		for i := uint32(0); i < numElems; i++ {
+			expr, err := readInitExpr(r)
+			if err != nil {
+				return err
+			}
+			s.Exprs = append(s.Exprs, expr)
+		}
+		return nil
+	}
 	s.Elems = make([]uint32, 0, getInitialCap(numElems))
 	for i := uint32(0); i < numElems; i++ {
 		e, err := leb128.ReadVarUint32(r)
@@ -799,14 +979,65 @@ func (s *ElementSegment) UnmarshalWASM(r io.Reader) error {
 	return nil
 }
 
+// ElemType returns the type of the elements of the segment, which is
+// ElemTypeAnyFunc if Type is not set.
+func (s *ElementSegment) ElemType() ElemType {
+	if s.Type == 0 {
+		return ElemTypeAnyFunc
+	}
+	return s.Type
+}
+
 func (s *ElementSegment) MarshalWASM(w io.Writer) error {
-	if _, err := leb128.WriteVarUint32(w, s.Index); err != nil {
+	typ := s.ElemType()
+	var flags uint32
+	switch {
+	case s.Declarative:
+		flags = segmentDeclarative
+	case s.Passive:
+		flags = segmentPassive
+	case s.Index != 0 || typ != ElemTypeAnyFunc:
+		flags = segmentActiveExplicit
+	}
+	if s.Exprs != nil {
+		flags |= segmentExprs
+	}
+	if _, err := leb128.WriteVarUint32(w, flags); err != nil {
 		return err
 	}
-	if _, err := w.Write(s.Offset); err != nil {
-		return err
+
+	switch flags &^ segmentExprs {
+	case segmentActiveExplicit:
+		if _, err := leb128.WriteVarUint32(w, s.Index); err != nil {
+			return err
+		}
+		fallthrough
+	case segmentActive:
+		if _, err := w.Write(s.Offset); err != nil {
+			return err
+		}
+	}
+	if flags&^segmentExprs != segmentActive {
+		kind := byte(elemKindFuncRef)
+		if s.Exprs != nil {
+			kind = byte(typ)
+		}
+		if _, err := w.Write([]byte{kind}); err != nil {
+			return err
+		}
 	}
 
+	if s.Exprs != nil {
+		if _, err := leb128.WriteVarUint32(w, uint32(len(s.Exprs))); err != nil {
+			return err
+		}
+		for _, expr := range s.Exprs {
+			if _, err := w.Write(expr); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	if _, err := leb128.WriteVarUint32(w, uint32(len(s.Elems))); err != nil {
 		return err
 	}
@@ -818,6 +1049,54 @@ func (s *ElementSegment) MarshalWASM(w io.Writer) error {
 	return nil
 }
 
+// Entries returns the elements of the segment as table entries. The
+// elements initialized by ref.null are uninitialized entries.
+func (s *ElementSegment) Entries(m *Module) ([]TableEntry, error) {
+	if s.Exprs == nil {
+		entries := make([]TableEntry, len(s.Elems))
+		for i, index := range s.Elems {
+			entries[i] = TableEntry{Index: index, Initialized: true}
+		}
+		return entries, nil
+	}
+	entries := make([]TableEntry, len(s.Exprs))
+	for i, expr := range s.Exprs {
+		val, err := m.ExecInitExpr(expr)
+		if err != nil {
+			return nil, err
+		}
+		entry, ok := val.(TableEntry)
+		if !ok {
+			return nil, InvalidValueTypeInitExprError{reflect.Struct, reflect.ValueOf(val).Kind()}
+		}
+		entries[i] = entry
+	}
+	return entries, nil
+}
+
+// SectionDataCount holds the number of segments of the data section. It
+// allows the memory.init and data.drop operators to be validated before the
+// data section is read.
+type SectionDataCount struct {
+	RawSection
+	Count uint32
+}
+
+func (*SectionDataCount) SectionID() SectionID {
+	return SectionIDDataCount
+}
+
+func (s *SectionDataCount) ReadPayload(r io.Reader) error {
+	var err error
+	s.Count, err = leb128.ReadVarUint32(r)
+	return err
+}
+
+func (s *SectionDataCount) WritePayload(w io.Writer) error {
+	_, err := leb128.WriteVarUint32(w, s.Count)
+	return err
+}
+
 // SectionCode describes the body for every function declared inside a module.
 type SectionCode struct {
 	RawSection
@@ -829,7 +1108,7 @@ func (*SectionCode) SectionID() SectionID {
 }
 
 func (s *SectionCode) ReadPayload(r io.Reader) error {
-	count, err := leb128.ReadVarUint32(r)
+	count, err := readCount(r)
 	if err != nil {
 		return err
 	}
@@ -881,7 +1160,7 @@ func (f *FunctionBody) UnmarshalWASM(r io.Reader) error {
 
 	bytesReader := bytes.NewBuffer(body)
 
-	localCount, err := leb128.ReadVarUint32(bytesReader)
+	localCount, err := readCount(bytesReader)
 	if err != nil {
 		return err
 	}
@@ -900,7 +1179,7 @@ func (f *FunctionBody) UnmarshalWASM(r io.Reader) error {
 	code := bytesReader.Bytes()
 	logger.Printf("Read %d bytes for function body", len(code))
 
-	if code[len(code)-1] != end {
+	if len(code) == 0 || code[len(code)-1] != end {
 		return ErrFunctionNoEnd
 	}
 
@@ -968,7 +1247,7 @@ func (*SectionData) SectionID() SectionID {
 }
 
 func (s *SectionData) ReadPayload(r io.Reader) error {
-	count, err := leb128.ReadVarUint32(r)
+	count, err := readCount(r)
 	if err != nil {
 		return err
 	}
@@ -1000,15 +1279,16 @@ type DataSegment struct {
 	Index  uint32 // The index into the global linear memory space, should always be 0 in the MVP.
 	Offset []byte // initializer expression for computing the offset for placing elements, should return an i32 value
 	Data   []byte
+	// Passive segments are not copied into the linear memory when the
+	// module is instantiated, but by the memory.init operator. Their
+	// Offset is nil.
+	Passive bool
 }
 
 func (s *DataSegment) UnmarshalWASM(r io.Reader) error {
 	var err error
 
-	if s.Index, err = leb128.ReadVarUint32(r); err != nil {
-		return err
-	}
-	if s.Offset, err = readInitExpr(r); err != nil {
+	if s.Index, s.Offset, s.Passive, err = readSegmentHeader(r); err != nil {
 		return err
 	}
 	s.Data, err = readBytesUint(r)
@@ -1016,10 +1296,7 @@ func (s *DataSegment) UnmarshalWASM(r io.Reader) error {
 }
 
 func (s *DataSegment) MarshalWASM(w io.Writer) error {
-	if _, err := leb128.WriteVarUint32(w, s.Index); err != nil {
-		return err
-	}
-	if _, err := w.Write(s.Offset); err != nil {
+	if err := writeSegmentHeader(w, s.Index, s.Offset, s.Passive); err != nil {
 		return err
 	}
 	return writeBytesUint(w, s.Data)
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 
 	"github.com/ontio/wagon/wasm/leb128"
 )
@@ -28,15 +29,25 @@ type ValueType uint8
 const (
 	ValueTypeI32 ValueType = 0x7f
 	ValueTypeI64 ValueType = 0x7e
-	//ValueTypeF32 ValueType = 0x7d
-	//ValueTypeF64 ValueType = 0x7c
+	ValueTypeF32 ValueType = 0x7d
+	ValueTypeF64 ValueType = 0x7c
+
+	// Reference types, introduced by the reference types proposal.
+	ValueTypeFuncRef   ValueType = 0x70
+	ValueTypeExternRef ValueType = 0x6f
+
+	// ValueTypeV128 is the 128-bit vector type of the SIMD proposal.
+	ValueTypeV128 ValueType = 0x7b
 )
 
 var valueTypeStrMap = map[ValueType]string{
-	ValueTypeI32: "i32",
-	ValueTypeI64: "i64",
-	//ValueTypeF32: "f32",
-	//ValueTypeF64: "f64",
+	ValueTypeI32:       "i32",
+	ValueTypeI64:       "i64",
+	ValueTypeF32:       "f32",
+	ValueTypeF64:       "f64",
+	ValueTypeFuncRef:   "funcref",
+	ValueTypeExternRef: "externref",
+	ValueTypeV128:      "v128",
 }
 
 func (t ValueType) String() string {
@@ -47,6 +58,11 @@ func (t ValueType) String() string {
 	return str
 }
 
+// IsRef reports whether t is a reference type.
+func (t ValueType) IsRef() bool {
+	return t == ValueTypeFuncRef || t == ValueTypeExternRef
+}
+
 // TypeFunc represents the value type of a function
 const TypeFunc uint8 = 0x60
 
@@ -75,17 +91,99 @@ func (b BlockType) String() string {
 	return ValueType(b).String()
 }
 
+// BlockTypeIndex is the signature of a structured block given as an index
+// into the type section, as introduced by the multi-value proposal. Such a
+// block may take parameters and return several values.
+type BlockTypeIndex uint32
+
+func (b BlockTypeIndex) String() string {
+	return fmt.Sprintf("<block type %d>", uint32(b))
+}
+
+// InvalidBlockTypeError is returned when a block type is neither empty, a
+// value type nor the index of a function signature.
+type InvalidBlockTypeError int64
+
+func (e InvalidBlockTypeError) Error() string {
+	return fmt.Sprintf("wasm: invalid block type %d", int64(e))
+}
+
+// ReadBlockType reads the signature of a block, loop or if operator, which is
+// encoded as a signed 33 bit integer. It returns a BlockType for the empty
+// block type and value types, and a BlockTypeIndex otherwise.
+func ReadBlockType(r io.Reader) (interface{}, error) {
+	b, err := ReadByte(r)
+	if err != nil {
+		return nil, err
+	}
+	if b&0xc0 == 0x40 {
+		// A negative number encoded in a single byte.
+		return BlockType(b), nil
+	}
+	v := int64(b & 0x7f)
+	for shift := uint(7); b&0x80 != 0; shift += 7 {
+		if shift >= 35 {
+			return nil, InvalidBlockTypeError(v)
+		}
+		if b, err = ReadByte(r); err != nil {
+			return nil, err
+		}
+		v |= int64(b&0x7f) << shift
+	}
+	if b&0x40 != 0 || v > math.MaxUint32 {
+		return nil, InvalidBlockTypeError(v)
+	}
+	return BlockTypeIndex(v), nil
+}
+
+// WriteBlockType writes the block type bt, a BlockType or a BlockTypeIndex.
+func WriteBlockType(w io.Writer, bt interface{}) error {
+	switch bt := bt.(type) {
+	case BlockType:
+		return writeByte(w, byte(bt))
+	case BlockTypeIndex:
+		_, err := w.Write(leb128.AppendSleb128(nil, int64(bt)))
+		return err
+	}
+	return fmt.Errorf("wasm: invalid block type %v", bt)
+}
+
+// BlockSig returns the signature of a block whose type is bt, a BlockType or
+// a BlockTypeIndex into the type section of m.
+func (m *Module) BlockSig(bt interface{}) (*FunctionSig, error) {
+	switch bt := bt.(type) {
+	case BlockType:
+		switch ValueType(bt) {
+		case ValueType(BlockTypeEmpty):
+			return &FunctionSig{Form: TypeFunc}, nil
+		case ValueTypeI32, ValueTypeI64, ValueTypeF32, ValueTypeF64, ValueTypeFuncRef, ValueTypeExternRef, ValueTypeV128:
+			return &FunctionSig{Form: TypeFunc, ReturnTypes: []ValueType{ValueType(bt)}}, nil
+		}
+		return nil, InvalidBlockTypeError(int8(bt<<1) >> 1)
+	case BlockTypeIndex:
+		if m.Types == nil || int(bt) >= len(m.Types.Entries) {
+			return nil, InvalidBlockTypeError(bt)
+		}
+		return &m.Types.Entries[bt], nil
+	}
+	return nil, fmt.Errorf("wasm: invalid block type %v", bt)
+}
+
 // ElemType describes the type of a table's elements
 type ElemType uint8 // varint7
 // ElemTypeAnyFunc descibres an any_func value
 const ElemTypeAnyFunc ElemType = 0x70
 
+// ElemTypeExternRef describes an externref value, as allowed by the
+// reference types proposal.
+const ElemTypeExternRef ElemType = 0x6f
+
 func (t *ElemType) UnmarshalWASM(r io.Reader) error {
 	b, err := ReadByte(r)
 	if err != nil {
 		return err
 	}
-	if b != uint8(ElemTypeAnyFunc) {
+	if b != uint8(ElemTypeAnyFunc) && b != uint8(ElemTypeExternRef) {
 		return fmt.Errorf("wasm: unsupported elem type:%d", b)
 	}
 	*t = ElemType(b)
@@ -96,9 +194,18 @@ func (t ElemType) MarshalWASM(w io.Writer) error {
 	return writeByte(w, byte(t))
 }
 
+// ValueType returns the type of the references held by a table of
+// elements of type t.
+func (t ElemType) ValueType() ValueType {
+	return ValueType(t)
+}
+
 func (t ElemType) String() string {
-	if t == ElemTypeAnyFunc {
+	switch t {
+	case ElemTypeAnyFunc:
 		return "anyfunc"
+	case ElemTypeExternRef:
+		return "externref"
 	}
 
 	return "<unknown elem_type>"
@@ -136,7 +243,7 @@ func (f *FunctionSig) UnmarshalWASM(r io.Reader) error {
 	}
 	f.Form = uint8(form)
 
-	paramCount, err := leb128.ReadVarUint32(r)
+	paramCount, err := readCount(r)
 	if err != nil {
 		return err
 	}
@@ -150,7 +257,7 @@ func (f *FunctionSig) UnmarshalWASM(r io.Reader) error {
 		f.ParamTypes = append(f.ParamTypes, v)
 	}
 
-	returnCount, err := leb128.ReadVarUint32(r)
+	returnCount, err := readCount(r)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,1249 @@
+// Copyright 2018 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wast
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/bits"
+	"strings"
+
+	"github.com/ontio/wagon/disasm"
+	"github.com/ontio/wagon/wasm"
+	"github.com/ontio/wagon/wasm/operators"
+)
+
+// Parse reads a module in the WebAssembly text format from r, with or
+// without the enclosing (module ...). Operators may be named as in the
+// current specification, or as written by WriteTo, such as get_local.
+// The module is returned as decoded from its binary encoding, so that it
+// round-trips through wasm.EncodeModule; its imports are not resolved.
+// The errors in the text are returned as a SyntaxError.
+func Parse(r io.Reader) (*wasm.Module, error) {
+	src, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	m, err := parseModule(string(src))
+	if err != nil {
+		return nil, err
+	}
+	buf := new(bytes.Buffer)
+	if err := wasm.EncodeModule(buf, m); err != nil {
+		return nil, err
+	}
+	return wasm.DecodeModule(buf)
+}
+
+func parseModule(src string) (m *wasm.Module, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			serr, ok := e.(SyntaxError)
+			if !ok {
+				panic(e)
+			}
+			err = serr
+		}
+	}()
+	p := &parser{
+		types:   newSpace("type"),
+		funcs:   newSpace("function"),
+		tables:  newSpace("table"),
+		mems:    newSpace("memory"),
+		globals: newSpace("global"),
+		elems:   newSpace("element segment"),
+		datas:   newSpace("data segment"),
+		exports: &wasm.SectionExports{Entries: make(map[string]wasm.ExportEntry)},
+	}
+	p.toks = scan(src)
+	return p.module(), nil
+}
+
+// space is an index space of a module, or the locals of a function. It
+// maps the identifiers of its entries to their indices.
+type space struct {
+	kind    string
+	names   map[string]uint32
+	len     uint32 // number of entries declared by the first pass
+	defined bool   // whether an entry is defined rather than imported
+	next    uint32 // index of the next entry parsed by the second pass
+}
+
+func newSpace(kind string) *space {
+	return &space{kind: kind, names: make(map[string]uint32)}
+}
+
+func (s *space) nextIndex() uint32 {
+	s.next++
+	return s.next - 1
+}
+
+// parser builds a module from its tokens in two passes. The first one
+// declares the entries of the index spaces, so that the second one may
+// resolve the identifiers of entries declared further in the text.
+type parser struct {
+	toks []token
+	pos  int
+
+	types, funcs, tables, mems, globals, elems, datas *space
+
+	sigs      []wasm.FunctionSig
+	imports   []wasm.ImportEntry
+	functions []uint32
+	tabs      []wasm.Table
+	memories  []wasm.Memory
+	vars      []wasm.GlobalEntry
+	exports   *wasm.SectionExports
+	start     *wasm.SectionStartFunction
+	elemSegs  []wasm.ElementSegment
+	dataSegs  []wasm.DataSegment
+	dataCount bool // whether the code refers to data segments
+	bodies    []wasm.FunctionBody
+
+	// State of the function or expression being parsed.
+	locals *space
+	labels []string // identifiers of the enclosing blocks, the innermost last
+	code   []disasm.Instr
+}
+
+func (p *parser) errorf(t token, format string, args ...interface{}) {
+	panic(SyntaxError{Line: t.line, Column: t.col, Msg: fmt.Sprintf(format, args...)})
+}
+
+func (p *parser) peek() token {
+	return p.peekAt(0)
+}
+
+// peekAt returns the token n tokens after the current one, or the final
+// tokenEOF if there are not as many.
+func (p *parser) peekAt(n int) token {
+	if p.pos+n >= len(p.toks) {
+		return p.toks[len(p.toks)-1]
+	}
+	return p.toks[p.pos+n]
+}
+
+func (p *parser) next() token {
+	t := p.toks[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) expect(kind tokenKind) token {
+	t := p.next()
+	if t.kind != kind {
+		p.errorf(t, "expected %v, got %v", kind, t)
+	}
+	return t
+}
+
+func (p *parser) peekKeyword(kw string) bool {
+	t := p.peek()
+	return t.kind == tokenKeyword && t.text == kw
+}
+
+// peekField reports whether the next tokens open the field, or the folded
+// instruction, kw.
+func (p *parser) peekField(kw string) bool {
+	t := p.peekAt(1)
+	return p.peek().kind == tokenLParen && t.kind == tokenKeyword && t.text == kw
+}
+
+func (p *parser) keyword(kw string) {
+	if t := p.next(); t.kind != tokenKeyword || t.text != kw {
+		p.errorf(t, "expected %s, got %v", kw, t)
+	}
+}
+
+func (p *parser) open(kw string) {
+	p.expect(tokenLParen)
+	p.keyword(kw)
+}
+
+func (p *parser) close() {
+	p.expect(tokenRParen)
+}
+
+// skip skips the parenthesized tokens starting at the current position.
+func (p *parser) skip() {
+	p.expect(tokenLParen)
+	for depth := 1; depth > 0; {
+		switch t := p.next(); t.kind {
+		case tokenLParen:
+			depth++
+		case tokenRParen:
+			depth--
+		case tokenEOF:
+			p.errorf(t, "unexpected %v", t)
+		}
+	}
+}
+
+// id returns the optional identifier at the current position, or a
+// tokenEOF if there is none.
+func (p *parser) id() token {
+	if p.peek().kind == tokenID {
+		return p.next()
+	}
+	return token{}
+}
+
+func (p *parser) u32() uint32 {
+	t := p.next()
+	v, ok := parseUint(t.text, 32)
+	if t.kind != tokenReserved || !ok {
+		p.errorf(t, "expected an unsigned 32-bit integer, got %v", t)
+	}
+	return uint32(v)
+}
+
+func (p *parser) strings() []byte {
+	var b []byte
+	for p.peek().kind == tokenString {
+		b = append(b, p.next().text...)
+	}
+	return b
+}
+
+func isIndex(t token) bool {
+	return t.kind == tokenID || t.kind == tokenReserved && t.text[0] >= '0' && t.text[0] <= '9'
+}
+
+// index returns the index of the entry of s given at the current position
+// by its index or identifier.
+func (p *parser) index(s *space) uint32 {
+	t := p.peek()
+	if t.kind != tokenID {
+		if !isIndex(t) {
+			p.errorf(t, "expected a %s index, got %v", s.kind, t)
+		}
+		return p.u32()
+	}
+	p.next()
+	idx, ok := s.names[t.text]
+	if !ok {
+		p.errorf(t, "unknown %s %s", s.kind, t.text)
+	}
+	return idx
+}
+
+// bind declares a new entry of s, named by id if it is an identifier.
+func (p *parser) bind(s *space, id token) uint32 {
+	idx := s.len
+	s.len++
+	if id.kind == tokenID {
+		if _, ok := s.names[id.text]; ok {
+			p.errorf(id, "duplicate %s %s", s.kind, id.text)
+		}
+		s.names[id.text] = idx
+	}
+	return idx
+}
+
+// external returns the index space and the kind of the imports and
+// exports named t.
+func (p *parser) external(t token) (*space, wasm.External) {
+	switch t.text {
+	case "func":
+		return p.funcs, wasm.ExternalFunction
+	case "table":
+		return p.tables, wasm.ExternalTable
+	case "memory":
+		return p.mems, wasm.ExternalMemory
+	case "global":
+		return p.globals, wasm.ExternalGlobal
+	}
+	p.errorf(t, "expected func, table, memory or global, got %v", t)
+	return nil, 0
+}
+
+func (p *parser) module() *wasm.Module {
+	wrapped := p.peekField("module")
+	if wrapped {
+		p.open("module")
+		p.id()
+	}
+	var fields []int
+	for p.peek().kind == tokenLParen {
+		fields = append(fields, p.pos)
+		p.declare()
+	}
+	if wrapped {
+		p.close()
+	}
+	if t := p.next(); t.kind != tokenEOF {
+		p.errorf(t, "unexpected %v", t)
+	}
+	for _, pos := range fields {
+		p.pos = pos
+		p.field()
+	}
+
+	m := &wasm.Module{Version: wasm.Version}
+	add := func(s wasm.Section) {
+		m.Sections = append(m.Sections, s)
+	}
+	if len(p.sigs) > 0 {
+		add(&wasm.SectionTypes{Entries: p.sigs})
+	}
+	if len(p.imports) > 0 {
+		add(&wasm.SectionImports{Entries: p.imports})
+	}
+	if len(p.functions) > 0 {
+		add(&wasm.SectionFunctions{Types: p.functions})
+	}
+	if len(p.tabs) > 0 {
+		add(&wasm.SectionTables{Entries: p.tabs})
+	}
+	if len(p.memories) > 0 {
+		add(&wasm.SectionMemories{Entries: p.memories})
+	}
+	if len(p.vars) > 0 {
+		add(&wasm.SectionGlobals{Globals: p.vars})
+	}
+	if len(p.exports.Entries) > 0 {
+		add(p.exports)
+	}
+	if p.start != nil {
+		add(p.start)
+	}
+	if len(p.elemSegs) > 0 {
+		add(&wasm.SectionElements{Entries: p.elemSegs})
+	}
+	if p.dataCount {
+		add(&wasm.SectionDataCount{Count: uint32(len(p.dataSegs))})
+	}
+	if len(p.bodies) > 0 {
+		add(&wasm.SectionCode{Bodies: p.bodies})
+	}
+	if len(p.dataSegs) > 0 {
+		add(&wasm.SectionData{Entries: p.dataSegs})
+	}
+	return m
+}
+
+// declare declares the entries defined by the module field at the current
+// position, and skips it. Type definitions are parsed entirely, as type
+// uses may refer to them before they are defined.
+func (p *parser) declare() {
+	start := p.pos
+	p.expect(tokenLParen)
+	t := p.expect(tokenKeyword)
+	switch t.text {
+	case "type":
+		id := p.id()
+		p.open("func")
+		sig := p.signature(newSpace("local"))
+		p.close()
+		p.close()
+		p.bind(p.types, id)
+		p.sigs = append(p.sigs, sig)
+	case "import":
+		p.expect(tokenString)
+		p.expect(tokenString)
+		p.expect(tokenLParen)
+		s, _ := p.external(p.next())
+		if s.defined {
+			p.errorf(t, "import after %s definition", s.kind)
+		}
+		p.bind(s, p.id())
+	case "func", "table", "memory", "global":
+		s, _ := p.external(t)
+		id := p.id()
+		for p.peekField("export") {
+			p.skip()
+		}
+		switch {
+		case p.peekField("import"):
+			if s.defined {
+				p.errorf(t, "import after %s definition", s.kind)
+			}
+		case t.text == "table" && p.peek().kind == tokenKeyword &&
+			p.peekAt(1).kind == tokenLParen && p.peekAt(2).text == "elem":
+			p.bind(p.elems, token{})
+		case t.text == "memory" && p.peekField("data"):
+			p.bind(p.datas, token{})
+		}
+		if !p.peekField("import") {
+			s.defined = true
+		}
+		p.bind(s, id)
+	case "elem":
+		p.bind(p.elems, p.id())
+	case "data":
+		p.bind(p.datas, p.id())
+	case "export", "start":
+	default:
+		p.errorf(t, "unknown module field %s", t.text)
+	}
+	p.pos = start
+	p.skip()
+}
+
+// field parses the module field at the current position.
+func (p *parser) field() {
+	start := p.pos
+	p.expect(tokenLParen)
+	switch t := p.next(); t.text {
+	case "type":
+		p.pos = start
+		p.skip()
+		return
+	case "import":
+		mod, name := p.expect(tokenString).text, p.expect(tokenString).text
+		p.expect(tokenLParen)
+		kind := p.next()
+		s, _ := p.external(kind)
+		s.nextIndex()
+		p.id()
+		p.imports = append(p.imports, wasm.ImportEntry{ModuleName: mod, FieldName: name, Type: p.importType(kind.text)})
+		p.close()
+	case "func":
+		p.function()
+	case "table":
+		p.table()
+	case "memory":
+		p.memory()
+	case "global":
+		p.id()
+		idx := p.globals.nextIndex()
+		p.inlineExports(wasm.ExternalGlobal, idx)
+		if !p.inlineImport("global") {
+			typ := p.globalType()
+			p.vars = append(p.vars, wasm.GlobalEntry{Type: typ, Init: p.expr(false)})
+		}
+	case "export":
+		name := p.expect(tokenString)
+		p.expect(tokenLParen)
+		s, kind := p.external(p.next())
+		p.export(name, kind, p.index(s))
+		p.close()
+	case "start":
+		if p.start != nil {
+			p.errorf(t, "duplicate start function")
+		}
+		p.start = &wasm.SectionStartFunction{Index: p.index(p.funcs)}
+	case "elem":
+		p.elem()
+	case "data":
+		p.data()
+	}
+	p.close()
+}
+
+// importType parses the type of an import of the given kind.
+func (p *parser) importType(kind string) wasm.Import {
+	switch kind {
+	case "func":
+		return wasm.FuncImport{Type: p.typeUse(newSpace("local"))}
+	case "table":
+		return wasm.TableImport{Type: p.tableType()}
+	case "memory":
+		return wasm.MemoryImport{Type: wasm.Memory{Limits: p.limits()}}
+	}
+	return wasm.GlobalVarImport{Type: p.globalType()}
+}
+
+// inlineImport parses the inline import of a field of the given kind, if
+// any, with the type of the import, and reports whether there was one.
+func (p *parser) inlineImport(kind string) bool {
+	if !p.peekField("import") {
+		return false
+	}
+	p.open("import")
+	mod, name := p.expect(tokenString).text, p.expect(tokenString).text
+	p.close()
+	p.imports = append(p.imports, wasm.ImportEntry{ModuleName: mod, FieldName: name, Type: p.importType(kind)})
+	return true
+}
+
+// inlineExports parses the inline exports of the entry idx of a field.
+func (p *parser) inlineExports(kind wasm.External, idx uint32) {
+	for p.peekField("export") {
+		p.open("export")
+		p.export(p.expect(tokenString), kind, idx)
+		p.close()
+	}
+}
+
+func (p *parser) export(name token, kind wasm.External, idx uint32) {
+	if _, ok := p.exports.Entries[name.text]; ok {
+		p.errorf(name, "duplicate export %q", name.text)
+	}
+	p.exports.Entries[name.text] = wasm.ExportEntry{FieldStr: name.text, Kind: kind, Index: idx}
+	p.exports.Names = append(p.exports.Names, name.text)
+}
+
+func (p *parser) function() {
+	p.id()
+	idx := p.funcs.nextIndex()
+	p.inlineExports(wasm.ExternalFunction, idx)
+	if p.inlineImport("func") {
+		return
+	}
+	p.locals = newSpace("local")
+	typ := p.typeUse(p.locals)
+	var locals []wasm.LocalEntry
+	add := func(t wasm.ValueType) {
+		if n := len(locals); n > 0 && locals[n-1].Type == t {
+			locals[n-1].Count++
+			return
+		}
+		locals = append(locals, wasm.LocalEntry{Count: 1, Type: t})
+	}
+	for p.peekField("local") {
+		p.open("local")
+		if id := p.id(); id.kind == tokenID {
+			add(p.valueType())
+			p.bind(p.locals, id)
+		} else {
+			for p.peek().kind != tokenRParen {
+				add(p.valueType())
+				p.bind(p.locals, id)
+			}
+		}
+		p.close()
+	}
+	start := p.peek()
+	p.labels, p.code = nil, nil
+	p.instrs()
+	p.functions = append(p.functions, typ)
+	p.bodies = append(p.bodies, wasm.FunctionBody{Locals: locals, Code: p.assemble(start)})
+}
+
+func (p *parser) table() {
+	p.id()
+	idx := p.tables.nextIndex()
+	p.inlineExports(wasm.ExternalTable, idx)
+	if p.inlineImport("table") {
+		return
+	}
+	if p.peek().kind != tokenKeyword {
+		p.tabs = append(p.tabs, p.tableType())
+		return
+	}
+	seg := wasm.ElementSegment{Index: idx, Offset: zeroOffset(), Type: p.refType()}
+	p.open("elem")
+	if p.peek().kind == tokenLParen {
+		seg.Exprs = p.elemExprs()
+	} else {
+		seg.Elems = p.funcIndices()
+	}
+	p.close()
+	n := uint32(len(seg.Elems) + len(seg.Exprs))
+	p.elems.nextIndex()
+	p.elemSegs = append(p.elemSegs, seg)
+	p.tabs = append(p.tabs, wasm.Table{
+		ElementType: seg.Type,
+		Limits:      wasm.ResizableLimits{Flags: 1, Initial: n, Maximum: n},
+	})
+}
+
+func (p *parser) memory() {
+	p.id()
+	idx := p.mems.nextIndex()
+	p.inlineExports(wasm.ExternalMemory, idx)
+	if p.inlineImport("memory") {
+		return
+	}
+	if !p.peekField("data") {
+		p.memories = append(p.memories, wasm.Memory{Limits: p.limits()})
+		return
+	}
+	p.open("data")
+	data := p.strings()
+	p.close()
+	n := uint32((len(data) + 1<<16 - 1) >> 16)
+	p.memories = append(p.memories, wasm.Memory{
+		Limits: wasm.ResizableLimits{Flags: 1, Initial: n, Maximum: n},
+	})
+	p.datas.nextIndex()
+	p.dataSegs = append(p.dataSegs, wasm.DataSegment{Index: idx, Offset: zeroOffset(), Data: data})
+}
+
+func (p *parser) elem() {
+	p.id()
+	p.elems.nextIndex()
+	var seg wasm.ElementSegment
+	switch {
+	case p.peekKeyword("declare"):
+		p.next()
+		seg.Declarative = true
+	case p.peekField("table"):
+		p.open("table")
+		seg.Index = p.index(p.tables)
+		p.close()
+		seg.Offset = p.offset()
+	case isIndex(p.peek()):
+		seg.Index = p.index(p.tables)
+		seg.Offset = p.offset()
+	case p.peek().kind == tokenLParen:
+		seg.Offset = p.offset()
+	default:
+		seg.Passive = true
+	}
+	seg.Type = wasm.ElemTypeAnyFunc
+	switch {
+	case p.peekKeyword("func"):
+		p.next()
+		seg.Elems = p.funcIndices()
+	case p.peek().kind == tokenKeyword:
+		seg.Type = p.refType()
+		seg.Exprs = p.elemExprs()
+	default:
+		seg.Elems = p.funcIndices()
+	}
+	p.elemSegs = append(p.elemSegs, seg)
+}
+
+func (p *parser) funcIndices() []uint32 {
+	var elems []uint32
+	for isIndex(p.peek()) {
+		elems = append(elems, p.index(p.funcs))
+	}
+	return elems
+}
+
+func (p *parser) elemExprs() [][]byte {
+	exprs := [][]byte{}
+	for p.peek().kind == tokenLParen {
+		if p.peekField("item") {
+			p.open("item")
+			exprs = append(exprs, p.expr(false))
+			p.close()
+			continue
+		}
+		exprs = append(exprs, p.expr(true))
+	}
+	return exprs
+}
+
+func (p *parser) data() {
+	p.id()
+	p.datas.nextIndex()
+	var seg wasm.DataSegment
+	switch {
+	case p.peekField("memory"):
+		p.open("memory")
+		seg.Index = p.index(p.mems)
+		p.close()
+		seg.Offset = p.offset()
+	case isIndex(p.peek()):
+		seg.Index = p.index(p.mems)
+		seg.Offset = p.offset()
+	case p.peek().kind == tokenLParen:
+		seg.Offset = p.offset()
+	default:
+		seg.Passive = true
+	}
+	seg.Data = p.strings()
+	p.dataSegs = append(p.dataSegs, seg)
+}
+
+// offset parses the offset of an active segment, given either as
+// (offset instr*) or as a single folded instruction.
+func (p *parser) offset() []byte {
+	if !p.peekField("offset") {
+		return p.expr(true)
+	}
+	p.open("offset")
+	expr := p.expr(false)
+	p.close()
+	return expr
+}
+
+// zeroOffset returns the offset of the segments defined inline by tables
+// and memories.
+func zeroOffset() []byte {
+	return []byte{operators.I32Const, 0, operators.End}
+}
+
+func (p *parser) valueType() wasm.ValueType {
+	t := p.next()
+	switch t.text {
+	case "i32":
+		return wasm.ValueTypeI32
+	case "i64":
+		return wasm.ValueTypeI64
+	case "f32":
+		return wasm.ValueTypeF32
+	case "f64":
+		return wasm.ValueTypeF64
+	case "v128":
+		return wasm.ValueTypeV128
+	case "funcref", "anyfunc":
+		return wasm.ValueTypeFuncRef
+	case "externref":
+		return wasm.ValueTypeExternRef
+	}
+	p.errorf(t, "expected a value type, got %v", t)
+	return 0
+}
+
+func (p *parser) refType() wasm.ElemType {
+	t := p.peek()
+	typ := p.valueType()
+	if !typ.IsRef() {
+		p.errorf(t, "expected a reference type, got %v", t)
+	}
+	return wasm.ElemType(typ)
+}
+
+func (p *parser) limits() wasm.ResizableLimits {
+	lim := wasm.ResizableLimits{Initial: p.u32()}
+	if p.peek().kind == tokenReserved {
+		lim.Flags = 1
+		lim.Maximum = p.u32()
+	}
+	return lim
+}
+
+func (p *parser) tableType() wasm.Table {
+	lim := p.limits()
+	return wasm.Table{ElementType: p.refType(), Limits: lim}
+}
+
+func (p *parser) globalType() wasm.GlobalVar {
+	if !p.peekField("mut") {
+		return wasm.GlobalVar{Type: p.valueType()}
+	}
+	p.open("mut")
+	typ := p.valueType()
+	p.close()
+	return wasm.GlobalVar{Type: typ, Mutable: true}
+}
+
+// signature parses the parameters and results of a function type, binding
+// the parameters in locals.
+func (p *parser) signature(locals *space) wasm.FunctionSig {
+	sig := wasm.FunctionSig{Form: wasm.TypeFunc}
+	for p.peekField("param") {
+		p.open("param")
+		if id := p.id(); id.kind == tokenID {
+			sig.ParamTypes = append(sig.ParamTypes, p.valueType())
+			p.bind(locals, id)
+		} else {
+			for p.peek().kind != tokenRParen {
+				sig.ParamTypes = append(sig.ParamTypes, p.valueType())
+				p.bind(locals, id)
+			}
+		}
+		p.close()
+	}
+	sig.ReturnTypes = p.results()
+	return sig
+}
+
+func (p *parser) results() []wasm.ValueType {
+	var types []wasm.ValueType
+	for p.peekField("result") {
+		p.open("result")
+		for p.peek().kind != tokenRParen {
+			types = append(types, p.valueType())
+		}
+		p.close()
+	}
+	return types
+}
+
+// typeUse parses a reference to a function type, binding its parameters
+// in locals, and returns the index of the type. A type given only by its
+// parameters and results is the first type with the same signature, which
+// is added to the types of the module if there is none.
+func (p *parser) typeUse(locals *space) uint32 {
+	if !p.peekField("type") {
+		return p.findType(p.signature(locals))
+	}
+	p.open("type")
+	t := p.peek()
+	idx := p.index(p.types)
+	p.close()
+	if int(idx) >= len(p.sigs) {
+		p.errorf(t, "unknown type %d", idx)
+	}
+	want := p.sigs[idx]
+	if p.peekField("param") || p.peekField("result") {
+		if sig := p.signature(locals); !sameSig(sig, want) {
+			p.errorf(t, "inline function type does not match type %d", idx)
+		}
+		return idx
+	}
+	for range want.ParamTypes {
+		p.bind(locals, token{})
+	}
+	return idx
+}
+
+func (p *parser) findType(sig wasm.FunctionSig) uint32 {
+	for i, s := range p.sigs {
+		if sameSig(s, sig) {
+			return uint32(i)
+		}
+	}
+	p.sigs = append(p.sigs, sig)
+	return uint32(len(p.sigs) - 1)
+}
+
+func sameSig(a, b wasm.FunctionSig) bool {
+	return sameTypes(a.ParamTypes, b.ParamTypes) && sameTypes(a.ReturnTypes, b.ReturnTypes)
+}
+
+func sameTypes(a, b []wasm.ValueType) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// expr parses a constant expression, made of the instructions up to the
+// closing parenthesis, or of a single folded instruction if folded is set.
+func (p *parser) expr(folded bool) []byte {
+	p.locals, p.labels, p.code = newSpace("local"), nil, nil
+	start := p.peek()
+	if folded {
+		p.folded()
+	} else {
+		p.instrs()
+	}
+	return append(p.assemble(start), operators.End)
+}
+
+// assemble returns the encoding of the instructions parsed since the token
+// start.
+func (p *parser) assemble(start token) []byte {
+	code, err := disasm.Assemble(p.code)
+	if err != nil {
+		p.errorf(start, "%v", err)
+	}
+	return code
+}
+
+func (p *parser) emit(code byte, imms ...interface{}) {
+	op, _ := operators.New(code)
+	p.code = append(p.code, disasm.Instr{Op: op, Immediates: imms})
+}
+
+// instrs parses instructions up to a closing parenthesis, or to the else
+// or end of the enclosing block.
+func (p *parser) instrs() {
+	for {
+		switch t := p.peek(); {
+		case t.kind == tokenLParen:
+			p.folded()
+		case t.kind == tokenKeyword && t.text != "end" && t.text != "else":
+			p.instr()
+		default:
+			return
+		}
+	}
+}
+
+// instr parses a plain instruction, or a block instruction up to its end.
+func (p *parser) instr() {
+	t := p.next()
+	switch t.text {
+	case "block", "loop", "if":
+		label := p.id()
+		p.block(opNames[t.text].Code, label, p.blockType())
+		p.instrs()
+		if t.text == "if" && p.peekKeyword("else") {
+			p.next()
+			p.labelEnd(label)
+			p.emit(operators.Else)
+			p.instrs()
+		}
+		p.keyword("end")
+		p.labelEnd(label)
+		p.blockEnd()
+		return
+	}
+	p.code = append(p.code, p.plainInstr(t))
+}
+
+// folded parses a folded instruction, whose operands come first.
+func (p *parser) folded() {
+	p.expect(tokenLParen)
+	switch t := p.next(); t.text {
+	case "block", "loop":
+		p.block(opNames[t.text].Code, p.id(), p.blockType())
+		p.instrs()
+		p.blockEnd()
+	case "if":
+		label := p.id()
+		bt := p.blockType()
+		for p.peek().kind == tokenLParen && !p.peekField("then") {
+			p.folded()
+		}
+		p.block(operators.If, label, bt)
+		p.open("then")
+		p.instrs()
+		p.close()
+		if p.peekField("else") {
+			p.open("else")
+			p.emit(operators.Else)
+			p.instrs()
+			p.close()
+		}
+		p.blockEnd()
+	default:
+		ins := p.plainInstr(t)
+		for p.peek().kind == tokenLParen {
+			p.folded()
+		}
+		p.code = append(p.code, ins)
+	}
+	p.close()
+}
+
+func (p *parser) block(code byte, label token, bt interface{}) {
+	p.emit(code, bt)
+	p.labels = append(p.labels, label.text)
+}
+
+func (p *parser) blockEnd() {
+	p.labels = p.labels[:len(p.labels)-1]
+	p.emit(operators.End)
+}
+
+// labelEnd parses the optional identifier following the else or end of the
+// block labeled label.
+func (p *parser) labelEnd(label token) {
+	if t := p.id(); t.kind == tokenID && t.text != label.text {
+		p.errorf(t, "mismatched label %s, expected %s", t.text, label.text)
+	}
+}
+
+func (p *parser) blockType() interface{} {
+	if !p.peekField("type") && !p.peekField("param") {
+		switch results := p.results(); len(results) {
+		case 0:
+			return wasm.BlockTypeEmpty
+		case 1:
+			return wasm.BlockType(results[0])
+		default:
+			return wasm.BlockTypeIndex(p.findType(wasm.FunctionSig{Form: wasm.TypeFunc, ReturnTypes: results}))
+		}
+	}
+	return wasm.BlockTypeIndex(p.typeUse(newSpace("local")))
+}
+
+// labelIndex returns the relative depth of the label given at the current
+// position by its depth or identifier.
+func (p *parser) labelIndex() uint32 {
+	t := p.peek()
+	if t.kind != tokenID {
+		return p.u32()
+	}
+	p.next()
+	for i := len(p.labels) - 1; i >= 0; i-- {
+		if p.labels[i] == t.text {
+			return uint32(len(p.labels) - 1 - i)
+		}
+	}
+	p.errorf(t, "unknown label %s", t.text)
+	return 0
+}
+
+// tableIndex returns the optional table index of an instruction, which
+// defaults to 0.
+func (p *parser) tableIndex() uint32 {
+	if isIndex(p.peek()) {
+		return p.index(p.tables)
+	}
+	return 0
+}
+
+// plainInstr parses the immediates of the plain instruction named t.
+func (p *parser) plainInstr(t token) disasm.Instr {
+	op, ok := opNames[t.text]
+	if t.kind != tokenKeyword || !ok {
+		p.errorf(t, "unknown operator %v", t)
+	}
+	var imms []interface{}
+	switch code := op.Code; code {
+	case operators.Block, operators.Loop, operators.If, operators.Else, operators.End:
+		p.errorf(t, "unexpected %s", t.text)
+	case operators.Br, operators.BrIf:
+		imms = append(imms, p.labelIndex())
+	case operators.BrTable:
+		var targets []interface{}
+		for isIndex(p.peek()) {
+			targets = append(targets, p.labelIndex())
+		}
+		if len(targets) == 0 {
+			p.errorf(p.peek(), "expected a label, got %v", p.peek())
+		}
+		imms = append(append(imms, uint32(len(targets)-1)), targets...)
+	case operators.Call, operators.ReturnCall, operators.RefFunc:
+		imms = append(imms, p.index(p.funcs))
+	case operators.CallIndirect, operators.ReturnCallIndirect:
+		var table uint32
+		if isIndex(p.peek()) {
+			p.next()
+			typeUse := p.peekField("type") || p.peekField("param") || p.peekField("result")
+			p.pos--
+			if !typeUse {
+				// A single index is the type in earlier drafts, as
+				// in call_indirect $sig.
+				imms = append(imms, p.index(p.types), table)
+				break
+			}
+			table = p.index(p.tables)
+		}
+		imms = append(imms, p.typeUse(newSpace("local")), table)
+	case operators.GetLocal, operators.SetLocal, operators.TeeLocal:
+		imms = append(imms, p.index(p.locals))
+	case operators.GetGlobal, operators.SetGlobal:
+		imms = append(imms, p.index(p.globals))
+	case operators.TableGet, operators.TableSet:
+		imms = append(imms, p.tableIndex())
+	case operators.Select:
+		if p.peekField("result") {
+			types := p.results()
+			op, _ = operators.New(operators.SelectTyped)
+			imms = append(imms, uint32(len(types)))
+			for _, typ := range types {
+				imms = append(imms, typ)
+			}
+		}
+	case operators.RefNull:
+		switch ht := p.next(); ht.text {
+		case "func", "funcref":
+			imms = append(imms, wasm.ValueTypeFuncRef)
+		case "extern", "externref":
+			imms = append(imms, wasm.ValueTypeExternRef)
+		default:
+			p.errorf(ht, "expected func or extern, got %v", ht)
+		}
+	case operators.I32Const:
+		imms = append(imms, int32(p.integer(32)))
+	case operators.I64Const:
+		imms = append(imms, int64(p.integer(64)))
+	case operators.F32Const:
+		imms = append(imms, math.Float32frombits(uint32(p.float(32))))
+	case operators.F64Const:
+		imms = append(imms, math.Float64frombits(p.float(64)))
+	case operators.CurrentMemory, operators.GrowMemory:
+		imms = append(imms, uint8(0))
+	case operators.MiscPrefix:
+		imms = p.miscImmediates(op.Sub)
+	case operators.SIMDPrefix:
+		imms = p.simdImmediates(op.Sub)
+	default:
+		if code >= operators.I32Load && code <= operators.I64Store32 {
+			imms = p.memarg(naturalAlignment(code))
+		}
+	}
+	return disasm.Instr{Op: op, Immediates: imms}
+}
+
+func (p *parser) integer(bits int) uint64 {
+	t := p.next()
+	v, ok := parseInt(t.text, bits)
+	if t.kind != tokenReserved || !ok {
+		p.errorf(t, "invalid i%d constant %v", bits, t)
+	}
+	return v
+}
+
+func (p *parser) float(bits int) uint64 {
+	t := p.next()
+	v, ok := parseFloat(t.text, bits)
+	if t.kind != tokenReserved && t.kind != tokenKeyword || !ok {
+		p.errorf(t, "invalid f%d constant %v", bits, t)
+	}
+	return v
+}
+
+// memarg parses the optional offset and alignment of a memory operator,
+// whose alignment defaults to natural, and returns them as immediates.
+func (p *parser) memarg(natural uint32) []interface{} {
+	var offset uint32
+	if t := p.peek(); t.kind == tokenKeyword && strings.HasPrefix(t.text, "offset=") {
+		p.next()
+		v, ok := parseUint(strings.TrimPrefix(t.text, "offset="), 32)
+		if !ok {
+			p.errorf(t, "invalid offset %s", t.text)
+		}
+		offset = uint32(v)
+	}
+	align := natural
+	if t := p.peek(); t.kind == tokenKeyword && strings.HasPrefix(t.text, "align=") {
+		p.next()
+		v, ok := parseUint(strings.TrimPrefix(t.text, "align="), 32)
+		if !ok || v == 0 || v&(v-1) != 0 {
+			p.errorf(t, "invalid alignment %s", t.text)
+		}
+		align = uint32(bits.TrailingZeros64(v))
+	}
+	return []interface{}{align, offset}
+}
+
+// miscImmediates parses the immediates of the operator sub prefixed with
+// operators.MiscPrefix, as returned by disasm.Disassemble.
+func (p *parser) miscImmediates(sub uint32) []interface{} {
+	switch sub {
+	case operators.MemoryInit:
+		p.dataCount = true
+		return []interface{}{p.index(p.datas), uint32(0)}
+	case operators.DataDrop:
+		p.dataCount = true
+		return []interface{}{p.index(p.datas)}
+	case operators.MemoryCopy:
+		return []interface{}{uint32(0), uint32(0)}
+	case operators.MemoryFill:
+		return []interface{}{uint32(0)}
+	case operators.TableInit:
+		var table uint32
+		if isIndex(p.peek()) && isIndex(p.peekAt(1)) {
+			table = p.index(p.tables)
+		}
+		return []interface{}{p.index(p.elems), table}
+	case operators.ElemDrop:
+		return []interface{}{p.index(p.elems)}
+	case operators.TableCopy:
+		if !isIndex(p.peek()) {
+			return []interface{}{uint32(0), uint32(0)}
+		}
+		dst := p.index(p.tables)
+		return []interface{}{dst, p.index(p.tables)}
+	case operators.TableGrow, operators.TableSize, operators.TableFill:
+		return []interface{}{p.tableIndex()}
+	}
+	return nil
+}
+
+// simdImmediates parses the immediates of the operator sub prefixed with
+// operators.SIMDPrefix, as returned by disasm.Disassemble.
+func (p *parser) simdImmediates(sub uint32) []interface{} {
+	var imms []interface{}
+	if sub <= operators.V128Store || sub >= operators.V128Load8Lane && sub <= operators.V128Load64Zero {
+		imms = p.memarg(simdNaturalAlignment(sub))
+	}
+	switch {
+	case sub == operators.V128Const:
+		imms = append(imms, p.v128())
+	case sub == operators.I8x16Shuffle:
+		var lanes [16]byte
+		for i := range lanes {
+			lanes[i] = p.lane()
+		}
+		imms = append(imms, lanes)
+	case sub >= operators.I8x16ExtractLaneS && sub <= operators.F64x2ReplaceLane,
+		sub >= operators.V128Load8Lane && sub <= operators.V128Store64Lane:
+		imms = append(imms, p.lane())
+	}
+	return imms
+}
+
+func (p *parser) lane() uint8 {
+	t := p.next()
+	v, ok := parseUint(t.text, 8)
+	if t.kind != tokenReserved || !ok {
+		p.errorf(t, "invalid lane index %v", t)
+	}
+	return uint8(v)
+}
+
+// v128 parses the shape and the lanes of a v128.const.
+func (p *parser) v128() [16]byte {
+	shape := p.next()
+	var lanes, size int
+	switch shape.text {
+	case "i8x16":
+		lanes, size = 16, 1
+	case "i16x8":
+		lanes, size = 8, 2
+	case "i32x4", "f32x4":
+		lanes, size = 4, 4
+	case "i64x2", "f64x2":
+		lanes, size = 2, 8
+	default:
+		p.errorf(shape, "invalid v128 shape %v", shape)
+	}
+	var b [16]byte
+	for i := 0; i < lanes; i++ {
+		var v uint64
+		if shape.text[0] == 'f' {
+			v = p.float(size * 8)
+		} else {
+			v = p.integer(size * 8)
+		}
+		for j := 0; j < size; j++ {
+			b[i*size+j] = byte(v >> uint(8*j))
+		}
+	}
+	return b
+}
+
+// opNames maps the names of the operators to their Op values. It also
+// holds the current names of the operators which package operators names
+// as in earlier drafts of the text format, such as local.get for get_local.
+var opNames = operatorNames()
+
+func operatorNames() map[string]operators.Op {
+	names := make(map[string]operators.Op)
+	add := func(op operators.Op) {
+		// select names both Select and SelectTyped, told apart by the
+		// results following it.
+		if _, ok := names[op.Name]; ok {
+			return
+		}
+		names[op.Name] = op
+		names[currentName(op.Name)] = op
+	}
+	for code := 0; code < 256; code++ {
+		if op, err := operators.New(byte(code)); err == nil {
+			add(op)
+		}
+	}
+	for _, prefix := range []byte{operators.MiscPrefix, operators.SIMDPrefix} {
+		for sub := uint32(0); sub < 0x200; sub++ {
+			if op, err := operators.NewPrefixed(prefix, sub); err == nil {
+				add(op)
+			}
+		}
+	}
+	names["current_memory"] = names["memory.size"]
+	names["grow_memory"] = names["memory.grow"]
+	return names
+}
+
+// currentName returns the current name of the operator named name in
+// earlier drafts of the text format.
+func currentName(name string) string {
+	switch name {
+	case "get_local":
+		return "local.get"
+	case "set_local":
+		return "local.set"
+	case "tee_local":
+		return "local.tee"
+	case "get_global":
+		return "global.get"
+	case "set_global":
+		return "global.set"
+	}
+	// Conversions such as i32.trunc_s/f32 are now named i32.trunc_f32_s.
+	i := strings.IndexByte(name, '/')
+	if i < 0 {
+		return name
+	}
+	op, from := name[:i], name[i+1:]
+	if strings.HasSuffix(op, "_s") || strings.HasSuffix(op, "_u") {
+		return op[:len(op)-2] + "_" + from + op[len(op)-2:]
+	}
+	return op + "_" + from
+}
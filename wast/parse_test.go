@@ -0,0 +1,283 @@
+// Copyright 2018 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wast_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ontio/wagon/wasm"
+	"github.com/ontio/wagon/wast"
+)
+
+// sections returns the encoded payloads of the sections of m, except the
+// custom sections and the export section, whose entries are returned
+// separately as their order is not preserved.
+func sections(t *testing.T, m *wasm.Module) ([][]byte, map[string]wasm.ExportEntry) {
+	var payloads [][]byte
+	for _, s := range m.Sections {
+		if s.SectionID() == wasm.SectionIDCustom || s.SectionID() == wasm.SectionIDExport {
+			continue
+		}
+		buf := new(bytes.Buffer)
+		if err := s.WritePayload(buf); err != nil {
+			t.Fatal(err)
+		}
+		payloads = append(payloads, append([]byte{byte(s.SectionID())}, buf.Bytes()...))
+	}
+	if m.Export == nil {
+		return payloads, nil
+	}
+	return payloads, m.Export.Entries
+}
+
+func TestParse(t *testing.T) {
+	for _, dir := range testPaths {
+		fnames, err := filepath.Glob(filepath.Join(dir, "*.wasm"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, fname := range fnames {
+			name := fname
+			tname := strings.TrimSuffix(name, ".wasm") + ".wast"
+			if _, err := os.Stat(tname); err != nil {
+				continue
+			}
+			t.Run(filepath.Base(tname), func(t *testing.T) {
+				raw, err := ioutil.ReadFile(name)
+				if err != nil {
+					t.Fatal(err)
+				}
+				text, err := ioutil.ReadFile(tname)
+				if err != nil {
+					t.Fatal(err)
+				}
+				exp, err := wasm.DecodeModule(bytes.NewReader(raw))
+				if err != nil {
+					t.Fatalf("error reading module %v", err)
+				}
+				m, err := wast.Parse(bytes.NewReader(text))
+				if err != nil {
+					t.Fatal(err)
+				}
+				got, gotExports := sections(t, m)
+				want, wantExports := sections(t, exp)
+				if len(got) != len(want) {
+					t.Fatalf("got %d sections, want %d", len(got), len(want))
+				}
+				for i := range got {
+					if !bytes.Equal(got[i], want[i]) {
+						t.Errorf("section %d: got %x, want %x", got[i][0], got[i][1:], want[i][1:])
+					}
+				}
+				if !reflect.DeepEqual(gotExports, wantExports) {
+					t.Errorf("got exports %v, want %v", gotExports, wantExports)
+				}
+			})
+		}
+	}
+}
+
+var parseEquivalentTests = []struct {
+	name string
+	text string // module using abbreviations and identifiers
+	want string // the same module, written without them
+}{
+	{
+		name: "names and inline imports and exports",
+		text: `(module
+  (type $t (func (param i32) (result i32)))
+  (import "env" "print" (func $print (param i32)))
+  (func $log (import "env" "log") (param $x i32))
+  (func $id (export "id") (type $t) (local.get 0))
+  (func (export "twice") (param $x i32) (result i32)
+    (local $y i32)
+    (local.set $y (call $id (local.get $x)))
+    (call $log (local.get $y))
+    (i32.add (local.get $y) (local.get $y)))
+  (memory (export "mem") (data "hi\00\u{e9}" "\t"))
+  (global $g (export "g") (mut i32) (i32.const -1))
+  (table funcref (elem $id 3)))`,
+		want: `
+  (type (func (param i32) (result i32)))
+  (type (func (param i32)))
+  (import "env" "print" (func (type 1)))
+  (import "env" "log" (func (type 1)))
+  (func (type 0) get_local 0)
+  (func (type 0) (local i32)
+    get_local 0 call 2 set_local 1
+    get_local 1 call 1
+    get_local 1 get_local 1 i32.add)
+  (table 2 2 anyfunc)
+  (memory 1 1)
+  (global (mut i32) i32.const 0xffffffff)
+  (export "id" (func 2))
+  (export "twice" (func 3))
+  (export "mem" (memory 0))
+  (export "g" (global 0))
+  (elem (i32.const 0) 2 3)
+  (data (i32.const 0) "hi\00\c3\a9\09")`,
+	},
+	{
+		name: "folded instructions and labels",
+		text: `(module
+  (func (param i32) (result i32)
+    (block $done (result i32)
+      (loop $next
+        (br_if $done (i32.const 1) (local.get 0))
+        (if (i32.eqz (local.get 0))
+          (then (br $next))
+          (else nop))
+        (br $next))
+      unreachable)))`,
+		want: `(module
+  (func (param i32) (result i32)
+    block (result i32)
+      loop
+        i32.const 1
+        local.get 0
+        br_if 1
+        local.get 0
+        i32.eqz
+        if
+          br 1
+        else
+          nop
+        end
+        br 0
+      end
+      unreachable
+    end))`,
+	},
+	{
+		name: "block types and segments",
+		text: `(module
+  (type $v (func))
+  (func $f (type $v)
+    (block $b (result i32 i64)
+      (i32.const 1) (i64.const 2))
+    drop drop
+    block $c
+      br $c
+    end $c
+    (memory.init $d (i32.const 0) (i32.const 0) (i32.const 1))
+    (data.drop $d))
+  (table $t 1 funcref)
+  (memory 1)
+  (elem (table $t) (offset (i32.const 0)) func $f)
+  (elem $e funcref (ref.func $f) (item ref.null func))
+  (elem declare func $f)
+  (data $d "x"))`,
+		want: `(module
+  (type (func))
+  (type (func (result i32 i64)))
+  (func (type 0)
+    block (type 1) i32.const 1 i64.const 2 end
+    drop drop
+    block br 0 end
+    i32.const 0 i32.const 0 i32.const 1 memory.init 0
+    data.drop 0)
+  (table 1 funcref)
+  (memory 1)
+  (elem (i32.const 0) 0)
+  (elem funcref (ref.func 0) (ref.null func))
+  (elem declare func 0)
+  (data "x"))`,
+	},
+}
+
+func TestParseEquivalent(t *testing.T) {
+	for _, test := range parseEquivalentTests {
+		t.Run(test.name, func(t *testing.T) {
+			m, err := wast.Parse(strings.NewReader(test.text))
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := wast.Parse(strings.NewReader(test.want))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, gotExports := sections(t, m)
+			exp, wantExports := sections(t, want)
+			if !reflect.DeepEqual(got, exp) {
+				t.Errorf("got sections %x, want %x", got, exp)
+			}
+			if !reflect.DeepEqual(gotExports, wantExports) {
+				t.Errorf("got exports %v, want %v", gotExports, wantExports)
+			}
+		})
+	}
+}
+
+func TestParseConstants(t *testing.T) {
+	m, err := wast.Parse(strings.NewReader(`(func
+  i32.const 0xffff_ffff drop
+  i64.const -0x8000000000000000 drop
+  f32.const 0x1p-1 drop
+  f64.const -nan:0x1 drop
+  f32.const inf drop
+  f64.const 1_000.5 drop)`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{
+		0x41, 0x7f, 0x1a,
+		0x42, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x1a,
+		0x43, 0x00, 0x00, 0x00, 0x3f, 0x1a,
+		0x44, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0xff, 0x1a,
+		0x43, 0x00, 0x00, 0x80, 0x7f, 0x1a,
+		0x44, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x8f, 0x40, 0x1a,
+	}
+	if got := m.Code.Bodies[0].Code; !bytes.Equal(got, want) {
+		t.Errorf("got code %x, want %x", got, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, test := range []struct {
+		text string
+		err  string
+	}{
+		{`(module (func (call $g)))`, "wast: 1:21: unknown function $g"},
+		{`(module (func $f) (func $f))`, "wast: 1:25: duplicate function $f"},
+		{`(module (func) (import "a" "b" (func)))`, "wast: 1:17: import after function definition"},
+		{"(module\n  (func i32.const 0x1_0000_0000))", "wast: 2:19: invalid i32 constant 0x1_0000_0000"},
+		{`(module (func block $a end $b))`, "wast: 1:28: mismatched label $b, expected $a"},
+		{`(module (func i32.frob))`, "wast: 1:15: unknown operator i32.frob"},
+		{`(module "unterminated`, "wast: 1:9: unterminated string"},
+		{`(module (func)`, "wast: 1:15: expected ')', got end of input"},
+		{`(module (func`, "wast: 1:14: unexpected end of input"},
+		{`(`, "wast: 1:2: expected keyword, got end of input"},
+		{`(module (table funcref`, "wast: 1:23: unexpected end of input"},
+	} {
+		_, err := wast.Parse(strings.NewReader(test.text))
+		if _, ok := err.(wast.SyntaxError); !ok || err.Error() != test.err {
+			t.Errorf("%s: got error %v, want %s", test.text, err, test.err)
+		}
+	}
+	if m, err := wast.Parse(strings.NewReader("")); err != nil || len(m.Sections) != 0 {
+		t.Errorf("empty text: got module %v, error %v, want an empty module", m, err)
+	}
+}
+
+func TestParseTruncated(t *testing.T) {
+	for _, test := range parseEquivalentTests {
+		for i := range test.text {
+			func() {
+				defer func() {
+					if e := recover(); e != nil {
+						t.Fatalf("%q: panic: %v", test.text[:i], e)
+					}
+				}()
+				wast.Parse(strings.NewReader(test.text[:i]))
+			}()
+		}
+	}
+}
@@ -0,0 +1,361 @@
+// Copyright 2018 The go-interpreter Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wast
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// See https://webassembly.github.io/spec/core/text/lexical.html
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenLParen
+	tokenRParen
+	tokenKeyword  // keywords and instruction names, starting with a lowercase letter
+	tokenID       // symbolic identifiers, starting with $
+	tokenString   // strings, whose text is unquoted
+	tokenReserved // other sequences of idchars, such as numbers
+)
+
+func (k tokenKind) String() string {
+	switch k {
+	case tokenEOF:
+		return "end of input"
+	case tokenLParen:
+		return "'('"
+	case tokenRParen:
+		return "')'"
+	case tokenKeyword:
+		return "keyword"
+	case tokenID:
+		return "identifier"
+	case tokenString:
+		return "string"
+	}
+	return "token"
+}
+
+type token struct {
+	kind tokenKind
+	text string
+	line int
+	col  int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF, tokenLParen, tokenRParen:
+		return t.kind.String()
+	case tokenString:
+		return strconv.Quote(t.text)
+	}
+	return t.text
+}
+
+// SyntaxError is returned by Parse when the text of a module is invalid.
+type SyntaxError struct {
+	Line   int // line of the invalid token, starting at 1
+	Column int // column of the invalid token in bytes, starting at 1
+	Msg    string
+}
+
+func (e SyntaxError) Error() string {
+	return fmt.Sprintf("wast: %d:%d: %s", e.Line, e.Column, e.Msg)
+}
+
+// scanner splits a text module into tokens.
+type scanner struct {
+	src  string
+	pos  int
+	line int
+	col  int
+}
+
+func (s *scanner) errorf(format string, args ...interface{}) {
+	panic(SyntaxError{Line: s.line, Column: s.col, Msg: fmt.Sprintf(format, args...)})
+}
+
+func (s *scanner) advance(n int) {
+	for _, c := range s.src[s.pos : s.pos+n] {
+		if c == '\n' {
+			s.line++
+			s.col = 1
+		} else {
+			s.col++
+		}
+	}
+	s.pos += n
+}
+
+// scan returns the tokens of src, ending with a tokenEOF.
+func scan(src string) []token {
+	s := &scanner{src: src, line: 1, col: 1}
+	var toks []token
+	for {
+		s.skipSpace()
+		tok := token{line: s.line, col: s.col}
+		if s.pos == len(s.src) {
+			return append(toks, tok)
+		}
+		switch c := s.src[s.pos]; {
+		case c == '(':
+			tok.kind = tokenLParen
+			s.advance(1)
+		case c == ')':
+			tok.kind = tokenRParen
+			s.advance(1)
+		case c == '"':
+			tok.kind = tokenString
+			tok.text = s.scanString()
+		case isIDChar(c):
+			n := 1
+			for s.pos+n < len(s.src) && isIDChar(s.src[s.pos+n]) {
+				n++
+			}
+			tok.text = s.src[s.pos : s.pos+n]
+			switch {
+			case c == '$' && n > 1:
+				tok.kind = tokenID
+			case c >= 'a' && c <= 'z':
+				tok.kind = tokenKeyword
+			default:
+				tok.kind = tokenReserved
+			}
+			s.advance(n)
+		default:
+			s.errorf("unexpected character %q", c)
+		}
+		toks = append(toks, tok)
+	}
+}
+
+// skipSpace skips the white space and the comments.
+func (s *scanner) skipSpace() {
+	for s.pos < len(s.src) {
+		switch {
+		case strings.HasPrefix(s.src[s.pos:], ";;"):
+			n := strings.IndexByte(s.src[s.pos:], '\n')
+			if n < 0 {
+				n = len(s.src) - s.pos
+			}
+			s.advance(n)
+		case strings.HasPrefix(s.src[s.pos:], "(;"):
+			s.skipBlockComment()
+		case strings.IndexByte(" \t\n\r", s.src[s.pos]) >= 0:
+			s.advance(1)
+		default:
+			return
+		}
+	}
+}
+
+// skipBlockComment skips a block comment, which may be nested.
+func (s *scanner) skipBlockComment() {
+	line, col := s.line, s.col
+	depth := 0
+	for s.pos < len(s.src) {
+		switch {
+		case strings.HasPrefix(s.src[s.pos:], "(;"):
+			depth++
+			s.advance(2)
+		case strings.HasPrefix(s.src[s.pos:], ";)"):
+			depth--
+			s.advance(2)
+			if depth == 0 {
+				return
+			}
+		default:
+			s.advance(1)
+		}
+	}
+	s.line, s.col = line, col
+	s.errorf("unterminated block comment")
+}
+
+// scanString returns the bytes of the string starting at the current
+// position, which may not be valid UTF-8.
+func (s *scanner) scanString() string {
+	line, col := s.line, s.col
+	var b strings.Builder
+	s.advance(1)
+	for s.pos < len(s.src) {
+		c := s.src[s.pos]
+		switch {
+		case c == '"':
+			s.advance(1)
+			return b.String()
+		case c == '\n' || c < 0x20 || c == 0x7f:
+			s.errorf("invalid character %q in string", c)
+		case c != '\\':
+			b.WriteByte(c)
+			s.advance(1)
+			continue
+		}
+		if s.pos+1 == len(s.src) {
+			break
+		}
+		switch e := s.src[s.pos+1]; e {
+		case 't':
+			b.WriteByte('\t')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case '"', '\'', '\\':
+			b.WriteByte(e)
+		case 'u':
+			end := strings.IndexByte(s.src[s.pos:], '}')
+			if s.pos+2 >= len(s.src) || s.src[s.pos+2] != '{' || end < 0 {
+				s.errorf("invalid unicode escape in string")
+			}
+			r, err := strconv.ParseUint(strings.Replace(s.src[s.pos+3:s.pos+end], "_", "", -1), 16, 32)
+			if err != nil || !utf8.ValidRune(rune(r)) {
+				s.errorf("invalid unicode escape in string")
+			}
+			b.WriteRune(rune(r))
+			s.advance(end + 1)
+			continue
+		default:
+			if s.pos+2 >= len(s.src) {
+				s.errorf("invalid escape in string")
+			}
+			v, err := strconv.ParseUint(s.src[s.pos+1:s.pos+3], 16, 8)
+			if err != nil {
+				s.errorf("invalid escape %q in string", s.src[s.pos:s.pos+3])
+			}
+			b.WriteByte(byte(v))
+			s.advance(3)
+			continue
+		}
+		s.advance(2)
+	}
+	s.line, s.col = line, col
+	s.errorf("unterminated string")
+	return ""
+}
+
+func isIDChar(c byte) bool {
+	switch {
+	case c >= '0' && c <= '9', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		return true
+	}
+	return strings.IndexByte("!#$%&'*+-./:<=>?@\\^_`|~", c) >= 0
+}
+
+// stripUnderscores removes the underscores separating the digits of a
+// number, or returns false if they do not separate digits.
+func stripUnderscores(s string) (string, bool) {
+	if !strings.Contains(s, "_") {
+		return s, true
+	}
+	if strings.HasPrefix(s, "_") || strings.HasSuffix(s, "_") || strings.Contains(s, "__") {
+		return "", false
+	}
+	return strings.Replace(s, "_", "", -1), true
+}
+
+// splitSign returns s without its sign, and whether it is negative.
+func splitSign(s string) (string, bool) {
+	switch {
+	case strings.HasPrefix(s, "-"):
+		return s[1:], true
+	case strings.HasPrefix(s, "+"):
+		return s[1:], false
+	}
+	return s, false
+}
+
+// parseUint parses an unsigned integer of the given size in bits, in
+// decimal or hexadecimal notation.
+func parseUint(s string, bits int) (uint64, bool) {
+	base := 10
+	if strings.HasPrefix(s, "0x") {
+		s, base = s[2:], 16
+	}
+	s, ok := stripUnderscores(s)
+	if !ok || s == "" {
+		return 0, false
+	}
+	v, err := strconv.ParseUint(s, base, bits)
+	return v, err == nil
+}
+
+// parseInt parses an integer of the given size in bits, which may be
+// signed or unsigned, and returns its bits.
+func parseInt(s string, bits int) (uint64, bool) {
+	digits, neg := splitSign(s)
+	v, ok := parseUint(digits, bits)
+	if !ok {
+		return 0, false
+	}
+	if neg {
+		if v > 1<<uint(bits-1) {
+			return 0, false
+		}
+		v = -v
+		if bits < 64 {
+			v &= 1<<uint(bits) - 1
+		}
+	}
+	return v, true
+}
+
+// parseFloat parses a floating point number of the given size in bits and
+// returns its bits.
+func parseFloat(s string, bits int) (uint64, bool) {
+	digits, neg := splitSign(s)
+	var v uint64
+	switch {
+	case digits == "inf":
+		v = math.Float64bits(math.Inf(1))
+		if bits == 32 {
+			v = uint64(math.Float32bits(float32(math.Inf(1))))
+		}
+	case digits == "nan":
+		v = 0x7ff8000000000000
+		if bits == 32 {
+			v = 0x7fc00000
+		}
+	case strings.HasPrefix(digits, "nan:0x"):
+		payload, ok := parseUint(digits[len("nan:"):], bits)
+		if !ok || payload == 0 {
+			return 0, false
+		}
+		v = 0x7ff0000000000000 | payload
+		if bits == 32 {
+			v = 0x7f800000 | payload
+		}
+		if payload >= 1<<52 || bits == 32 && payload >= 1<<23 {
+			return 0, false
+		}
+	default:
+		digits, ok := stripUnderscores(digits)
+		if !ok || digits == "" || digits[0] < '0' || digits[0] > '9' {
+			return 0, false
+		}
+		if strings.HasPrefix(digits, "0x") && !strings.ContainsAny(digits, "pP") {
+			digits += "p0"
+		}
+		f, err := strconv.ParseFloat(digits, bits)
+		if err != nil {
+			return 0, false
+		}
+		v = math.Float64bits(f)
+		if bits == 32 {
+			v = uint64(math.Float32bits(float32(f)))
+		}
+	}
+	if neg {
+		v |= 1 << uint(bits-1)
+	}
+	return v, true
+}
@@ -10,10 +10,12 @@ package wast
 import (
 	"bufio"
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"math"
 	"strconv"
+	"strings"
 
 	"github.com/ontio/wagon/disasm"
 	"github.com/ontio/wagon/wasm"
@@ -216,10 +218,7 @@ func (w *writer) writeTables() {
 		w.WriteString(tab + "(table ")
 		w.Print("(;%d;)", i)
 		w.Print(" %d %d ", t.Limits.Initial, t.Limits.Maximum)
-		switch t.ElementType {
-		case wasm.ElemTypeAnyFunc:
-			w.WriteString("anyfunc")
-		}
+		w.WriteString(t.ElementType.String())
 		w.WriteString(")")
 	}
 }
@@ -272,12 +271,28 @@ func (w *writer) writeElements() {
 	for _, d := range w.m.Elements.Entries {
 		w.WriteString("\n")
 		w.WriteString(tab + "(elem")
-		if d.Index != 0 {
-			w.Print(" %d", d.Index)
+		switch {
+		case d.Declarative:
+			w.WriteString(" declare")
+		case d.Passive:
+		default:
+			if d.Index != 0 {
+				w.Print(" %d", d.Index)
+			}
+			w.WriteString(" (")
+			w.writeCode(d.Offset, true)
+			w.WriteString(")")
+		}
+		if d.Exprs != nil {
+			w.WriteString(" " + d.ElemType().ValueType().String())
+			for _, expr := range d.Exprs {
+				w.WriteString(" (")
+				w.writeCode(expr, true)
+				w.WriteString(")")
+			}
+		} else if d.Passive || d.Declarative {
+			w.WriteString(" func")
 		}
-		w.WriteString(" (")
-		w.writeCode(d.Offset, true)
-		w.WriteString(")")
 		for _, v := range d.Elems {
 			w.Print(" %d", v)
 		}
@@ -292,12 +307,15 @@ func (w *writer) writeData() {
 	for _, d := range w.m.Data.Entries {
 		w.WriteString("\n")
 		w.WriteString(tab + "(data")
-		if d.Index != 0 {
-			w.Print(" %d", d.Index)
+		if !d.Passive {
+			if d.Index != 0 {
+				w.Print(" %d", d.Index)
+			}
+			w.WriteString(" (")
+			w.writeCode(d.Offset, true)
+			w.WriteString(")")
 		}
-		w.WriteString(" (")
-		w.writeCode(d.Offset, true)
-		w.Print(") %s)", quoteData(d.Data))
+		w.Print(" %s)", quoteData(d.Data))
 	}
 }
 
@@ -378,22 +396,26 @@ func (w *writer) writeCode(code []byte, isInit bool) {
 		case operators.Block, operators.Loop, operators.If:
 			tabs++
 			block++
-			b := ins.Immediates[0].(wasm.BlockType)
-			if b != wasm.BlockTypeEmpty {
-				w.WriteString(" (result ")
-				w.WriteString(b.String())
-				w.WriteString(")")
+			switch b := ins.Immediates[0].(type) {
+			case wasm.BlockType:
+				if b != wasm.BlockTypeEmpty {
+					w.WriteString(" (result ")
+					w.WriteString(b.String())
+					w.WriteString(")")
+				}
+			case wasm.BlockTypeIndex:
+				w.Print(" (type %d)", uint32(b))
 			}
 			w.Print("  ;; label = @%d", block)
 			continue
-		//case operators.F32Const:
-		//	i1 := ins.Immediates[0].(float32)
-		//	w.WriteString(" " + formatFloat32(i1))
-		//	continue
-		//case operators.F64Const:
-		//	i1 := ins.Immediates[0].(float64)
-		//	w.WriteString(" " + formatFloat64(i1))
-		//	continue
+		case operators.F32Const:
+			i1 := ins.Immediates[0].(float32)
+			w.WriteString(" " + formatFloat32(i1))
+			continue
+		case operators.F64Const:
+			i1 := ins.Immediates[0].(float64)
+			w.WriteString(" " + formatFloat64(i1))
+			continue
 		case operators.BrIf, operators.Br:
 			i1 := ins.Immediates[0].(uint32)
 			writeBlock(int(i1))
@@ -407,7 +429,7 @@ func (w *writer) writeCode(code []byte, isInit bool) {
 			def := ins.Immediates[n+1].(uint32)
 			writeBlock(int(def))
 			continue
-		case operators.Call:
+		case operators.Call, operators.ReturnCall:
 			i1 := ins.Immediates[0].(uint32)
 			if name, ok := w.fnames[i1]; ok {
 				w.WriteString(" $")
@@ -416,10 +438,28 @@ func (w *writer) writeCode(code []byte, isInit bool) {
 				w.Print(" %v", i1)
 			}
 			continue
-		case operators.CallIndirect:
+		case operators.CallIndirect, operators.ReturnCallIndirect:
 			i1 := ins.Immediates[0].(uint32)
+			if table := ins.Immediates[1].(uint32); table != 0 {
+				w.Print(" %d", table)
+			}
 			w.Print(" (type %d)", i1)
 			continue
+		case operators.SelectTyped:
+			for _, t := range ins.Immediates[1:] {
+				w.Print(" (result %v)", t)
+			}
+			continue
+		case operators.RefNull:
+			t := ins.Immediates[0].(wasm.ValueType)
+			w.WriteString(" " + strings.TrimSuffix(t.String(), "ref"))
+			continue
+		case operators.MiscPrefix:
+			w.writeMiscImmediates(ins.Op.Sub, ins.Immediates)
+			continue
+		case operators.SIMDPrefix:
+			w.writeSIMDImmediates(ins.Op.Sub, ins.Immediates)
+			continue
 		case operators.CurrentMemory, operators.GrowMemory:
 			r := ins.Immediates[0].(uint8)
 			if r == 0 {
@@ -429,37 +469,22 @@ func (w *writer) writeCode(code []byte, isInit bool) {
 			operators.I32Store8, operators.I64Store8,
 			operators.I32Store16, operators.I64Store16,
 			operators.I64Store32,
-			//operators.F32Store, operators.F64Store,
+			operators.F32Store, operators.F64Store,
 			operators.I32Load, operators.I64Load,
 			operators.I32Load8u, operators.I32Load8s,
 			operators.I32Load16u, operators.I32Load16s,
 			operators.I64Load8u, operators.I64Load8s,
 			operators.I64Load16u, operators.I64Load16s,
-			operators.I64Load32u, operators.I64Load32s:
-			//operators.F32Load, operators.F64Load:
+			operators.I64Load32u, operators.I64Load32s,
+			operators.F32Load, operators.F64Load:
 
 			i1 := ins.Immediates[0].(uint32)
 			i2 := ins.Immediates[1].(uint32)
-			dst := 0 // in log 2 (i8)
-			switch ins.Op.Code {
-			case operators.I64Load, operators.I64Store:
-				//operators.F64Load, operators.F64Store:
-				dst = 3
-			case operators.I32Load, operators.I64Load32s, operators.I64Load32u,
-				operators.I32Store, operators.I64Store32:
-				//operators.F32Load, operators.F32Store:
-				dst = 2
-			case operators.I32Load16u, operators.I32Load16s, operators.I64Load16u, operators.I64Load16s,
-				operators.I32Store16, operators.I64Store16:
-				dst = 1
-			case operators.I32Load8u, operators.I32Load8s, operators.I64Load8u, operators.I64Load8s,
-				operators.I32Store8, operators.I64Store8:
-				dst = 0
-			}
+			dst := naturalAlignment(ins.Op.Code)
 			if i2 != 0 {
 				w.Print(" offset=%d", i2)
 			}
-			if int(i1) != dst {
+			if i1 != dst {
 				w.Print(" align=%d", 1<<i1)
 			}
 			continue
@@ -471,6 +496,92 @@ func (w *writer) writeCode(code []byte, isInit bool) {
 	}
 }
 
+// writeMiscImmediates writes the immediates of an operator prefixed with
+// operators.MiscPrefix, given its sub-opcode.
+func (w *writer) writeMiscImmediates(sub uint32, imms []interface{}) {
+	switch sub {
+	case operators.MemoryInit, operators.DataDrop, operators.ElemDrop,
+		operators.TableGrow, operators.TableSize, operators.TableFill:
+		w.Print(" %d", imms[0].(uint32))
+	case operators.TableInit:
+		if table := imms[1].(uint32); table != 0 {
+			w.Print(" %d", table)
+		}
+		w.Print(" %d", imms[0].(uint32))
+	case operators.TableCopy:
+		if dst, src := imms[0].(uint32), imms[1].(uint32); dst != 0 || src != 0 {
+			w.Print(" %d %d", dst, src)
+		}
+	}
+}
+
+// writeSIMDImmediates writes the immediates of an operator prefixed with
+// operators.SIMDPrefix, given its sub-opcode.
+func (w *writer) writeSIMDImmediates(sub uint32, imms []interface{}) {
+	if len(imms) >= 2 {
+		if align, ok := imms[0].(uint32); ok {
+			if offset := imms[1].(uint32); offset != 0 {
+				w.Print(" offset=%d", offset)
+			}
+			if align != simdNaturalAlignment(sub) {
+				w.Print(" align=%d", 1<<align)
+			}
+			imms = imms[2:]
+		}
+	}
+	for _, imm := range imms {
+		switch imm := imm.(type) {
+		case uint8:
+			w.Print(" %d", imm)
+		case [16]byte:
+			if sub == operators.I8x16Shuffle {
+				for _, lane := range imm {
+					w.Print(" %d", lane)
+				}
+				continue
+			}
+			w.WriteString(" i32x4")
+			for i := 0; i < 16; i += 4 {
+				w.Print(" 0x%08x", binary.LittleEndian.Uint32(imm[i:]))
+			}
+		}
+	}
+}
+
+// naturalAlignment returns the log2 of the number of bytes accessed by the
+// memory operator code.
+func naturalAlignment(code byte) uint32 {
+	switch code {
+	case operators.I64Load, operators.I64Store,
+		operators.F64Load, operators.F64Store:
+		return 3
+	case operators.I32Load, operators.I64Load32s, operators.I64Load32u,
+		operators.I32Store, operators.I64Store32,
+		operators.F32Load, operators.F32Store:
+		return 2
+	case operators.I32Load16u, operators.I32Load16s, operators.I64Load16u, operators.I64Load16s,
+		operators.I32Store16, operators.I64Store16:
+		return 1
+	}
+	return 0
+}
+
+// simdNaturalAlignment returns the log2 of the number of bytes accessed by
+// the SIMD memory operator sub.
+func simdNaturalAlignment(sub uint32) uint32 {
+	switch sub {
+	case operators.V128Load, operators.V128Store:
+		return 4
+	case operators.V128Load8Splat, operators.V128Load8Lane, operators.V128Store8Lane:
+		return 0
+	case operators.V128Load16Splat, operators.V128Load16Lane, operators.V128Store16Lane:
+		return 1
+	case operators.V128Load32Splat, operators.V128Load32Lane, operators.V128Store32Lane, operators.V128Load32Zero:
+		return 2
+	}
+	return 3
+}
+
 func formatFloat32(v float32) string {
 	s := ""
 	if v == float32(int32(v)) {